@@ -36,19 +36,26 @@ type BlockChaosAction string
 
 const (
 	BlockDelay BlockChaosAction = "delay"
+	BlockLimit BlockChaosAction = "limit"
 )
 
 // BlockChaosSpec is the content of the specification for a BlockChaos
 type BlockChaosSpec struct {
 	// Action defines the specific block chaos action.
-	// Supported action: delay
-	// +kubebuilder:validation:Enum=delay
+	// Supported action: delay / limit
+	// +kubebuilder:validation:Enum=delay;limit
 	Action BlockChaosAction `json:"action"`
 
 	// Delay defines the delay distribution.
 	// +optional
 	Delay *BlockDelaySpec `json:"delay,omitempty"`
 
+	// Limit defines the limit of io requests and bandwidth of each selected container
+	// on the block device, rather than the limit of the device.
+	// +ui:form:when=action=='limit'
+	// +optional
+	Limit *BlockLimitSpec `json:"limit,omitempty"`
+
 	ContainerNodeVolumePathSelector `json:",inline"`
 
 	// Duration represents the duration of the chaos action.
@@ -72,6 +79,24 @@ type BlockDelaySpec struct {
 	Jitter string `json:"jitter,omitempty" default:"0ms" webhook:"Duration"`
 }
 
+// BlockLimitSpec describes the block limit specification. The io of the selected
+// container on the block device is throttled by the io controller of cgroup, whose
+// limits on the buffered writes only work with cgroup v2. The limits are written to
+// the cgroup of the container, so they apply to every selected container separately,
+// and the io of other containers and processes on the same device isn't limited.
+type BlockLimitSpec struct {
+	// IOPS defines the number of read requests and the number of write requests
+	// allowed per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	IOPS uint64 `json:"iops,omitempty"`
+
+	// BPS defines the bytes read and the bytes written allowed per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BPS uint64 `json:"bps,omitempty"`
+}
+
 // ContainerNodeVolumePathSelector is the selector to select a node and a PV on it
 type ContainerNodeVolumePathSelector struct {
 	ContainerSelector `json:",inline"`
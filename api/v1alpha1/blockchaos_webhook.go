@@ -27,6 +27,23 @@ func (in *BlockChaosSpec) Validate(root interface{}, path *field.Path) field.Err
 			err := errors.Errorf("delay should be set on %s action", in.Action)
 			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, err.Error()))
 		}
+		if in.Limit != nil {
+			err := errors.Errorf("limit cannot be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("limit"), in.Limit, err.Error()))
+		}
+	}
+	if in.Action == BlockLimit {
+		if in.Limit == nil {
+			err := errors.Errorf("limit should be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("limit"), in.Limit, err.Error()))
+		} else if in.Limit.IOPS == 0 && in.Limit.BPS == 0 {
+			err := errors.New("at least one of iops and bps should be set")
+			allErrs = append(allErrs, field.Invalid(path.Child("limit"), in.Limit, err.Error()))
+		}
+		if in.Delay != nil {
+			err := errors.Errorf("delay cannot be set on %s action", in.Action)
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, err.Error()))
+		}
 	}
 	return allErrs
 }
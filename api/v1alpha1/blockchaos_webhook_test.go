@@ -256,6 +256,85 @@ var _ = Describe("blockchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate limit",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+							Limit: &BlockLimitSpec{
+								IOPS: 100,
+								BPS:  1024 * 1024,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate limit without spec",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate limit without iops and bps",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockLimit,
+							Limit:  &BlockLimitSpec{},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate limit with delay action",
+					chaos: BlockChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: BlockChaosSpec{
+							Action: BlockDelay,
+							Delay: &BlockDelaySpec{
+								Latency: "10ms",
+							},
+							Limit: &BlockLimitSpec{
+								IOPS: 100,
+							},
+						},
+					},
+					execute: func(chaos *BlockChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -49,6 +49,7 @@ const (
 	ConditionAllInjected  ChaosConditionType = "AllInjected"
 	ConditionAllRecovered ChaosConditionType = "AllRecovered"
 	ConditionPaused       ChaosConditionType = "Paused"
+	ConditionConflicted   ChaosConditionType = "Conflicted"
 )
 
 type ChaosCondition struct {
@@ -190,6 +191,14 @@ type WebhookObject interface {
 	runtime.Object
 }
 
+// +kubebuilder:object:generate=false
+
+// ConflictedObject is an object whose rules may conflict with the rules of other objects
+type ConflictedObject interface {
+	InnerObject
+	IsConflicted() bool
+}
+
 // +kubebuilder:object:generate=false
 type RemoteObject interface {
 	StatefulObject
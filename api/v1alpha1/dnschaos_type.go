@@ -31,6 +31,34 @@ const (
 
 	// RandomAction represents get random IP when send DNS request.
 	RandomAction DNSChaosAction = "random"
+
+	// DNSDelayAction represents the answers of DNS request are delayed.
+	DNSDelayAction DNSChaosAction = "delay"
+
+	// DNSFixedAction represents get the static records when send DNS request.
+	DNSFixedAction DNSChaosAction = "fixed"
+)
+
+// DNSChaosResponder represents where the DNS requests are answered with chaos.
+type DNSChaosResponder string
+
+const (
+	// ServerResponder represents the requests are answered by the chaos DNS server,
+	// which the selected pods are set to use.
+	ServerResponder DNSChaosResponder = "server"
+
+	// PodResponder represents the requests are answered by a DNS responder run by
+	// chaos daemon in the network namespace of every selected pod.
+	PodResponder DNSChaosResponder = "pod"
+)
+
+// DNSRcode represents the response code returned by the error action of DNSChaos.
+type DNSRcode string
+
+const (
+	ServFailRcode DNSRcode = "SERVFAIL"
+	NXDomainRcode DNSRcode = "NXDOMAIN"
+	RefusedRcode  DNSRcode = "REFUSED"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -54,19 +82,31 @@ type DNSChaos struct {
 	Status DNSChaosStatus `json:"status,omitempty"`
 }
 
+var _ InnerObjectWithCustomStatus = (*DNSChaos)(nil)
 var _ InnerObjectWithSelector = (*DNSChaos)(nil)
 var _ InnerObject = (*DNSChaos)(nil)
 
 // DNSChaosSpec defines the desired state of DNSChaos
 type DNSChaosSpec struct {
 	// Action defines the specific DNS chaos action.
-	// Supported action: error, random
+	// Supported action: error, random, delay, fixed
+	// The delay and fixed actions are only supported by the pod responder.
 	// Default action: error
-	// +kubebuilder:validation:Enum=error;random
+	// +kubebuilder:validation:Enum=error;random;delay;fixed
 	Action DNSChaosAction `json:"action"`
 
 	ContainerSelector `json:",inline"`
 
+	// Responder represents where the DNS requests are answered with chaos.
+	// The server responder depends on the chaos DNS server deployed with chaos mesh,
+	// and only supports error action with SERVFAIL and random action. The pod responder
+	// redirects the DNS requests of the selected pods to a responder in their network
+	// namespace, and forwards the requests not selected to the original nameservers.
+	// Default responder: server
+	// +optional
+	// +kubebuilder:validation:Enum=server;pod
+	Responder DNSChaosResponder `json:"responder,omitempty"`
+
 	// Duration represents the duration of the chaos action
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
@@ -80,6 +120,31 @@ type DNSChaosSpec struct {
 	// +optional
 	DomainNamePatterns []string `json:"patterns,omitempty"`
 
+	// Delay represents the latency added to the answers, only available for delay action.
+	// +optional
+	// +ui:form:when=action=='delay'
+	Delay string `json:"delay,omitempty"`
+
+	// Records represents the static records returned by fixed action, the key is the
+	// domain name, and the values are IPv4 addresses for A records, IPv6 addresses for
+	// AAAA records, or a single domain name for CNAME record.
+	// For example: `{"api.example.com": ["10.0.0.1"], "db.example.com": ["sinkhole.local"]}`
+	// +optional
+	// +ui:form:when=action=='fixed'
+	Records map[string][]string `json:"records,omitempty"`
+
+	// Rcode represents the response code returned by error action, default SERVFAIL.
+	// The rcode other than SERVFAIL is only supported by the pod responder.
+	// +optional
+	// +kubebuilder:validation:Enum=SERVFAIL;NXDOMAIN;REFUSED
+	// +ui:form:when=action=='error'
+	Rcode DNSRcode `json:"rcode,omitempty"`
+
+	// Truncate represents the answers over UDP are truncated without records,
+	// which forces the clients to retry over TCP. It's only supported by the pod responder.
+	// +optional
+	Truncate bool `json:"truncate,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
@@ -88,6 +153,11 @@ type DNSChaosSpec struct {
 // DNSChaosStatus defines the observed state of DNSChaos
 type DNSChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	// Instances always specifies the uid of DNS responder on the pods or empty,
+	// it's only used by the pod responder
+	// +optional
+	Instances map[string]string `json:"instances,omitempty"`
 }
 
 func (obj *DNSChaos) GetSelectorSpecs() map[string]interface{} {
@@ -95,3 +165,7 @@ func (obj *DNSChaos) GetSelectorSpecs() map[string]interface{} {
 		".": &obj.Spec.ContainerSelector,
 	}
 }
+
+func (obj *DNSChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (in *DNSChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Responder {
+	case "", ServerResponder:
+		if in.Action == DNSDelayAction || in.Action == DNSFixedAction {
+			allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action, "delay and fixed actions are only supported by the pod responder"))
+		}
+		if len(in.Rcode) > 0 && in.Rcode != ServFailRcode {
+			allErrs = append(allErrs, field.Invalid(path.Child("rcode"), in.Rcode, "rcode other than SERVFAIL is only supported by the pod responder"))
+		}
+		if in.Truncate {
+			allErrs = append(allErrs, field.Invalid(path.Child("truncate"), in.Truncate, "truncate is only supported by the pod responder"))
+		}
+	case PodResponder:
+	default:
+		allErrs = append(allErrs, field.Invalid(path.Child("responder"), in.Responder,
+			fmt.Sprintf("responder %s not supported, responder can be 'server' or 'pod'", in.Responder)))
+	}
+
+	if in.Action == DNSDelayAction {
+		delay, err := time.ParseDuration(in.Delay)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, fmt.Sprintf("parse delay field error: %s", err)))
+		} else if delay <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, "delay should be positive"))
+		}
+	} else if len(in.Delay) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, "delay is only available for delay action"))
+	}
+
+	if in.Action == DNSFixedAction {
+		if len(in.Records) == 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("records"), in.Records, "records should not be empty for fixed action"))
+		}
+		for name, values := range in.Records {
+			allErrs = append(allErrs, validateDNSRecords(name, values, path.Child("records").Key(name))...)
+		}
+	} else if len(in.Records) > 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("records"), in.Records, "records is only available for fixed action"))
+	}
+
+	if len(in.Rcode) > 0 {
+		if in.Action != ErrorAction {
+			allErrs = append(allErrs, field.Invalid(path.Child("rcode"), in.Rcode, "rcode is only available for error action"))
+		}
+		switch in.Rcode {
+		case ServFailRcode, NXDomainRcode, RefusedRcode:
+		default:
+			allErrs = append(allErrs, field.Invalid(path.Child("rcode"), in.Rcode,
+				fmt.Sprintf("rcode %s not supported, rcode can be 'SERVFAIL', 'NXDOMAIN' or 'REFUSED'", in.Rcode)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDNSRecords checks the values of a domain name are all IPs, or a single domain name of CNAME record
+func validateDNSRecords(name string, values []string, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Invalid(path, name, "the domain name should not be empty"))
+	}
+	if len(values) == 0 {
+		allErrs = append(allErrs, field.Invalid(path, values, "the records should not be empty"))
+		return allErrs
+	}
+
+	var cnames int
+	for i, value := range values {
+		if len(value) == 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), value, "the record should not be empty"))
+			continue
+		}
+		if net.ParseIP(value) == nil {
+			cnames++
+		}
+	}
+	if cnames > 0 && len(values) > 1 {
+		allErrs = append(allErrs, field.Invalid(path, values, "a CNAME record cannot be set with other records"))
+	}
+	return allErrs
+}
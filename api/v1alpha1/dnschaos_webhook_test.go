@@ -0,0 +1,198 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("dnschaos_webhook", func() {
+	Context("webhook.Validator of dnschaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name   string
+				spec   DNSChaosSpec
+				expect string
+			}
+
+			tcs := []TestCase{
+				{
+					name: "error action with rcode",
+					spec: DNSChaosSpec{
+						Action:    ErrorAction,
+						Responder: PodResponder,
+						Rcode:     NXDomainRcode,
+						Truncate:  true,
+					},
+					expect: "",
+				},
+				{
+					name: "random action with rcode",
+					spec: DNSChaosSpec{
+						Action: RandomAction,
+						Rcode:  RefusedRcode,
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown rcode",
+					spec: DNSChaosSpec{
+						Action: ErrorAction,
+						Rcode:  "FORMERR",
+					},
+					expect: "error",
+				},
+				{
+					name: "delay action",
+					spec: DNSChaosSpec{
+						Action:             DNSDelayAction,
+						Responder:          PodResponder,
+						DomainNamePatterns: []string{"*.example.com"},
+						Delay:              "500ms",
+					},
+					expect: "",
+				},
+				{
+					name: "delay action without delay",
+					spec: DNSChaosSpec{
+						Action:    DNSDelayAction,
+						Responder: PodResponder,
+					},
+					expect: "error",
+				},
+				{
+					name: "delay with error action",
+					spec: DNSChaosSpec{
+						Action: ErrorAction,
+						Delay:  "1s",
+					},
+					expect: "error",
+				},
+				{
+					name: "fixed action",
+					spec: DNSChaosSpec{
+						Action:    DNSFixedAction,
+						Responder: PodResponder,
+						Records: map[string][]string{
+							"api.example.com": {"10.0.0.1", "fd00::1"},
+							"db.example.com":  {"sinkhole.local"},
+						},
+					},
+					expect: "",
+				},
+				{
+					name: "fixed action without records",
+					spec: DNSChaosSpec{
+						Action:    DNSFixedAction,
+						Responder: PodResponder,
+					},
+					expect: "error",
+				},
+				{
+					name: "fixed action with cname and ip",
+					spec: DNSChaosSpec{
+						Action:    DNSFixedAction,
+						Responder: PodResponder,
+						Records: map[string][]string{
+							"api.example.com": {"10.0.0.1", "sinkhole.local"},
+						},
+					},
+					expect: "error",
+				},
+				{
+					name: "delay action with server responder",
+					spec: DNSChaosSpec{
+						Action: DNSDelayAction,
+						Delay:  "1s",
+					},
+					expect: "error",
+				},
+				{
+					name: "fixed action with default responder",
+					spec: DNSChaosSpec{
+						Action: DNSFixedAction,
+						Records: map[string][]string{
+							"api.example.com": {"10.0.0.1"},
+						},
+					},
+					expect: "error",
+				},
+				{
+					name: "rcode with default responder",
+					spec: DNSChaosSpec{
+						Action: ErrorAction,
+						Rcode:  NXDomainRcode,
+					},
+					expect: "error",
+				},
+				{
+					name: "truncate with default responder",
+					spec: DNSChaosSpec{
+						Action:   ErrorAction,
+						Truncate: true,
+					},
+					expect: "error",
+				},
+				{
+					name: "servfail with default responder",
+					spec: DNSChaosSpec{
+						Action: ErrorAction,
+						Rcode:  ServFailRcode,
+					},
+					expect: "",
+				},
+				{
+					name: "rcode with server responder",
+					spec: DNSChaosSpec{
+						Action:    ErrorAction,
+						Responder: ServerResponder,
+						Rcode:     NXDomainRcode,
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown responder",
+					spec: DNSChaosSpec{
+						Action:    ErrorAction,
+						Responder: "node",
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				chaos := &DNSChaos{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceDefault,
+						Name:      "foo",
+					},
+					Spec: tc.spec,
+				}
+				_, err := chaos.ValidateCreate(context.Background(), chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
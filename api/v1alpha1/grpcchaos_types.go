@@ -0,0 +1,140 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+// +genclient
+
+// GRPCChaos is the Schema for the grpcchaos API
+type GRPCChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GRPCChaosSpec   `json:"spec,omitempty"`
+	Status GRPCChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithCustomStatus = (*GRPCChaos)(nil)
+var _ InnerObjectWithSelector = (*GRPCChaos)(nil)
+var _ InnerObject = (*GRPCChaos)(nil)
+
+// GRPCChaosAction represents the chaos action about gRPC
+type GRPCChaosAction string
+
+const (
+	// GRPCStatusAction represents the chaos action of returning a specific gRPC status
+	GRPCStatusAction GRPCChaosAction = "status"
+
+	// GRPCDelayAction represents the chaos action of delaying the gRPC calls
+	GRPCDelayAction GRPCChaosAction = "delay"
+
+	// GRPCAbortAction represents the chaos action of aborting the gRPC streams mid-flight
+	GRPCAbortAction GRPCChaosAction = "abort"
+)
+
+// GRPCChaosSpec defines the desired state of GRPCChaos
+type GRPCChaosSpec struct {
+	PodSelector `json:",inline"`
+
+	// Action defines the specific gRPC chaos action.
+	// Supported action: status;delay;abort
+	// +kubebuilder:validation:Enum=status;delay;abort
+	Action GRPCChaosAction `json:"action"`
+
+	// Port represents the port which the target gRPC server listens on.
+	// Only the plaintext (h2c) gRPC traffic from outside the pod is injected, the
+	// TLS connections aren't supported, and they are forwarded without any fault.
+	// The GRPCChaos on the same port of a pod share a proxy, and a call is injected
+	// by the first of them selecting it in the order of namespaced names.
+	Port int32 `json:"port" webhook:"Port"`
+
+	// Service is a rule to select target by the full name of gRPC service,
+	// such as `helloworld.Greeter`. All services are selected if it's empty.
+	// +optional
+	Service string `json:"service,omitempty"`
+
+	// Method is a rule to select target by the name of gRPC method,
+	// such as `SayHello`. All methods are selected if it's empty.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// Metadata is a rule to select target by the metadata of gRPC request.
+	// The key-value pairs represent metadata key and value pairs.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Code represents the gRPC status code returned by status action, from 1 to 16.
+	// +ui:form:when=action=='status'
+	// +optional
+	Code *int32 `json:"code,omitempty"`
+
+	// Message represents the gRPC status message returned by status action.
+	// +ui:form:when=action=='status'
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Delay represents the delay of the target calls in delay action.
+	// A duration string is a possibly unsigned sequence of
+	// decimal numbers, each with optional fraction and a unit suffix,
+	// such as "300ms", "2h45m".
+	// +ui:form:when=action=='delay'
+	// +optional
+	Delay string `json:"delay,omitempty"`
+
+	// AbortAfterMessages represents the number of response messages forwarded
+	// before the stream is aborted in abort action, default 0.
+	// +ui:form:when=action=='abort'
+	// +optional
+	AbortAfterMessages int32 `json:"abortAfterMessages,omitempty"`
+
+	// Duration represents the duration of the chaos action.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+}
+
+// GRPCChaosStatus defines the observed state of GRPCChaos
+type GRPCChaosStatus struct {
+	ChaosStatus `json:",inline"`
+
+	// Instances always specifies the uid of gRPC proxy on the pods or empty
+	// +optional
+	Instances map[string]string `json:"instances,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GRPCChaos{}, &GRPCChaosList{})
+}
+
+func (obj *GRPCChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.PodSelector,
+	}
+}
+
+func (obj *GRPCChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// the range of gRPC status codes which represent errors, from CANCELLED to UNAUTHENTICATED
+	minGRPCErrorCode int32 = 1
+	maxGRPCErrorCode int32 = 16
+)
+
+func (in *GRPCChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Action {
+	case GRPCStatusAction:
+		if in.Code == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("code"), in.Code, "code must be provided for status action"))
+		} else if *in.Code < minGRPCErrorCode || *in.Code > maxGRPCErrorCode {
+			allErrs = append(allErrs, field.Invalid(path.Child("code"), *in.Code,
+				fmt.Sprintf("code should be in range [%d, %d]", minGRPCErrorCode, maxGRPCErrorCode)))
+		}
+	case GRPCDelayAction:
+		delay, err := time.ParseDuration(in.Delay)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, fmt.Sprintf("parse delay field error: %s", err)))
+		} else if delay <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, "delay should be positive"))
+		}
+	case GRPCAbortAction:
+		if in.AbortAfterMessages < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("abortAfterMessages"), in.AbortAfterMessages, "abortAfterMessages should not be negative"))
+		}
+	case "":
+		allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action, "action not provided"))
+	default:
+		allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action,
+			fmt.Sprintf("action %s not supported, action can be 'status', 'delay' or 'abort'", in.Action)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,147 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("grpcchaos_webhook", func() {
+	Context("webhook.Validator of grpcchaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name   string
+				spec   GRPCChaosSpec
+				expect string
+			}
+			unavailable, ok, unknown := int32(14), int32(0), int32(17)
+
+			tcs := []TestCase{
+				{
+					name: "status action",
+					spec: GRPCChaosSpec{
+						Action:  GRPCStatusAction,
+						Port:    50051,
+						Service: "helloworld.Greeter",
+						Method:  "SayHello",
+						Code:    &unavailable,
+						Message: "unavailable",
+					},
+					expect: "",
+				},
+				{
+					name: "status action without code",
+					spec: GRPCChaosSpec{
+						Action: GRPCStatusAction,
+						Port:   50051,
+					},
+					expect: "error",
+				},
+				{
+					name: "status action with ok code",
+					spec: GRPCChaosSpec{
+						Action: GRPCStatusAction,
+						Port:   50051,
+						Code:   &ok,
+					},
+					expect: "error",
+				},
+				{
+					name: "status action with unknown code",
+					spec: GRPCChaosSpec{
+						Action: GRPCStatusAction,
+						Port:   50051,
+						Code:   &unknown,
+					},
+					expect: "error",
+				},
+				{
+					name: "delay action",
+					spec: GRPCChaosSpec{
+						Action:   GRPCDelayAction,
+						Port:     50051,
+						Metadata: map[string]string{"x-user": "foo"},
+						Delay:    "100ms",
+					},
+					expect: "",
+				},
+				{
+					name: "delay action with invalid delay",
+					spec: GRPCChaosSpec{
+						Action: GRPCDelayAction,
+						Port:   50051,
+						Delay:  "100",
+					},
+					expect: "error",
+				},
+				{
+					name: "abort action",
+					spec: GRPCChaosSpec{
+						Action:             GRPCAbortAction,
+						Port:               50051,
+						AbortAfterMessages: 2,
+					},
+					expect: "",
+				},
+				{
+					name: "abort action with negative messages",
+					spec: GRPCChaosSpec{
+						Action:             GRPCAbortAction,
+						Port:               50051,
+						AbortAfterMessages: -1,
+					},
+					expect: "error",
+				},
+				{
+					name: "without port",
+					spec: GRPCChaosSpec{
+						Action: GRPCAbortAction,
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown action",
+					spec: GRPCChaosSpec{
+						Action: "replace",
+						Port:   50051,
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				chaos := &GRPCChaos{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceDefault,
+						Name:      "foo",
+					},
+					Spec: tc.spec,
+				}
+				_, err := chaos.ValidateCreate(context.Background(), chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
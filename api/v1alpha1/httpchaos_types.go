@@ -47,8 +47,14 @@ type HTTPChaosSpec struct {
 	PodHttpChaosActions `json:",inline"`
 
 	// Port represents the target port to be proxy of.
+	// It's the port of destinations when egress is set.
 	Port int32 `json:"port,omitempty" webhook:"Port"`
 
+	// Egress represents intercepting the outbound requests of the selected pods
+	// to the port of destinations, instead of the inbound requests to the pods.
+	// +optional
+	Egress *PodHttpChaosEgress `json:"egress,omitempty"`
+
 	// Path is a rule to select target by uri path in http request.
 	// +optional
 	Path *string `json:"path,omitempty"`
@@ -71,11 +77,49 @@ type HTTPChaosSpec struct {
 	// +optional
 	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
 
+	// PathPattern is a rule to select target by matching uri path with a glob or regex pattern.
+	// +optional
+	PathPattern *PodHttpChaosPattern `json:"path_pattern,omitempty"`
+
+	// Queries is a rule to select target by uri queries in http request.
+	// The key-value pairs represent query name and the pattern of query value.
+	// +optional
+	Queries map[string]PodHttpChaosPattern `json:"queries,omitempty" webhook:"HTTPPatterns"`
+
+	// RequestHeaderPatterns is a rule to select target by http headers in request.
+	// The key-value pairs represent header name and the pattern of header value.
+	// +optional
+	RequestHeaderPatterns map[string]PodHttpChaosPattern `json:"request_header_patterns,omitempty" webhook:"HTTPPatterns"`
+
+	// RequestBody is a rule to select target by the contents of http request body,
+	// such as a tenant ID or the operation name of GraphQL.
+	// +optional
+	RequestBody *PodHttpChaosBodySelector `json:"request_body,omitempty"`
+
+	// Percent represents the percentage of the selected requests to be injected, from 0 to 100.
+	// All the selected requests are injected if it's not set.
+	// +optional
+	Percent *int `json:"percent,omitempty" webhook:"Percent"`
+
+	// RateLimit represents the maximum number of requests to be injected per second.
+	// The requests beyond the limit are forwarded as they are.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RateLimit *int32 `json:"rateLimit,omitempty" webhook:"RateLimit"`
+
 	// TLS is the tls config,
 	// will override PodHttpChaos if there are multiple HTTPChaos experiments are applied
 	// +optional
 	TLS *PodHttpChaosTLS `json:"tls,omitempty"`
 
+	// AutoTLS represents the controller generates a CA and certificates for
+	// this experiment to intercept the tls connections, instead of the secret in TLS.
+	// The generated certificates are stored in the secret `<name>-autotls` owned by
+	// this experiment, and removed when the chaos is recovered. The chaos fails if
+	// a secret of the name exists but isn't owned by this experiment.
+	// +optional
+	AutoTLS *HTTPChaosAutoTLS `json:"autoTLS,omitempty"`
+
 	// Duration represents the duration of the chaos action.
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
@@ -85,12 +129,64 @@ type HTTPChaosSpec struct {
 	RemoteCluster string `json:"remoteCluster,omitempty"`
 }
 
+// HTTPChaosAutoTLS represents the certificates generated for an HTTPChaos experiment
+type HTTPChaosAutoTLS struct {
+	// Hosts represents the DNS names and IPs of the generated certificate.
+	// The egress targets or the IPs of the pod are used if it's empty.
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+
+	// InjectCA represents the generated CA is added into the trust store of
+	// the containers in the selected pods, which is only available in egress mode.
+	// +optional
+	InjectCA bool `json:"injectCA,omitempty"`
+}
+
 type HTTPChaosStatus struct {
 	ChaosStatus `json:",inline"`
 
 	// Instances always specifies podhttpchaos generation or empty
 	// +optional
 	Instances map[string]int64 `json:"instances,omitempty"`
+
+	// Stats represents the number of requests matched and injected on every pod,
+	// it's reported by the http proxy periodically
+	// +optional
+	Stats map[string]HTTPChaosStats `json:"stats,omitempty"`
+}
+
+// HTTPChaosStats represents the number of requests matched and injected by HTTPChaos
+type HTTPChaosStats struct {
+	// Matched is the number of requests selected by the rule
+	Matched int64 `json:"matched"`
+
+	// Faulted is the number of requests injected, which is less than Matched
+	// when the percent or rate limit is set
+	Faulted int64 `json:"faulted"`
+
+	// Observations is the summary of the requests selected by the observe action,
+	// only the most frequent combinations of path, method and status code are kept
+	// +optional
+	Observations []HTTPChaosObservation `json:"observations,omitempty"`
+}
+
+// HTTPChaosObservation represents the requests observed with the same path, method and status code
+type HTTPChaosObservation struct {
+	Path   string `json:"path"`
+	Method string `json:"method"`
+	Code   int32  `json:"code"`
+
+	// Count is the number of the observed requests
+	Count int64 `json:"count"`
+
+	// P50Latency is the median latency of the observed requests, it's the upper
+	// bound of the bucket in the latency histogram
+	// +optional
+	P50Latency string `json:"p50Latency,omitempty"`
+
+	// P99Latency is the 99th percentile latency of the observed requests
+	// +optional
+	P99Latency string `json:"p99Latency,omitempty"`
 }
 
 func (obj *HTTPChaos) GetSelectorSpecs() map[string]interface{} {
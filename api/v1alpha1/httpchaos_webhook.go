@@ -17,8 +17,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	pathpkg "path"
 	"reflect"
+	"regexp"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -50,6 +53,16 @@ func (in *Port) Validate(root interface{}, path *field.Path) field.ErrorList {
 	return allErrs
 }
 
+type RateLimit int32
+
+func (in *RateLimit) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if *in <= 0 {
+		allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("rate limit %d should be positive", *in)))
+	}
+	return allErrs
+}
+
 type HTTPMethod string
 
 func (in *HTTPMethod) Validate(root interface{}, path *field.Path) field.ErrorList {
@@ -83,9 +96,186 @@ func (in *PodHttpChaosTarget) Validate(root interface{}, path *field.Path) field
 	return allErrs
 }
 
+func (in *PodHttpChaosPattern) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch in.Type {
+	case "", ExactPattern:
+	case GlobPattern:
+		if _, err := pathpkg.Match(in.Value, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("value"), in.Value, fmt.Sprintf("invalid glob pattern: %s", err)))
+		}
+	case RegexPattern:
+		if _, err := regexp.Compile(in.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("value"), in.Value, fmt.Sprintf("invalid regex pattern: %s", err)))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(path.Child("type"), in.Type, fmt.Sprintf("pattern type %s is not supported", in.Type)))
+	}
+	return allErrs
+}
+
+type HTTPPatterns map[string]PodHttpChaosPattern
+
+func (in *HTTPPatterns) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for key, pattern := range *in {
+		if len(key) == 0 {
+			allErrs = append(allErrs, field.Invalid(path, key, "the name should not be empty"))
+		}
+		allErrs = append(allErrs, pattern.Validate(root, path.Key(key))...)
+	}
+	return allErrs
+}
+
+// jsonPathRegexp matches the json paths made up of object keys and array indexes, such as `$.items[0].tenant`
+var jsonPathRegexp = regexp.MustCompile(`^\$(\.[A-Za-z0-9_-]+|\[[0-9]+\])*$`)
+
+func (in *PodHttpChaosBodySelector) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(in.JSONPath) > 0 && !jsonPathRegexp.MatchString(in.JSONPath) {
+		allErrs = append(allErrs, field.Invalid(path.Child("json_path"), in.JSONPath,
+			"json path should be made up of object keys and array indexes, such as `$.items[0].tenant`"))
+	}
+	return allErrs
+}
+
+func (in *PodHttpChaosStreamActions) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if chaos, ok := root.(*HTTPChaos); ok && chaos.Spec.Target != PodHttpResponse {
+		allErrs = append(allErrs, field.Invalid(path, in, "stream actions are only available for the response"))
+	}
+	if in.Rate != nil && *in.Rate <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("rate"), *in.Rate, "rate should be positive"))
+	}
+	if in.Ttfb != nil {
+		ttfb, err := time.ParseDuration(*in.Ttfb)
+		if err != nil || ttfb < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("ttfb"), *in.Ttfb, fmt.Sprintf("invalid duration %s", *in.Ttfb)))
+		}
+	}
+	if in.Truncate != nil && *in.Truncate < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("truncate"), *in.Truncate, "truncate should not be negative"))
+	}
+	return allErrs
+}
+
+func (in *PodHttpChaosFrameActions) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if chaos, ok := root.(*HTTPChaos); ok && chaos.Spec.Stream != nil {
+		allErrs = append(allErrs, field.Invalid(path, in, "frame actions cannot be set with stream actions"))
+	}
+	if in.DropAfterFrames != nil && *in.DropAfterFrames <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("drop_after_frames"), *in.DropAfterFrames, "drop_after_frames should be positive"))
+	}
+	if in.DropAfter != nil {
+		dropAfter, err := time.ParseDuration(*in.DropAfter)
+		if err != nil || dropAfter <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("drop_after"), *in.DropAfter, fmt.Sprintf("invalid duration %s", *in.DropAfter)))
+		}
+	}
+	if in.Delay != nil {
+		delay, err := time.ParseDuration(*in.Delay)
+		if err != nil || delay < 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), *in.Delay, fmt.Sprintf("invalid duration %s", *in.Delay)))
+		}
+	}
+	if in.Corrupt != nil {
+		if *in.Corrupt < 0 || *in.Corrupt > 100 {
+			allErrs = append(allErrs, field.Invalid(path.Child("corrupt"), *in.Corrupt, "corrupt should be between 0 and 100"))
+		}
+		if in.Replace != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("corrupt"), *in.Corrupt, "corrupt and replace cannot be set at the same time"))
+		}
+	}
+	if in.CloseCode != nil {
+		if in.DropAfterFrames == nil && in.DropAfter == nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("close_code"), *in.CloseCode, "close_code requires drop_after_frames or drop_after"))
+		}
+		if !validCloseCode(*in.CloseCode) {
+			allErrs = append(allErrs, field.Invalid(path.Child("close_code"), *in.CloseCode, "close code should be one of 1000-1003, 1007-1014 and 3000-4999"))
+		}
+	}
+	return allErrs
+}
+
+// validCloseCode checks whether the close code of WebSocket can be sent in a close frame,
+// the codes 1004-1006 and 1015 are reserved, see RFC 6455 section 7.4.1
+func validCloseCode(code int32) bool {
+	switch {
+	case code >= 1000 && code <= 1003:
+		return true
+	case code >= 1007 && code <= 1014:
+		return true
+	case code >= 3000 && code <= 4999:
+		return true
+	}
+	return false
+}
+
+func (in *HTTPChaosAutoTLS) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	chaos, ok := root.(*HTTPChaos)
+	if !ok {
+		return allErrs
+	}
+	if chaos.Spec.TLS != nil {
+		allErrs = append(allErrs, field.Invalid(path, in, "autoTLS and tls cannot be set at the same time"))
+	}
+	if in.InjectCA && chaos.Spec.Egress == nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("injectCA"), in.InjectCA, "injectCA is only available in egress mode"))
+	}
+	return allErrs
+}
+
+type Observe bool
+
+func (in *Observe) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	chaos, ok := root.(*HTTPChaos)
+	if !ok || !bool(*in) {
+		return allErrs
+	}
+	spec := &chaos.Spec
+	if spec.Abort != nil || spec.Delay != nil || spec.Replace != nil || spec.Patch != nil || spec.Stream != nil || spec.Frames != nil {
+		allErrs = append(allErrs, field.Invalid(path, *in, "observe cannot be set with other actions"))
+	}
+	if spec.Percent != nil || spec.RateLimit != nil {
+		allErrs = append(allErrs, field.Invalid(path, *in, "percent and rateLimit are not available for observe"))
+	}
+	return allErrs
+}
+
+type EgressTargets []string
+
+func (in *EgressTargets) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, target := range *in {
+		if len(target) == 0 {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), target, "the target should not be empty"))
+			continue
+		}
+		if _, _, err := net.SplitHostPort(target); err == nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), target, "the port of target should be set by the port field"))
+			continue
+		}
+		ip := net.ParseIP(target)
+		if _, ipNet, err := net.ParseCIDR(target); err == nil {
+			ip = ipNet.IP
+		}
+		if ip != nil && ip.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), target, "the IPv6 targets are not supported"))
+		}
+	}
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("Delay", reflect.PtrTo(reflect.TypeOf(Delay(""))))
 	genericwebhook.Register("Port", reflect.PtrTo(reflect.TypeOf(Port(0))))
+	genericwebhook.Register("HTTPPatterns", reflect.PtrTo(reflect.TypeOf(HTTPPatterns(nil))))
+	genericwebhook.Register("Observe", reflect.PtrTo(reflect.TypeOf(Observe(false))))
+	genericwebhook.Register("EgressTargets", reflect.PtrTo(reflect.TypeOf(EgressTargets(nil))))
+	genericwebhook.Register("RateLimit", reflect.PtrTo(reflect.TypeOf(RateLimit(0))))
 	genericwebhook.Register("HTTPMethod", reflect.PtrTo(reflect.TypeOf(HTTPMethod(""))))
 	genericwebhook.Register("PodHttpChaosTarget", reflect.PtrTo(reflect.TypeOf(PodHttpChaosTarget(""))))
 }
@@ -38,6 +38,13 @@ var _ = Describe("HTTPChaos Webhook", func() {
 			validMethod := http.MethodGet
 			errorDelay := "1"
 			valideDelay := "1s"
+			validPercent, errorPercent := 5, 101
+			validRateLimit, errorRateLimit := int32(10), int32(0)
+			rate, truncate, negative := int64(1024), int64(4096), int64(-1)
+			ttfb, invalidTtfb := "2s", "-1s"
+			observe := true
+			dropFrames, invalidCorrupt := int64(10), 101
+			closeCode, reservedCloseCode := int32(4000), int32(1006)
 
 			tcs := []TestCase{
 				{
@@ -392,6 +399,570 @@ var _ = Describe("HTTPChaos Webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "valid percent and rate limit",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: HTTPChaosSpec{
+							Port:      80,
+							Target:    PodHttpRequest,
+							Percent:   &validPercent,
+							RateLimit: &validRateLimit,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "invalid percent",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    80,
+							Target:  PodHttpRequest,
+							Percent: &errorPercent,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid rate limit",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: HTTPChaosSpec{
+							Port:      80,
+							Target:    PodHttpRequest,
+							RateLimit: &errorRateLimit,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "valid patterns",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo24",
+						},
+						Spec: HTTPChaosSpec{
+							Port:                  80,
+							Target:                PodHttpRequest,
+							PathPattern:           &PodHttpChaosPattern{Type: GlobPattern, Value: "/api/*/orders"},
+							Queries:               map[string]PodHttpChaosPattern{"tenant": {Type: RegexPattern, Value: "^t-[0-9]+$"}},
+							RequestHeaderPatterns: map[string]PodHttpChaosPattern{"X-Tenant": {Value: "foo"}},
+							RequestBody:           &PodHttpChaosBodySelector{JSONPath: "$.items[0].tenant", Pattern: PodHttpChaosPattern{Value: "foo"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "invalid glob pattern",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo25",
+						},
+						Spec: HTTPChaosSpec{
+							Port:        80,
+							Target:      PodHttpRequest,
+							PathPattern: &PodHttpChaosPattern{Type: GlobPattern, Value: "/api/[a-"},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid regex pattern of query",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo26",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    80,
+							Target:  PodHttpRequest,
+							Queries: map[string]PodHttpChaosPattern{"tenant": {Type: RegexPattern, Value: "t-(0"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown pattern type of header",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo27",
+						},
+						Spec: HTTPChaosSpec{
+							Port:                  80,
+							Target:                PodHttpRequest,
+							RequestHeaderPatterns: map[string]PodHttpChaosPattern{"X-Tenant": {Type: "Prefix", Value: "foo"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid json path",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo28",
+						},
+						Spec: HTTPChaosSpec{
+							Port:        80,
+							Target:      PodHttpRequest,
+							RequestBody: &PodHttpChaosBodySelector{JSONPath: "items.tenant", Pattern: PodHttpChaosPattern{Value: "foo"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid pattern of body",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo29",
+						},
+						Spec: HTTPChaosSpec{
+							Port:        80,
+							Target:      PodHttpRequest,
+							RequestBody: &PodHttpChaosBodySelector{JSONPath: "$.operationName", Pattern: PodHttpChaosPattern{Type: RegexPattern, Value: "*Query"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "egress to domain names and cidrs",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo30",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   443,
+							Target: PodHttpRequest,
+							Egress: &PodHttpChaosEgress{Targets: []string{"www.example.com", "10.0.0.0/24"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "egress target with port",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo31",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   443,
+							Target: PodHttpRequest,
+							Egress: &PodHttpChaosEgress{Targets: []string{"www.example.com:443"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "empty egress target",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo32",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   443,
+							Target: PodHttpRequest,
+							Egress: &PodHttpChaosEgress{Targets: []string{""}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "ipv6 egress target",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo48",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   443,
+							Target: PodHttpRequest,
+							Egress: &PodHttpChaosEgress{Targets: []string{"2001:db8::/64"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "stream response body",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo33",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Stream: &PodHttpChaosStreamActions{Rate: &rate, Ttfb: &ttfb, Truncate: &truncate},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "stream request body",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo34",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Stream: &PodHttpChaosStreamActions{Rate: &rate},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid stream rate",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo35",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Stream: &PodHttpChaosStreamActions{Rate: &negative},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid ttfb",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo36",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Stream: &PodHttpChaosStreamActions{Ttfb: &invalidTtfb},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid truncate",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo37",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Stream: &PodHttpChaosStreamActions{Truncate: &negative},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "auto tls with injected ca",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo38",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    443,
+							Target:  PodHttpRequest,
+							Egress:  &PodHttpChaosEgress{Targets: []string{"www.example.com"}},
+							AutoTLS: &HTTPChaosAutoTLS{InjectCA: true},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "auto tls with tls",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo39",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    443,
+							Target:  PodHttpRequest,
+							TLS:     &PodHttpChaosTLS{SecretName: "foo", SecretNamespace: "default", CertName: "tls.crt", KeyName: "tls.key"},
+							AutoTLS: &HTTPChaosAutoTLS{Hosts: []string{"foo.default.svc"}},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "inject ca without egress",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo40",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    443,
+							Target:  PodHttpRequest,
+							AutoTLS: &HTTPChaosAutoTLS{InjectCA: true},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "observe",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo41",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Observe: &observe,
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "observe with other actions",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo42",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Observe: &observe,
+								Delay:   &valideDelay,
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "observe with percent",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo43",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Observe: &observe,
+							},
+							Percent: &validPercent,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "frame actions",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo44",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Frames: &PodHttpChaosFrameActions{DropAfterFrames: &dropFrames, Delay: &valideDelay, CloseCode: &closeCode},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "ok",
+				},
+				{
+					name: "frame actions with invalid corrupt",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo45",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Frames: &PodHttpChaosFrameActions{Corrupt: &invalidCorrupt},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "frame actions with reserved close code",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo46",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Frames: &PodHttpChaosFrameActions{DropAfterFrames: &dropFrames, CloseCode: &reservedCloseCode},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "close code without drop",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo47",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpResponse,
+							PodHttpChaosActions: PodHttpChaosActions{
+								Frames: &PodHttpChaosFrameActions{CloseCode: &closeCode},
+							},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
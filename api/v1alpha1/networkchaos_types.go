@@ -16,6 +16,8 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -44,6 +46,7 @@ type NetworkChaos struct {
 var _ InnerObjectWithCustomStatus = (*NetworkChaos)(nil)
 var _ InnerObjectWithSelector = (*NetworkChaos)(nil)
 var _ InnerObject = (*NetworkChaos)(nil)
+var _ ConflictedObject = (*NetworkChaos)(nil)
 
 // NetworkChaosAction represents the chaos action about network.
 type NetworkChaosAction string
@@ -70,6 +73,14 @@ const (
 
 	// BandwidthAction represents the chaos action of network bandwidth of pods.
 	BandwidthAction NetworkChaosAction = "bandwidth"
+
+	// ResetAction represents the chaos action of rejecting tcp packets with tcp reset,
+	// it works like partition but the connections are reset immediately.
+	ResetAction NetworkChaosAction = "reset"
+
+	// RejectAction represents the chaos action of rejecting packets with icmp port unreachable,
+	// it works like partition but the connections are refused immediately.
+	RejectAction NetworkChaosAction = "reject"
 )
 
 // Direction represents traffic direction from source to target,
@@ -93,9 +104,9 @@ type NetworkChaosSpec struct {
 	PodSelector `json:",inline"`
 
 	// Action defines the specific network chaos action.
-	// Supported action: partition, netem, delay, loss, duplicate, corrupt
+	// Supported action: partition, netem, delay, loss, duplicate, corrupt, reset, reject
 	// Default action: delay
-	// +kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;partition;bandwidth
+	// +kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;partition;bandwidth;reset;reject
 	Action NetworkChaosAction `json:"action"`
 
 	// Device represents the network device to be affected.
@@ -108,6 +119,27 @@ type NetworkChaosSpec struct {
 	// TcParameter represents the traffic control definition
 	TcParameter `json:",inline"`
 
+	// Reject represents the detail about reset and reject action
+	// +ui:form:when=action=='reset'||action=='reject'
+	// +optional
+	Reject *RejectSpec `json:"reject,omitempty"`
+
+	// Profile represents how the network fault changes over time,
+	// this applies on netem, delay and loss action.
+	// +optional
+	Profile *NetworkProfileSpec `json:"profile,omitempty"`
+
+	// Flap represents the chaos is toggled on and off periodically in one experiment,
+	// and every cycle starts with the chaos injected.
+	// +optional
+	Flap *NetworkFlapSpec `json:"flap,omitempty"`
+
+	// PortFilter limits the chaos to the packets with specific protocol and ports,
+	// this applies on netem, bandwidth, partition, reset and reject action.
+	// The ports are described in the direction from the selected pods to the target,
+	// and they are swapped automatically for the packets in the opposite direction.
+	PortFilter `json:",inline"`
+
 	// Direction represents the direction, this applies on netem and network partition action
 	// +optional
 	// +kubebuilder:validation:Enum=to;from;both
@@ -126,17 +158,269 @@ type NetworkChaosSpec struct {
 	// +optional
 	ExternalTargets []string `json:"externalTargets,omitempty"`
 
+	// ShapeIngress represents shaping the traffic of `from` direction on the ingress of
+	// the selected pods through an IFB device, instead of the egress of the target pods.
+	// It allows the `from` and `both` direction to work with external targets and
+	// pods outside the control of Chaos Mesh, this applies on netem and bandwidth action.
+	// +optional
+	ShapeIngress bool `json:"shapeIngress,omitempty"`
+
+	// Matrix represents different traffic controls between the selected pods and different targets,
+	// every entry is applied with its own filter, so one chaos could describe the links between
+	// several regions. It only works with netem action, and the traffic control, target and
+	// external targets of the spec must be empty when Matrix is set.
+	// +optional
+	// +kubebuilder:validation:MaxItems=13
+	Matrix []NetworkMatrixEntry `json:"matrix,omitempty"`
+
+	// ConflictPolicy decides what happens when the rules of this chaos overlap with the rules of
+	// other NetworkChaos on the same pod. reject refuses to inject this chaos on the pod, last-wins
+	// suspends the overlapping rules of the others until this chaos is recovered, and merge-additive
+	// keeps all the rules.
+	// Default: merge-additive
+	// +optional
+	// +kubebuilder:validation:Enum=reject;last-wins;merge-additive
+	ConflictPolicy NetworkConflictPolicy `json:"conflictPolicy,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
 }
 
+// NetworkConflictPolicy represents how the overlapping rules of NetworkChaos on the same pod are resolved
+type NetworkConflictPolicy string
+
+const (
+	// RejectConflictPolicy represents the chaos is not injected on the pod with overlapping rules
+	RejectConflictPolicy NetworkConflictPolicy = "reject"
+
+	// LastWinsConflictPolicy represents the overlapping rules of other chaos are suspended on the pod,
+	// and they are applied again when this chaos is recovered
+	LastWinsConflictPolicy NetworkConflictPolicy = "last-wins"
+
+	// MergeAdditiveConflictPolicy represents all the overlapping rules are kept on the pod. The traffic
+	// controls without filter or with the same filter are chained, so their effects add up. For the
+	// traffic controls with partially overlapping filters, the packets in the overlap are shaped by
+	// the one applied last.
+	MergeAdditiveConflictPolicy NetworkConflictPolicy = "merge-additive"
+)
+
+// RejectSpec defines detail of reset and reject action
+type RejectSpec struct {
+	// Probability represents the percentage of packets to be rejected, default 100
+	// +optional
+	Probability string `json:"probability,omitempty"`
+}
+
+// NetworkMatrixEntry represents the traffic control between the selected pods and a target
+type NetworkMatrixEntry struct {
+	// Target represents the pods on the other side of the link
+	// +optional
+	Target *PodSelector `json:"target,omitempty" webhook:",nilable"`
+
+	// ExternalTargets represents the network targets outside k8s on the other side of the link
+	// +optional
+	ExternalTargets []string `json:"externalTargets,omitempty"`
+
+	// TcParameter represents the traffic control on this link,
+	// delay, loss, duplicate, corrupt, rate and bandwidth are supported.
+	TcParameter `json:",inline"`
+}
+
+// PortFilter represents the protocol and ports of the affected packets
+type PortFilter struct {
+	// Protocol represents the protocol of the affected packets.
+	// Supported protocol: tcp, udp, icmp
+	// +optional
+	// +kubebuilder:validation:Enum=tcp;udp;icmp
+	Protocol string `json:"protocol,omitempty"`
+
+	// SourcePorts represents the source ports of the affected packets.
+	// It could be a single port, a range or a list, e.g. "80", "8000:8080" or "80,443".
+	// Only available when the protocol is tcp or udp.
+	// +optional
+	SourcePorts string `json:"sourcePorts,omitempty" webhook:"Ports"`
+
+	// DestinationPorts represents the destination ports of the affected packets.
+	// It could be a single port, a range or a list, e.g. "80", "8000:8080" or "80,443".
+	// Only available when the protocol is tcp or udp.
+	// +optional
+	DestinationPorts string `json:"destinationPorts,omitempty" webhook:"Ports"`
+}
+
+// Reverse returns the PortFilter for the packets in the opposite direction
+func (in PortFilter) Reverse() PortFilter {
+	return PortFilter{
+		Protocol:         in.Protocol,
+		SourcePorts:      in.DestinationPorts,
+		DestinationPorts: in.SourcePorts,
+	}
+}
+
 // NetworkChaosStatus defines the observed state of NetworkChaos
 type NetworkChaosStatus struct {
 	ChaosStatus `json:",inline"`
+
+	NetworkInjectionStatus `json:",inline"`
+
+	// Profile represents the current phase of the network profile
+	// +optional
+	Profile *NetworkProfileStatus `json:"profile,omitempty"`
+
+	// Flap represents the current cycle of flapping
+	// +optional
+	Flap *NetworkFlapStatus `json:"flap,omitempty"`
+}
+
+// NetworkInjectionStatus represents the status changed by the injection and recovery of
+// NetworkChaos, which is saved together with the records
+type NetworkInjectionStatus struct {
 	// Instances always specifies podnetworkchaos generation or empty
 	// +optional
 	Instances map[string]int64 `json:"instances,omitempty"`
+
+	// Conflicts represents the rules of this chaos overlapping with the rules of other NetworkChaos
+	// +optional
+	Conflicts []NetworkConflict `json:"conflicts,omitempty"`
+}
+
+// NetworkConflict represents the rules of this chaos overlap with the rules of another NetworkChaos on a pod
+type NetworkConflict struct {
+	// Pod is the namespaced name of the pod
+	Pod string `json:"pod"`
+
+	// Source is the namespaced name of the other NetworkChaos
+	Source string `json:"source"`
+
+	// Rules describes the overlapping rules
+	Rules string `json:"rules"`
+
+	// Policy is the conflict policy which resolved this conflict
+	Policy NetworkConflictPolicy `json:"policy"`
+}
+
+// IsConflicted returns whether the rules of this chaos overlap with the rules of other NetworkChaos
+func (in *NetworkChaos) IsConflicted() bool {
+	return len(in.Status.Conflicts) > 0
+}
+
+// NetworkFlapSpec defines the cadence of toggling the chaos on and off
+type NetworkFlapSpec struct {
+	// UpDuration represents how long the network works normally in every cycle
+	UpDuration string `json:"upDuration" webhook:"Duration"`
+
+	// DownDuration represents how long the chaos is injected in every cycle
+	DownDuration string `json:"downDuration" webhook:"Duration"`
+
+	// Jitter represents the max random duration added to every down phase, and the rest of
+	// jitter is added to the following up phase, so every cycle lasts for up+down+jitter
+	// +optional
+	Jitter string `json:"jitter,omitempty" webhook:"Duration"`
+}
+
+// NetworkFlapStatus represents the current cycle of flapping
+type NetworkFlapStatus struct {
+	// Cycle represents the number of cycles which have started
+	Cycle int `json:"cycle"`
+
+	// Down represents whether the chaos is injected currently
+	// +optional
+	Down bool `json:"down,omitempty"`
+}
+
+// NetworkProfileType represents how the network fault changes between the steps of profile
+type NetworkProfileType string
+
+const (
+	// RampProfile represents the network fault changes linearly between adjacent steps
+	RampProfile NetworkProfileType = "ramp"
+
+	// StepProfile represents the network fault keeps the value of a step until the next step
+	StepProfile NetworkProfileType = "step"
+
+	// SineProfile represents the network fault oscillates between the first two steps over the period
+	SineProfile NetworkProfileType = "sine"
+
+	// TraceProfile represents the network fault replays the steps of a recorded trace as StepProfile
+	TraceProfile NetworkProfileType = "trace"
+)
+
+// NetworkProfileSpec defines how the network fault changes over time
+type NetworkProfileSpec struct {
+	// Type represents how the network fault changes between steps.
+	// Supported type: ramp, step, sine, trace
+	// +kubebuilder:validation:Enum=ramp;step;sine;trace
+	Type NetworkProfileType `json:"type"`
+
+	// Steps represents the key points of profile, it's required by ramp, step and sine profile.
+	// The first two steps are the lowest and highest points of sine profile.
+	// +optional
+	Steps []NetworkProfileStep `json:"steps,omitempty"`
+
+	// Period represents the period of sine profile
+	// +optional
+	Period string `json:"period,omitempty" webhook:"Duration"`
+
+	// Trace represents the recorded trace of trace profile
+	// +optional
+	Trace *NetworkTraceSource `json:"trace,omitempty"`
+
+	// Interval represents how often the traffic control is updated, default 1s
+	// +optional
+	Interval string `json:"interval,omitempty" webhook:"Duration"`
+}
+
+// NetworkProfileStep represents a key point of network profile
+type NetworkProfileStep struct {
+	// Offset represents the time since the chaos is injected, it restarts after the chaos is resumed
+	Offset string `json:"offset" webhook:"Duration"`
+
+	// Latency represents the delay of packets
+	// +optional
+	Latency string `json:"latency,omitempty" webhook:"Duration"`
+
+	// Loss represents the percentage of lost packets
+	// +optional
+	Loss string `json:"loss,omitempty"`
+
+	// Rate represents the speed limit of packets.
+	// Allows bit, kbit, mbit, gbit, tbit, bps, kbps, mbps, gbps, tbps unit. bps means bytes per second.
+	// +optional
+	Rate string `json:"rate,omitempty"`
+}
+
+// NetworkTraceSource represents a recorded trace in ConfigMap.
+// Every line of the trace is `<offset>,<latency>,<loss>,<rate>`, e.g. `30s,100ms,1,10mbps`,
+// and the empty fields are ignored.
+type NetworkTraceSource struct {
+	// Name represents the name of ConfigMap in the namespace of chaos
+	Name string `json:"name"`
+
+	// Key represents the key of trace in ConfigMap
+	Key string `json:"key"`
+}
+
+// NetworkProfileStatus represents the current phase of network profile
+type NetworkProfileStatus struct {
+	// CurrentStep represents the index of step which the profile is in,
+	// or the index of cycle for sine profile
+	CurrentStep int `json:"currentStep"`
+
+	// Finished represents whether the profile has passed the last step
+	// +optional
+	Finished bool `json:"finished,omitempty"`
+
+	// Latency represents the current delay of packets
+	// +optional
+	Latency string `json:"latency,omitempty"`
+
+	// Loss represents the current percentage of lost packets
+	// +optional
+	Loss string `json:"loss,omitempty"`
+
+	// Rate represents the current speed limit of packets
+	// +optional
+	Rate string `json:"rate,omitempty"`
 }
 
 // DelaySpec defines detail of a delay action
@@ -220,9 +504,19 @@ func (obj *NetworkChaos) GetSelectorSpecs() map[string]interface{} {
 	if obj.Spec.Target != nil {
 		selectors[".Target"] = obj.Spec.Target
 	}
+	for i := range obj.Spec.Matrix {
+		if obj.Spec.Matrix[i].Target != nil {
+			selectors[MatrixTargetSelectorKey(i)] = obj.Spec.Matrix[i].Target
+		}
+	}
 	return selectors
 }
 
+// MatrixTargetSelectorKey returns the selector key of the target of i-th matrix entry
+func MatrixTargetSelectorKey(index int) string {
+	return fmt.Sprintf(".Matrix[%d].Target", index)
+}
+
 func (obj *NetworkChaos) GetCustomStatus() interface{} {
-	return &obj.Status.Instances
+	return &obj.Status.NetworkInjectionStatus
 }
@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -85,23 +86,147 @@ func isValidRateUnit(nu string) (bool, error) {
 	return false, errors.New("invalid unit")
 }
 
+// maxMultiPorts is the max number of ports supported by iptables multiport
+const maxMultiPorts = 15
+
+type Ports string
+
+// Validate validates the ports are a single port, a range or a list, e.g. "80", "8000:8080" or "80,443"
+func (in *Ports) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in == nil || len(*in) == 0 {
+		// allow ports to be empty, which means all ports
+		return allErrs
+	}
+
+	items := strings.Split(string(*in), ",")
+	if len(items) > maxMultiPorts {
+		allErrs = append(allErrs,
+			field.Invalid(path, in,
+				fmt.Sprintf("at most %d ports can be specified", maxMultiPorts)))
+		return allErrs
+	}
+
+	for _, item := range items {
+		if err := validatePortRange(item); err != nil {
+			allErrs = append(allErrs,
+				field.Invalid(path, in,
+					fmt.Sprintf("parse ports field error:%s", err)))
+		}
+	}
+	return allErrs
+}
+
+func validatePortRange(portRange string) error {
+	bounds := strings.Split(portRange, ":")
+	if len(bounds) > 2 {
+		return errors.Errorf("invalid port range %q", portRange)
+	}
+
+	var ports []uint64
+	for _, bound := range bounds {
+		port, err := strconv.ParseUint(bound, 10, 16)
+		if err != nil {
+			return err
+		}
+		if port == 0 {
+			return errors.Errorf("port %q should be greater than 0", bound)
+		}
+		ports = append(ports, port)
+	}
+
+	if len(ports) == 2 && ports[0] > ports[1] {
+		return errors.Errorf("the start of port range %q is greater than the end", portRange)
+	}
+	return nil
+}
+
+// validatePortFilter validates the ports are used with a protocol which has ports
+func (in *PortFilter) validatePortFilter(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.SourcePorts == "" && in.DestinationPorts == "" {
+		return allErrs
+	}
+
+	if in.Protocol != "tcp" && in.Protocol != "udp" {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("protocol"), in.Protocol,
+				"protocol should be tcp or udp when source ports or destination ports are specified"))
+	}
+	return allErrs
+}
+
 // ValidateTargets validates externalTargets and Targets
 func (in *NetworkChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
-	allErrs := field.ErrorList{}
+	allErrs := in.PortFilter.validatePortFilter(path)
 
-	if in.Action == PartitionAction {
-		return nil
+	if in.Profile != nil && in.Action != NetemAction && in.Action != DelayAction && in.Action != LossAction {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("profile"), in.Action,
+				"profile can only be used with netem, delay and loss action"))
+	}
+
+	if in.Flap != nil && in.Profile != nil {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("flap"), in.Flap,
+				"flap cannot be used with profile"))
+	}
+
+	if in.Reject != nil && in.Action != ResetAction && in.Action != RejectAction {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("reject"), in.Action,
+				"reject can only be used with reset and reject action"))
+	}
+
+	if in.Action == ResetAction && in.Protocol != "" && in.Protocol != "tcp" {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("protocol"), in.Protocol,
+				"reset action only works with tcp protocol"))
+	}
+
+	if in.ShapeIngress && in.Action.isIptablesAction() {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("shapeIngress"), in.Action,
+				"shaping ingress can only be used with netem and bandwidth action"))
+	}
+
+	if in.ShapeIngress && (in.Direction == To || in.Direction == "") {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("shapeIngress"), in.Direction,
+				"shaping ingress can only be used with `from` and `both` direction"))
+	}
+
+	if len(in.Matrix) > 0 {
+		return append(allErrs, in.validateMatrix(path)...)
+	}
+
+	if in.Action.isIptablesAction() {
+		return allErrs
+	}
+
+	if in.ShapeIngress {
+		if in.Protocol != "" || in.SourcePorts != "" || in.DestinationPorts != "" {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("shapeIngress"), in.ShapeIngress,
+					"protocol and ports cannot be used with shaping ingress yet"))
+		}
+
+		// the `from` traffic is shaped on the ingress of the selected pods, so
+		// it doesn't rely on the targets.
+		return allErrs
 	}
 
 	if (in.Direction == From || in.Direction == Both) &&
-		in.ExternalTargets != nil && in.Action != PartitionAction {
+		in.ExternalTargets != nil && !in.Action.isIptablesAction() {
 		allErrs = append(allErrs,
 			field.Invalid(path.Child("direction"), in.Direction,
 				"external targets cannot be used with `from` and `both` direction in netem action yet"))
 	}
 
 	if (in.Direction == From || in.Direction == Both) && in.Target == nil {
-		if in.Action != PartitionAction {
+		if !in.Action.isIptablesAction() {
 			allErrs = append(allErrs,
 				field.Invalid(path.Child("direction"), in.Direction,
 					"`from` and `both` direction cannot be used when targets is empty in netem action"))
@@ -116,6 +241,174 @@ func (in *NetworkChaosSpec) Validate(root interface{}, path *field.Path) field.E
 	return allErrs
 }
 
+// validateMatrix validates the entries of matrix and the fields conflicting with it
+func (in *NetworkChaosSpec) validateMatrix(path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if in.Action != NetemAction {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("action"), in.Action,
+				"matrix can only be used with netem action"))
+	}
+	if in.TcParameter != (TcParameter{}) || in.Profile != nil {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("matrix"), len(in.Matrix),
+				"the traffic control and profile of spec cannot be used with matrix"))
+	}
+	if in.Target != nil || len(in.ExternalTargets) > 0 {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("matrix"), len(in.Matrix),
+				"target and external targets of spec cannot be used with matrix"))
+	}
+	if in.ShapeIngress && (in.Protocol != "" || in.SourcePorts != "" || in.DestinationPorts != "") {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("shapeIngress"), in.ShapeIngress,
+				"protocol and ports cannot be used with shaping ingress yet"))
+	}
+
+	for i, entry := range in.Matrix {
+		entryPath := path.Child("matrix").Index(i)
+
+		if entry.Target == nil && len(entry.ExternalTargets) == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(entryPath, entry,
+					"either target or external targets is required by the entry of matrix"))
+		}
+		if entry.TcParameter == (TcParameter{}) {
+			allErrs = append(allErrs,
+				field.Invalid(entryPath, entry,
+					"traffic control is required by the entry of matrix"))
+		}
+
+		// the `from` traffic is shaped on the ingress of the selected pods, so
+		// it doesn't rely on the targets.
+		if in.ShapeIngress || in.Direction == To || in.Direction == "" {
+			continue
+		}
+		if len(entry.ExternalTargets) > 0 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("direction"), in.Direction,
+					"external targets cannot be used with `from` and `both` direction in netem action yet"))
+		}
+		if entry.Target == nil {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("direction"), in.Direction,
+					"`from` and `both` direction cannot be used when targets is empty in netem action"))
+		}
+	}
+
+	return allErrs
+}
+
+// isIptablesAction returns whether the action is implemented by the iptables rules
+// on the selected pods and targets
+func (in NetworkChaosAction) isIptablesAction() bool {
+	return in == PartitionAction || in == ResetAction || in == RejectAction
+}
+
+// Validate validates the durations of flapping
+func (in *NetworkFlapSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if up, err := time.ParseDuration(in.UpDuration); len(in.UpDuration) == 0 || (err == nil && up <= 0) {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("upDuration"), in.UpDuration,
+				"upDuration should be greater than 0"))
+	}
+	if down, err := time.ParseDuration(in.DownDuration); len(in.DownDuration) == 0 || (err == nil && down <= 0) {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("downDuration"), in.DownDuration,
+				"downDuration should be greater than 0"))
+	}
+	if jitter, err := time.ParseDuration(in.Jitter); err == nil && jitter < 0 {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child("jitter"), in.Jitter,
+				"jitter should not be negative"))
+	}
+
+	return allErrs
+}
+
+// Validate validates the probability of reject
+func (in *RejectSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(in.Probability) > 0 {
+		probability, err := strconv.ParseFloat(in.Probability, 32)
+		if err != nil || probability <= 0 || probability > 100 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("probability"), in.Probability,
+					"probability should be a percentage between 0 and 100"))
+		}
+	}
+
+	return allErrs
+}
+
+// Validate validates the steps of network profile
+func (in *NetworkProfileSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Type {
+	case RampProfile, StepProfile:
+		if len(in.Steps) == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("steps"), in.Steps,
+					fmt.Sprintf("steps are required by %s profile", in.Type)))
+		}
+	case SineProfile:
+		if len(in.Steps) != 2 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("steps"), in.Steps,
+					"sine profile requires exactly two steps as the lowest and highest points"))
+		}
+		if len(in.Period) == 0 {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("period"), in.Period,
+					"period is required by sine profile"))
+		}
+	case TraceProfile:
+		if in.Trace == nil {
+			allErrs = append(allErrs,
+				field.Invalid(path.Child("trace"), in.Trace,
+					"trace is required by trace profile"))
+		}
+	}
+
+	var lastOffset time.Duration
+	for i, step := range in.Steps {
+		stepPath := path.Child("steps").Index(i)
+
+		offset, err := time.ParseDuration(step.Offset)
+		if err == nil && offset < lastOffset {
+			allErrs = append(allErrs,
+				field.Invalid(stepPath.Child("offset"), step.Offset,
+					"the offsets of steps should be in ascending order"))
+		}
+		lastOffset = offset
+
+		if len(step.Loss) > 0 {
+			loss, err := strconv.ParseFloat(step.Loss, 32)
+			if err != nil || loss < 0 || loss > 100 {
+				allErrs = append(allErrs,
+					field.Invalid(stepPath.Child("loss"), step.Loss,
+						"loss should be a percentage between 0 and 100"))
+			}
+		}
+
+		if len(step.Rate) > 0 {
+			if _, err := isValidRateUnit(step.Rate); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(stepPath.Child("rate"), step.Rate,
+						fmt.Sprintf("parse rate field error:%s", err)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("Rate", reflect.PtrTo(reflect.TypeOf(Rate(""))))
+	genericwebhook.Register("Ports", reflect.PtrTo(reflect.TypeOf(Ports(""))))
 }
@@ -294,6 +294,516 @@ var _ = Describe("networkchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the ports",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo13",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							PortFilter: PortFilter{
+								Protocol:         "tcp",
+								DestinationPorts: "8080:80",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the ports without protocol",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo14",
+						},
+						Spec: NetworkChaosSpec{
+							PortFilter: PortFilter{
+								SourcePorts: "5432",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the protocol and ports",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo15",
+						},
+						Spec: NetworkChaosSpec{
+							PortFilter: PortFilter{
+								Protocol:         "udp",
+								SourcePorts:      "53",
+								DestinationPorts: "80,443,8000:8080",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate shaping ingress with externalTargets",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo16",
+						},
+						Spec: NetworkChaosSpec{
+							Direction:       From,
+							ExternalTargets: []string{"8.8.8.8"},
+							ShapeIngress:    true,
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate shaping ingress with ports",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo17",
+						},
+						Spec: NetworkChaosSpec{
+							Direction:    From,
+							ShapeIngress: true,
+							PortFilter: PortFilter{
+								Protocol:    "tcp",
+								SourcePorts: "5432",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate ramp profile",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo18",
+						},
+						Spec: NetworkChaosSpec{
+							Action: DelayAction,
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:     "10ms",
+									Correlation: "0",
+								},
+							},
+							Profile: &NetworkProfileSpec{
+								Type: RampProfile,
+								Steps: []NetworkProfileStep{
+									{Offset: "0s", Latency: "10ms", Loss: "0"},
+									{Offset: "1m", Latency: "200ms", Loss: "10", Rate: "1mbps"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate profile with descending offsets",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo19",
+						},
+						Spec: NetworkChaosSpec{
+							Action: DelayAction,
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:     "10ms",
+									Correlation: "0",
+								},
+							},
+							Profile: &NetworkProfileSpec{
+								Type: StepProfile,
+								Steps: []NetworkProfileStep{
+									{Offset: "1m", Latency: "10ms"},
+									{Offset: "30s", Latency: "200ms"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate sine profile without period",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo20",
+						},
+						Spec: NetworkChaosSpec{
+							Action: LossAction,
+							TcParameter: TcParameter{
+								Loss: &LossSpec{
+									Loss:        "1",
+									Correlation: "0",
+								},
+							},
+							Profile: &NetworkProfileSpec{
+								Type: SineProfile,
+								Steps: []NetworkProfileStep{
+									{Offset: "0s", Loss: "1"},
+									{Offset: "0s", Loss: "50"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate profile with bandwidth action",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: NetworkChaosSpec{
+							Action: BandwidthAction,
+							TcParameter: TcParameter{
+								Bandwidth: &BandwidthSpec{
+									Rate:   "1mbps",
+									Limit:  1,
+									Buffer: 1,
+								},
+							},
+							Profile: &NetworkProfileSpec{
+								Type: TraceProfile,
+								Trace: &NetworkTraceSource{
+									Name: "trace",
+									Key:  "trace.csv",
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate matrix",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: NetworkChaosSpec{
+							Action:    NetemAction,
+							Direction: Both,
+							Matrix: []NetworkMatrixEntry{
+								{
+									Target: &PodSelector{
+										Mode: AllMode,
+										Selector: PodSelectorSpec{
+											GenericSelectorSpec: GenericSelectorSpec{
+												LabelSelectors: map[string]string{"region": "eu-west"},
+											},
+										},
+									},
+									TcParameter: TcParameter{
+										Delay: &DelaySpec{
+											Latency:     "80ms",
+											Correlation: "0",
+										},
+									},
+								},
+								{
+									Target: &PodSelector{
+										Mode: AllMode,
+										Selector: PodSelectorSpec{
+											GenericSelectorSpec: GenericSelectorSpec{
+												LabelSelectors: map[string]string{"region": "ap-south"},
+											},
+										},
+									},
+									TcParameter: TcParameter{
+										Delay: &DelaySpec{
+											Latency:     "200ms",
+											Correlation: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate matrix with traffic control of spec",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: NetworkChaosSpec{
+							Action: NetemAction,
+							TcParameter: TcParameter{
+								Delay: &DelaySpec{
+									Latency:     "80ms",
+									Correlation: "0",
+								},
+							},
+							Matrix: []NetworkMatrixEntry{
+								{
+									ExternalTargets: []string{"8.8.8.8"},
+									TcParameter: TcParameter{
+										Loss: &LossSpec{
+											Loss:        "10",
+											Correlation: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate matrix entry without target",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo24",
+						},
+						Spec: NetworkChaosSpec{
+							Action: NetemAction,
+							Matrix: []NetworkMatrixEntry{
+								{
+									TcParameter: TcParameter{
+										Loss: &LossSpec{
+											Loss:        "10",
+											Correlation: "0",
+										},
+									},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate reset action",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo25",
+						},
+						Spec: NetworkChaosSpec{
+							Action:          ResetAction,
+							Direction:       Both,
+							ExternalTargets: []string{"8.8.8.8"},
+							PortFilter: PortFilter{
+								Protocol:         "tcp",
+								DestinationPorts: "443",
+							},
+							Reject: &RejectSpec{
+								Probability: "50",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate reset action with udp",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo26",
+						},
+						Spec: NetworkChaosSpec{
+							Action: ResetAction,
+							PortFilter: PortFilter{
+								Protocol: "udp",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate reject action with invalid probability",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo27",
+						},
+						Spec: NetworkChaosSpec{
+							Action: RejectAction,
+							Reject: &RejectSpec{
+								Probability: "150",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate flap",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo28",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Flap: &NetworkFlapSpec{
+								UpDuration:   "30s",
+								DownDuration: "10s",
+								Jitter:       "5s",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "validate flap without down duration",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo29",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Flap: &NetworkFlapSpec{
+								UpDuration: "30s",
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate flap with profile",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo30",
+						},
+						Spec: NetworkChaosSpec{
+							Action: PartitionAction,
+							Flap: &NetworkFlapSpec{
+								UpDuration:   "30s",
+								DownDuration: "10s",
+							},
+							Profile: &NetworkProfileSpec{
+								Type: StepProfile,
+								Steps: []NetworkProfileStep{
+									{Offset: "0s", Loss: "1"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shaping ingress with to direction",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo31",
+						},
+						Spec: NetworkChaosSpec{
+							Direction:       To,
+							ExternalTargets: []string{"8.8.8.8"},
+							ShapeIngress:    true,
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate shaping ingress with partition action",
+					chaos: NetworkChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo32",
+						},
+						Spec: NetworkChaosSpec{
+							Action:          PartitionAction,
+							Direction:       From,
+							ExternalTargets: []string{"8.8.8.8"},
+							ShapeIngress:    true,
+						},
+					},
+					execute: func(chaos *NetworkChaos) error {
+						_, err := chaos.ValidateCreate(context.Background(), chaos)
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -306,6 +816,19 @@ var _ = Describe("networkchaos_webhook", func() {
 			}
 		})
 	})
+	Context("validatePortRange", func() {
+		It("should accept a single port and a range", func() {
+			Expect(validatePortRange("80")).Should(Succeed())
+			Expect(validatePortRange("8000:8080")).Should(Succeed())
+		})
+
+		It("should return error with invalid port", func() {
+			Expect(validatePortRange("0")).Should(HaveOccurred())
+			Expect(validatePortRange("65536")).Should(HaveOccurred())
+			Expect(validatePortRange("80:90:100")).Should(HaveOccurred())
+			Expect(validatePortRange("http")).Should(HaveOccurred())
+		})
+	})
 	Context("isValidRateUnit", func() {
 		It("mbps unit, should convert number with unit successfully", func() {
 			isValid, err := isValidRateUnit("  10   mbPs  ")
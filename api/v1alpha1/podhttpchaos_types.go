@@ -31,11 +31,11 @@ type PodHttpChaosSpec struct {
 
 // PodHttpChaosStatus defines the actual state of PodHttpChaos.
 type PodHttpChaosStatus struct {
-	// Pid represents a running tproxy process id.
+	// Pid represents a running http proxy process id.
 	// +optional
 	Pid int64 `json:"pid,omitempty"`
 
-	// StartTime represents the start time of a tproxy process.
+	// StartTime represents the start time of a http proxy process.
 	// +optional
 	StartTime int64 `json:"startTime,omitempty"`
 
@@ -67,6 +67,31 @@ type PodHttpChaosBaseRule struct {
 
 	// Actions contains rules to inject target.
 	Actions PodHttpChaosActions `json:"actions"`
+
+	// Percent represents the percentage of the selected requests to be injected, from 0 to 100.
+	// All the selected requests are injected if it's not set.
+	// +optional
+	Percent *int `json:"percent,omitempty" webhook:"Percent"`
+
+	// RateLimit represents the maximum number of requests to be injected per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RateLimit *int32 `json:"rate_limit,omitempty" webhook:"RateLimit"`
+
+	// Egress represents this rule selects the outbound requests of the pod to the
+	// destinations, and the port in selector is the port of destinations.
+	// +optional
+	Egress *PodHttpChaosEgress `json:"egress,omitempty"`
+}
+
+// PodHttpChaosEgress represents the destinations of the outbound requests to be intercepted.
+type PodHttpChaosEgress struct {
+	// Targets represents the destinations, which are domain names, IPv4 addresses or CIDRs.
+	// The domain names are matched with the host of requests in the pod, and all the
+	// outbound connections to the port are intercepted if any target is a domain name.
+	// All the destinations are selected if it's empty.
+	// +optional
+	Targets []string `json:"targets,omitempty" webhook:"EgressTargets"`
 }
 
 type PodHttpChaosSelector struct {
@@ -95,6 +120,64 @@ type PodHttpChaosSelector struct {
 	// The key-value pairs represent header name and header value pairs.
 	// +optional
 	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+
+	// PathPattern is a rule to select target by matching uri path with a glob or regex pattern.
+	// +optional
+	PathPattern *PodHttpChaosPattern `json:"path_pattern,omitempty"`
+
+	// Queries is a rule to select target by uri queries in http request.
+	// The key-value pairs represent query name and the pattern of query value.
+	// +optional
+	Queries map[string]PodHttpChaosPattern `json:"queries,omitempty" webhook:"HTTPPatterns"`
+
+	// RequestHeaderPatterns is a rule to select target by http headers in request.
+	// The key-value pairs represent header name and the pattern of header value.
+	// +optional
+	RequestHeaderPatterns map[string]PodHttpChaosPattern `json:"request_header_patterns,omitempty" webhook:"HTTPPatterns"`
+
+	// RequestBody is a rule to select target by the contents of http request body.
+	// +optional
+	RequestBody *PodHttpChaosBodySelector `json:"request_body,omitempty"`
+}
+
+// PodHttpChaosPatternType represents how a pattern is matched.
+type PodHttpChaosPatternType string
+
+const (
+	// ExactPattern represents the value should be equal to the pattern
+	ExactPattern PodHttpChaosPatternType = "Exact"
+
+	// GlobPattern represents the value should match the shell glob pattern of Go `path.Match`,
+	// where `*` doesn't match `/`, such as `/api/*/orders`
+	GlobPattern PodHttpChaosPatternType = "Glob"
+
+	// RegexPattern represents the value should match the RE2 regular expression, which matches
+	// any part of the value unless it's anchored, such as `^/api/v[12]/`
+	RegexPattern PodHttpChaosPatternType = "Regex"
+)
+
+// PodHttpChaosPattern is a pattern to match a value in http request.
+type PodHttpChaosPattern struct {
+	// Type is the type of pattern, <Exact|Glob|Regex>, default Exact.
+	// +optional
+	// +kubebuilder:validation:Enum=Exact;Glob;Regex
+	Type PodHttpChaosPatternType `json:"type,omitempty"`
+
+	// Value is the pattern.
+	Value string `json:"value"`
+}
+
+// PodHttpChaosBodySelector selects http request by the contents of body.
+type PodHttpChaosBodySelector struct {
+	// JSONPath locates the value to be matched in a JSON body, such as `$.operationName`
+	// or `$.items[0].tenant`. A string is matched without quotes, and the other values are
+	// matched in JSON. The whole body is matched if it's empty, and the bodies larger than
+	// 1MiB are not selected.
+	// +optional
+	JSONPath string `json:"json_path,omitempty"`
+
+	// Pattern is the pattern to match the value.
+	Pattern PodHttpChaosPattern `json:"pattern"`
 }
 
 // PodHttpChaosActions defines possible actions of HttpChaos.
@@ -118,6 +201,76 @@ type PodHttpChaosActions struct {
 	// Patch is a rule to patch some contents in target.
 	// +optional
 	Patch *PodHttpChaosPatchActions `json:"patch,omitempty"`
+
+	// Stream is a rule to slow down or truncate the response body.
+	// +optional
+	Stream *PodHttpChaosStreamActions `json:"stream,omitempty"`
+
+	// Frames is a rule to inject the frames of upgraded connections, such as the
+	// messages of WebSocket and the events of server-sent events.
+	// +optional
+	Frames *PodHttpChaosFrameActions `json:"frames,omitempty"`
+
+	// Observe represents the selected requests are only counted by path, method,
+	// status code and latency without being changed, which cannot be set with
+	// other actions.
+	// +optional
+	Observe *bool `json:"observe,omitempty" webhook:"Observe"`
+}
+
+// PodHttpChaosStreamActions defines the actions on streaming the response body of HttpChaos.
+type PodHttpChaosStreamActions struct {
+	// Rate represents the response body is sent at this rate in bytes per second.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Rate *int64 `json:"rate,omitempty"`
+
+	// Ttfb represents the delay before the first byte of response is sent,
+	// which is separated from the delay of the whole response.
+	// +optional
+	Ttfb *string `json:"ttfb,omitempty"`
+
+	// Truncate represents the connection is cut after this number of bytes of
+	// response body are sent.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Truncate *int64 `json:"truncate,omitempty"`
+}
+
+// PodHttpChaosFrameActions defines the actions on the frames of WebSocket connections
+// and server-sent events. The frames sent by the client are injected if the target is
+// Request, and the frames sent by the server are injected if the target is Response.
+type PodHttpChaosFrameActions struct {
+	// DropAfterFrames represents the connection is closed after this number of frames.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	DropAfterFrames *int64 `json:"drop_after_frames,omitempty"`
+
+	// DropAfter represents the connection is closed after this duration since
+	// it's upgraded, such as "30s".
+	// +optional
+	DropAfter *string `json:"drop_after,omitempty"`
+
+	// Delay represents the delay of every frame.
+	// +optional
+	Delay *string `json:"delay,omitempty"`
+
+	// Replace represents the payload of every frame is replaced by it.
+	// +optional
+	Replace []byte `json:"replace,omitempty"`
+
+	// Corrupt represents the percentage of frames whose payload is corrupted
+	// with random bytes, from 0 to 100.
+	// +optional
+	Corrupt *int `json:"corrupt,omitempty"`
+
+	// CloseCode represents the close code of WebSocket sent when the connection
+	// is dropped, the connection is cut without a close frame if it's not set.
+	// It's not available for server-sent events.
+	// +optional
+	// +kubebuilder:validation:Minimum=1000
+	// +kubebuilder:validation:Maximum=4999
+	CloseCode *int32 `json:"close_code,omitempty"`
 }
 
 // PodHttpChaosPatchActions defines possible patch-actions of HttpChaos.
@@ -65,6 +65,14 @@ const (
 	NetIPSet     IPSetType = "hash:net"
 )
 
+// IPFamily represents the address family of an IP set
+type IPFamily string
+
+const (
+	IPv4Family IPFamily = "inet"
+	IPv6Family IPFamily = "inet6"
+)
+
 // RawIPSet represents an ipset on specific pod
 type RawIPSet struct {
 	// The name of ipset
@@ -72,6 +80,12 @@ type RawIPSet struct {
 
 	IPSetType IPSetType `json:"ipsetType"`
 
+	// The address family of ipset, default to inet.
+	// Not available when IPSetType is SetIPSet.
+	// +optional
+	// +kubebuilder:validation:Enum=inet;inet6
+	IPFamily IPFamily `json:"ipFamily,omitempty"`
+
 	// The contents of ipset.
 	// Only available when IPSetType is NetIPSet.
 	// +optional
@@ -128,9 +142,45 @@ type RawIptables struct {
 	// +optional
 	Device string `json:"device,omitempty"`
 
+	// PortFilter limits this iptables rule to the packets with specific protocol and ports
+	PortFilter `json:",inline"`
+
+	// RejectWith represents the packets are rejected with the response instead of being dropped.
+	// +optional
+	// +kubebuilder:validation:Enum=tcp-reset;icmp-port-unreachable
+	RejectWith RejectType `json:"rejectWith,omitempty"`
+
+	// Probability represents the percentage of packets matched by this iptables rule, default 100
+	// +optional
+	Probability string `json:"probability,omitempty"`
+
+	// Flap represents this iptables rule is toggled on and off periodically
+	// +optional
+	Flap *NetworkFlapSpec `json:"flap,omitempty"`
+
+	// FlapStartTime represents the time which the cycles of flapping start from
+	// +optional
+	FlapStartTime *metav1.Time `json:"flapStartTime,omitempty"`
+
+	// DisplacedBy represents this iptables rule is displaced by the overlapping rules of the
+	// source with last-wins conflict policy, it's not applied until they are cleared
+	// +optional
+	DisplacedBy string `json:"displacedBy,omitempty"`
+
 	RawRuleSource `json:",inline"`
 }
 
+// RejectType represents the response of rejected packets
+type RejectType string
+
+const (
+	// TCPReset represents rejecting the packets with tcp reset
+	TCPReset RejectType = "tcp-reset"
+
+	// ICMPPortUnreachable represents rejecting the packets with icmp port unreachable
+	ICMPPortUnreachable RejectType = "icmp-port-unreachable"
+)
+
 // TcType the type of traffic control
 type TcType string
 
@@ -159,6 +209,36 @@ type RawTrafficControl struct {
 	// Device represents the network device to be affected.
 	// +optional
 	Device string `json:"device,omitempty"`
+
+	// PortFilter limits this traffic control to the packets with specific protocol and ports
+	PortFilter `json:",inline"`
+
+	// Ingress represents this traffic control is applied on the ingress of the device
+	// through an IFB device, and the IPSet is matched with the source address.
+	// +optional
+	Ingress bool `json:"ingress,omitempty"`
+
+	// Profile represents how the netem changes over time, the steps of
+	// trace profile have been resolved from the trace
+	// +optional
+	Profile *NetworkProfileSpec `json:"profile,omitempty"`
+
+	// ProfileStartTime represents the time which the offsets of profile are relative to
+	// +optional
+	ProfileStartTime *metav1.Time `json:"profileStartTime,omitempty"`
+
+	// Flap represents this traffic control is toggled on and off periodically
+	// +optional
+	Flap *NetworkFlapSpec `json:"flap,omitempty"`
+
+	// FlapStartTime represents the time which the cycles of flapping start from
+	// +optional
+	FlapStartTime *metav1.Time `json:"flapStartTime,omitempty"`
+
+	// DisplacedBy represents this traffic control is displaced by the overlapping rules of the
+	// source with last-wins conflict policy, it's not applied until they are cleared
+	// +optional
+	DisplacedBy string `json:"displacedBy,omitempty"`
 }
 
 // TcParameter represents the parameters for a traffic control chaos
@@ -204,6 +284,11 @@ type PodNetworkChaosStatus struct {
 	FailedMessage string `json:"failedMessage,omitempty"`
 
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// DriftCount is the number of times the rules drifted from the spec on the pod,
+	// and were applied again by chaos daemon
+	// +optional
+	DriftCount int64 `json:"driftCount,omitempty"`
 }
 
 // +kubebuilder:object:root=true
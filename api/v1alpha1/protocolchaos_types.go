@@ -0,0 +1,153 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="protocol",type=string,JSONPath=`.spec.protocol`
+// +kubebuilder:printcolumn:name="action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+// +genclient
+
+// ProtocolChaos is the Schema for the protocolchaos API
+type ProtocolChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ProtocolChaosSpec   `json:"spec,omitempty"`
+	Status ProtocolChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithCustomStatus = (*ProtocolChaos)(nil)
+var _ InnerObjectWithSelector = (*ProtocolChaos)(nil)
+var _ InnerObject = (*ProtocolChaos)(nil)
+
+// ProtocolType represents the wire protocol parsed by ProtocolChaos
+type ProtocolType string
+
+const (
+	RedisProtocol ProtocolType = "redis"
+	MySQLProtocol ProtocolType = "mysql"
+	KafkaProtocol ProtocolType = "kafka"
+)
+
+// ProtocolChaosAction represents the chaos action about the commands of a wire protocol
+type ProtocolChaosAction string
+
+const (
+	// ProtocolErrorAction represents the chaos action of responding an error of the protocol
+	ProtocolErrorAction ProtocolChaosAction = "error"
+
+	// ProtocolDelayAction represents the chaos action of delaying the commands
+	ProtocolDelayAction ProtocolChaosAction = "delay"
+
+	// ProtocolAbortAction represents the chaos action of closing the connections
+	ProtocolAbortAction ProtocolChaosAction = "abort"
+)
+
+// ProtocolChaosSpec defines the desired state of ProtocolChaos
+type ProtocolChaosSpec struct {
+	PodSelector `json:",inline"`
+
+	// Action defines the specific protocol chaos action.
+	// Supported action: error;delay;abort
+	// +kubebuilder:validation:Enum=error;delay;abort
+	Action ProtocolChaosAction `json:"action"`
+
+	// Protocol represents the wire protocol of the target server.
+	// Supported protocol: redis;mysql;kafka
+	// +kubebuilder:validation:Enum=redis;mysql;kafka
+	Protocol ProtocolType `json:"protocol"`
+
+	// Port represents the port which the target server listens on.
+	// Only the plaintext traffic from outside the pod is proxied.
+	// The ProtocolChaos of the same protocol on the same port of a pod share a proxy,
+	// and a command is injected by the first of them selecting it in the order of
+	// namespaced names. The ProtocolChaos of another protocol on the port fails.
+	Port int32 `json:"port" webhook:"Port"`
+
+	// Command is a rule to select target by the name of command, such as `GET`
+	// of redis, `QUERY` of mysql or `Produce` of kafka. All commands are selected
+	// if it's empty.
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// KeyPattern is a rule to select the redis commands by the glob pattern of
+	// key, such as `session:*`.
+	// +ui:form:when=protocol=='redis'
+	// +optional
+	KeyPattern string `json:"keyPattern,omitempty"`
+
+	// QueryPattern is a rule to select the mysql commands by the regular
+	// expression of query, such as `^SELECT .* FROM orders`.
+	// +ui:form:when=protocol=='mysql'
+	// +optional
+	QueryPattern string `json:"queryPattern,omitempty"`
+
+	// Code represents the mysql error code returned by error action, default 1105.
+	// +ui:form:when=action=='error'
+	// +optional
+	Code *int32 `json:"code,omitempty"`
+
+	// Message represents the error message returned by error action.
+	// The message of redis starts with the error prefix, such as `ERR` or `READONLY`.
+	// +ui:form:when=action=='error'
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// Delay represents the delay of the target commands in delay action.
+	// A duration string is a possibly unsigned sequence of
+	// decimal numbers, each with optional fraction and a unit suffix,
+	// such as "300ms", "2h45m".
+	// +ui:form:when=action=='delay'
+	// +optional
+	Delay string `json:"delay,omitempty"`
+
+	// Duration represents the duration of the chaos action.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+}
+
+// ProtocolChaosStatus defines the observed state of ProtocolChaos
+type ProtocolChaosStatus struct {
+	ChaosStatus `json:",inline"`
+
+	// Instances always specifies the uid of protocol proxy on the pods or empty
+	// +optional
+	Instances map[string]string `json:"instances,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ProtocolChaos{}, &ProtocolChaosList{})
+}
+
+func (obj *ProtocolChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.PodSelector,
+	}
+}
+
+func (obj *ProtocolChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
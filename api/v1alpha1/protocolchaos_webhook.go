@@ -0,0 +1,88 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+	pathpkg "path"
+	"regexp"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// the range of error codes of mysql
+	minMySQLErrorCode int32 = 1
+	maxMySQLErrorCode int32 = 65535
+)
+
+func (in *ProtocolChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch in.Protocol {
+	case RedisProtocol, MySQLProtocol, KafkaProtocol:
+	default:
+		allErrs = append(allErrs, field.Invalid(path.Child("protocol"), in.Protocol,
+			fmt.Sprintf("protocol %s not supported, protocol can be 'redis', 'mysql' or 'kafka'", in.Protocol)))
+	}
+
+	if len(in.KeyPattern) > 0 {
+		if in.Protocol != RedisProtocol {
+			allErrs = append(allErrs, field.Invalid(path.Child("keyPattern"), in.KeyPattern, "keyPattern is only available for redis"))
+		} else if _, err := pathpkg.Match(in.KeyPattern, ""); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("keyPattern"), in.KeyPattern, fmt.Sprintf("invalid glob pattern: %s", err)))
+		}
+	}
+
+	if len(in.QueryPattern) > 0 {
+		if in.Protocol != MySQLProtocol {
+			allErrs = append(allErrs, field.Invalid(path.Child("queryPattern"), in.QueryPattern, "queryPattern is only available for mysql"))
+		} else if _, err := regexp.Compile(in.QueryPattern); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("queryPattern"), in.QueryPattern, fmt.Sprintf("invalid regular expression: %s", err)))
+		}
+	}
+
+	switch in.Action {
+	case ProtocolErrorAction:
+		if in.Protocol == KafkaProtocol {
+			allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action, "error action is not supported for kafka"))
+		}
+		if in.Code != nil {
+			if in.Protocol != MySQLProtocol {
+				allErrs = append(allErrs, field.Invalid(path.Child("code"), *in.Code, "code is only available for mysql"))
+			} else if *in.Code < minMySQLErrorCode || *in.Code > maxMySQLErrorCode {
+				allErrs = append(allErrs, field.Invalid(path.Child("code"), *in.Code,
+					fmt.Sprintf("code should be in range [%d, %d]", minMySQLErrorCode, maxMySQLErrorCode)))
+			}
+		}
+	case ProtocolDelayAction:
+		delay, err := time.ParseDuration(in.Delay)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, fmt.Sprintf("parse delay field error: %s", err)))
+		} else if delay <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child("delay"), in.Delay, "delay should be positive"))
+		}
+	case ProtocolAbortAction:
+	case "":
+		allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action, "action not provided"))
+	default:
+		allErrs = append(allErrs, field.Invalid(path.Child("action"), in.Action,
+			fmt.Sprintf("action %s not supported, action can be 'error', 'delay' or 'abort'", in.Action)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("protocolchaos_webhook", func() {
+	Context("webhook.Validator of protocolchaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name   string
+				spec   ProtocolChaosSpec
+				expect string
+			}
+			lockTimeout, unknown := int32(1205), int32(70000)
+
+			tcs := []TestCase{
+				{
+					name: "redis error action",
+					spec: ProtocolChaosSpec{
+						Action:     ProtocolErrorAction,
+						Protocol:   RedisProtocol,
+						Port:       6379,
+						Command:    "GET",
+						KeyPattern: "session:*",
+						Message:    "ERR injected",
+					},
+					expect: "",
+				},
+				{
+					name: "mysql error action",
+					spec: ProtocolChaosSpec{
+						Action:       ProtocolErrorAction,
+						Protocol:     MySQLProtocol,
+						Port:         3306,
+						QueryPattern: "^SELECT .* FROM orders",
+						Code:         &lockTimeout,
+						Message:      "Lock wait timeout exceeded",
+					},
+					expect: "",
+				},
+				{
+					name: "kafka delay action",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolDelayAction,
+						Protocol: KafkaProtocol,
+						Port:     9092,
+						Command:  "Produce",
+						Delay:    "60s",
+					},
+					expect: "",
+				},
+				{
+					name: "kafka error action",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolErrorAction,
+						Protocol: KafkaProtocol,
+						Port:     9092,
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown protocol",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolAbortAction,
+						Protocol: "postgres",
+						Port:     5432,
+					},
+					expect: "error",
+				},
+				{
+					name: "key pattern of mysql",
+					spec: ProtocolChaosSpec{
+						Action:     ProtocolAbortAction,
+						Protocol:   MySQLProtocol,
+						Port:       3306,
+						KeyPattern: "session:*",
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid key pattern",
+					spec: ProtocolChaosSpec{
+						Action:     ProtocolAbortAction,
+						Protocol:   RedisProtocol,
+						Port:       6379,
+						KeyPattern: "session:[",
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid query pattern",
+					spec: ProtocolChaosSpec{
+						Action:       ProtocolAbortAction,
+						Protocol:     MySQLProtocol,
+						Port:         3306,
+						QueryPattern: "*FROM",
+					},
+					expect: "error",
+				},
+				{
+					name: "code of redis",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolErrorAction,
+						Protocol: RedisProtocol,
+						Port:     6379,
+						Code:     &lockTimeout,
+					},
+					expect: "error",
+				},
+				{
+					name: "unknown code of mysql",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolErrorAction,
+						Protocol: MySQLProtocol,
+						Port:     3306,
+						Code:     &unknown,
+					},
+					expect: "error",
+				},
+				{
+					name: "delay action without delay",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolDelayAction,
+						Protocol: RedisProtocol,
+						Port:     6379,
+					},
+					expect: "error",
+				},
+				{
+					name: "without port",
+					spec: ProtocolChaosSpec{
+						Action:   ProtocolAbortAction,
+						Protocol: RedisProtocol,
+					},
+					expect: "error",
+				},
+			}
+
+			for _, tc := range tcs {
+				chaos := &ProtocolChaos{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: metav1.NamespaceDefault,
+						Name:      "foo",
+					},
+					Spec: tc.spec,
+				}
+				_, err := chaos.ValidateCreate(context.Background(), chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred(), tc.name)
+				} else {
+					Expect(err).NotTo(HaveOccurred(), tc.name)
+				}
+			}
+		})
+	})
+})
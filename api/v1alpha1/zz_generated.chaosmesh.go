@@ -838,6 +838,164 @@ func (in *GCPChaos) Default(_ context.Context, obj runtime.Object) error {
 	return nil
 }
 
+const KindGRPCChaos = "GRPCChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *GRPCChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *GRPCChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *GRPCChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *GRPCChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *GRPCChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *GRPCChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *GRPCChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// GRPCChaosList contains a list of GRPCChaos
+type GRPCChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GRPCChaos `json:"items"`
+}
+
+func (in *GRPCChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *GRPCChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *GRPCChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *GRPCChaos) IsOneShot() bool {
+	return false
+}
+
+var GRPCChaosWebhookLog = logf.Log.WithName("GRPCChaos-resource")
+
+func (in *GRPCChaos) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*GRPCChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *GRPCChaos, got %T", obj)
+	}
+	GRPCChaosWebhookLog.Info("validate create", "name", typedObj.GetName())
+
+	return typedObj.Validate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *GRPCChaos) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	typedOldObj, ok := oldObj.(*GRPCChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *GRPCChaos, got %T", oldObj)
+	}
+
+	typedNewObj, ok := newObj.(*GRPCChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *GRPCChaos, got %T", newObj)
+	}
+
+	GRPCChaosWebhookLog.Info("validate update", "name", typedOldObj.GetName())
+	if !reflect.DeepEqual(typedOldObj.Spec, typedNewObj.Spec) {
+		return nil, ErrCanNotUpdateChaos
+	}
+	return typedNewObj.Validate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *GRPCChaos) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*GRPCChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *GRPCChaos, got %T", obj)
+	}
+
+	GRPCChaosWebhookLog.Info("validate delete", "name", typedObj.GetName())
+
+	return nil, nil
+}
+
+var _ webhook.CustomValidator = &GRPCChaos{}
+
+func (in *GRPCChaos) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.CustomDefaulter = &GRPCChaos{}
+
+func (in *GRPCChaos) Default(_ context.Context, obj runtime.Object) error {
+	gw.Default(obj)
+	return nil
+}
+
 const KindHTTPChaos = "HTTPChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -2175,6 +2333,164 @@ func (in *PodNetworkChaos) Default(_ context.Context, obj runtime.Object) error
 	return nil
 }
 
+const KindProtocolChaos = "ProtocolChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *ProtocolChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *ProtocolChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *ProtocolChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *ProtocolChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *ProtocolChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *ProtocolChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *ProtocolChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// ProtocolChaosList contains a list of ProtocolChaos
+type ProtocolChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ProtocolChaos `json:"items"`
+}
+
+func (in *ProtocolChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *ProtocolChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *ProtocolChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *ProtocolChaos) IsOneShot() bool {
+	return false
+}
+
+var ProtocolChaosWebhookLog = logf.Log.WithName("ProtocolChaos-resource")
+
+func (in *ProtocolChaos) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*ProtocolChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *ProtocolChaos, got %T", obj)
+	}
+	ProtocolChaosWebhookLog.Info("validate create", "name", typedObj.GetName())
+
+	return typedObj.Validate()
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *ProtocolChaos) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	typedOldObj, ok := oldObj.(*ProtocolChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *ProtocolChaos, got %T", oldObj)
+	}
+
+	typedNewObj, ok := newObj.(*ProtocolChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *ProtocolChaos, got %T", newObj)
+	}
+
+	ProtocolChaosWebhookLog.Info("validate update", "name", typedOldObj.GetName())
+	if !reflect.DeepEqual(typedOldObj.Spec, typedNewObj.Spec) {
+		return nil, ErrCanNotUpdateChaos
+	}
+	return typedNewObj.Validate()
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (in *ProtocolChaos) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	typedObj, ok := obj.(*ProtocolChaos)
+	if !ok {
+		return nil, errors.Errorf("expected type *ProtocolChaos, got %T", obj)
+	}
+
+	ProtocolChaosWebhookLog.Info("validate delete", "name", typedObj.GetName())
+
+	return nil, nil
+}
+
+var _ webhook.CustomValidator = &ProtocolChaos{}
+
+func (in *ProtocolChaos) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.CustomDefaulter = &ProtocolChaos{}
+
+func (in *ProtocolChaos) Default(_ context.Context, obj runtime.Object) error {
+	gw.Default(obj)
+	return nil
+}
+
 const KindRemoteCluster = "RemoteCluster"
 
 var RemoteClusterWebhookLog = logf.Log.WithName("RemoteCluster-resource")
@@ -2799,6 +3115,12 @@ func init() {
 		list:  &GCPChaosList{},
 	})
 
+	SchemeBuilder.Register(&GRPCChaos{}, &GRPCChaosList{})
+	all.register(KindGRPCChaos, &ChaosKind{
+		chaos: &GRPCChaos{},
+		list:  &GRPCChaosList{},
+	})
+
 	SchemeBuilder.Register(&HTTPChaos{}, &HTTPChaosList{})
 	all.register(KindHTTPChaos, &ChaosKind{
 		chaos: &HTTPChaos{},
@@ -2849,6 +3171,12 @@ func init() {
 
 	SchemeBuilder.Register(&PodNetworkChaos{}, &PodNetworkChaosList{})
 
+	SchemeBuilder.Register(&ProtocolChaos{}, &ProtocolChaosList{})
+	all.register(KindProtocolChaos, &ChaosKind{
+		chaos: &ProtocolChaos{},
+		list:  &ProtocolChaosList{},
+	})
+
 	SchemeBuilder.Register(&RemoteCluster{}, &RemoteClusterList{})
 
 	SchemeBuilder.Register(&RuntimeMutatorChaos{}, &RuntimeMutatorChaosList{})
@@ -2897,6 +3225,11 @@ func init() {
 		list:  &GCPChaosList{},
 	})
 
+	allScheduleItem.register(KindGRPCChaos, &ChaosKind{
+		chaos: &GRPCChaos{},
+		list:  &GRPCChaosList{},
+	})
+
 	allScheduleItem.register(KindHTTPChaos, &ChaosKind{
 		chaos: &HTTPChaos{},
 		list:  &HTTPChaosList{},
@@ -2932,6 +3265,11 @@ func init() {
 		list:  &PodChaosList{},
 	})
 
+	allScheduleItem.register(KindProtocolChaos, &ChaosKind{
+		chaos: &ProtocolChaos{},
+		list:  &ProtocolChaosList{},
+	})
+
 	allScheduleItem.register(KindRuntimeMutatorChaos, &ChaosKind{
 		chaos: &RuntimeMutatorChaos{},
 		list:  &RuntimeMutatorChaosList{},
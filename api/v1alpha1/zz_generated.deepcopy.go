@@ -450,6 +450,11 @@ func (in *BlockChaosSpec) DeepCopyInto(out *BlockChaosSpec) {
 		*out = new(BlockDelaySpec)
 		**out = **in
 	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(BlockLimitSpec)
+		**out = **in
+	}
 	in.ContainerNodeVolumePathSelector.DeepCopyInto(&out.ContainerNodeVolumePathSelector)
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
@@ -506,6 +511,21 @@ func (in *BlockDelaySpec) DeepCopy() *BlockDelaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlockLimitSpec) DeepCopyInto(out *BlockLimitSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockLimitSpec.
+func (in *BlockLimitSpec) DeepCopy() *BlockLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlockLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUStressor) DeepCopyInto(out *CPUStressor) {
 	*out = *in
@@ -818,6 +838,22 @@ func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosSpec.
@@ -834,6 +870,13 @@ func (in *DNSChaosSpec) DeepCopy() *DNSChaosSpec {
 func (in *DNSChaosStatus) DeepCopyInto(out *DNSChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosStatus.
@@ -928,6 +971,25 @@ func (in *DuplicateSpec) DeepCopy() *DuplicateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in EgressTargets) DeepCopyInto(out *EgressTargets) {
+	{
+		in := &in
+		*out = make(EgressTargets, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressTargets.
+func (in EgressTargets) DeepCopy() EgressTargets {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressTargets)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 	*out = *in
@@ -956,6 +1018,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(GCPChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GRPCChaos != nil {
+		in, out := &in.GRPCChaos, &out.GRPCChaos
+		*out = new(GRPCChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.HTTPChaos != nil {
 		in, out := &in.HTTPChaos, &out.HTTPChaos
 		*out = new(HTTPChaosSpec)
@@ -991,6 +1058,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(PodChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProtocolChaos != nil {
+		in, out := &in.ProtocolChaos, &out.ProtocolChaos
+		*out = new(ProtocolChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RuntimeMutatorChaos != nil {
 		in, out := &in.RuntimeMutatorChaos, &out.RuntimeMutatorChaos
 		*out = new(RuntimeMutatorChaosSpec)
@@ -1589,6 +1661,121 @@ func (in *GCPSelector) DeepCopy() *GCPSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaos) DeepCopyInto(out *GRPCChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaos.
+func (in *GRPCChaos) DeepCopy() *GRPCChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosList) DeepCopyInto(out *GRPCChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GRPCChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosList.
+func (in *GRPCChaosList) DeepCopy() *GRPCChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GRPCChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosSpec) DeepCopyInto(out *GRPCChaosSpec) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosSpec.
+func (in *GRPCChaosSpec) DeepCopy() *GRPCChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCChaosStatus) DeepCopyInto(out *GRPCChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCChaosStatus.
+func (in *GRPCChaosStatus) DeepCopy() *GRPCChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenericSelectorSpec) DeepCopyInto(out *GenericSelectorSpec) {
 	*out = *in
@@ -1680,6 +1867,26 @@ func (in *HTTPChaos) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPChaosAutoTLS) DeepCopyInto(out *HTTPChaosAutoTLS) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPChaosAutoTLS.
+func (in *HTTPChaosAutoTLS) DeepCopy() *HTTPChaosAutoTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPChaosAutoTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPChaosList) DeepCopyInto(out *HTTPChaosList) {
 	*out = *in
@@ -1712,11 +1919,31 @@ func (in *HTTPChaosList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPChaosObservation) DeepCopyInto(out *HTTPChaosObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPChaosObservation.
+func (in *HTTPChaosObservation) DeepCopy() *HTTPChaosObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPChaosObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 	*out = *in
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	in.PodHttpChaosActions.DeepCopyInto(&out.PodHttpChaosActions)
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(PodHttpChaosEgress)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Path != nil {
 		in, out := &in.Path, &out.Path
 		*out = new(string)
@@ -1746,11 +1973,50 @@ func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
+		*out = new(PodHttpChaosPattern)
+		**out = **in
+	}
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make(map[string]PodHttpChaosPattern, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestHeaderPatterns != nil {
+		in, out := &in.RequestHeaderPatterns, &out.RequestHeaderPatterns
+		*out = make(map[string]PodHttpChaosPattern, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestBody != nil {
+		in, out := &in.RequestBody, &out.RequestBody
+		*out = new(PodHttpChaosBodySelector)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(PodHttpChaosTLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoTLS != nil {
+		in, out := &in.AutoTLS, &out.AutoTLS
+		*out = new(HTTPChaosAutoTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
@@ -1768,6 +2034,26 @@ func (in *HTTPChaosSpec) DeepCopy() *HTTPChaosSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPChaosStats) DeepCopyInto(out *HTTPChaosStats) {
+	*out = *in
+	if in.Observations != nil {
+		in, out := &in.Observations, &out.Observations
+		*out = make([]HTTPChaosObservation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPChaosStats.
+func (in *HTTPChaosStats) DeepCopy() *HTTPChaosStats {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPChaosStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPChaosStatus) DeepCopyInto(out *HTTPChaosStatus) {
 	*out = *in
@@ -1779,6 +2065,13 @@ func (in *HTTPChaosStatus) DeepCopyInto(out *HTTPChaosStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.Stats != nil {
+		in, out := &in.Stats, &out.Stats
+		*out = make(map[string]HTTPChaosStats, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPChaosStatus.
@@ -1857,6 +2150,27 @@ func (in *HTTPDelaySpec) DeepCopy() *HTTPDelaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in HTTPPatterns) DeepCopyInto(out *HTTPPatterns) {
+	{
+		in := &in
+		*out = make(HTTPPatterns, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPatterns.
+func (in HTTPPatterns) DeepCopy() HTTPPatterns {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPatterns)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPRequestSpec) DeepCopyInto(out *HTTPRequestSpec) {
 	*out = *in
@@ -2668,6 +2982,22 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 		**out = **in
 	}
 	in.TcParameter.DeepCopyInto(&out.TcParameter)
+	if in.Reject != nil {
+		in, out := &in.Reject, &out.Reject
+		*out = new(RejectSpec)
+		**out = **in
+	}
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(NetworkProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Flap != nil {
+		in, out := &in.Flap, &out.Flap
+		*out = new(NetworkFlapSpec)
+		**out = **in
+	}
+	out.PortFilter = in.PortFilter
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
 		*out = new(PodSelector)
@@ -2678,6 +3008,13 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = make([]NetworkMatrixEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
@@ -2694,12 +3031,16 @@ func (in *NetworkChaosSpec) DeepCopy() *NetworkChaosSpec {
 func (in *NetworkChaosStatus) DeepCopyInto(out *NetworkChaosStatus) {
 	*out = *in
 	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
-	if in.Instances != nil {
-		in, out := &in.Instances, &out.Instances
-		*out = make(map[string]int64, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.NetworkInjectionStatus.DeepCopyInto(&out.NetworkInjectionStatus)
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(NetworkProfileStatus)
+		**out = **in
+	}
+	if in.Flap != nil {
+		in, out := &in.Flap, &out.Flap
+		*out = new(NetworkFlapStatus)
+		**out = **in
 	}
 }
 
@@ -2729,23 +3070,38 @@ func (in *NetworkCommonSpec) DeepCopy() *NetworkCommonSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkCorruptSpec) DeepCopyInto(out *NetworkCorruptSpec) {
+func (in *NetworkConflict) DeepCopyInto(out *NetworkConflict) {
 	*out = *in
-	out.NetworkCommonSpec = in.NetworkCommonSpec
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCorruptSpec.
-func (in *NetworkCorruptSpec) DeepCopy() *NetworkCorruptSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkConflict.
+func (in *NetworkConflict) DeepCopy() *NetworkConflict {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkCorruptSpec)
+	out := new(NetworkConflict)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkDNSSpec) DeepCopyInto(out *NetworkDNSSpec) {
+func (in *NetworkCorruptSpec) DeepCopyInto(out *NetworkCorruptSpec) {
+	*out = *in
+	out.NetworkCommonSpec = in.NetworkCommonSpec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkCorruptSpec.
+func (in *NetworkCorruptSpec) DeepCopy() *NetworkCorruptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkCorruptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkDNSSpec) DeepCopyInto(out *NetworkDNSSpec) {
 	*out = *in
 }
 
@@ -2806,6 +3162,36 @@ func (in *NetworkDuplicateSpec) DeepCopy() *NetworkDuplicateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkFlapSpec) DeepCopyInto(out *NetworkFlapSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFlapSpec.
+func (in *NetworkFlapSpec) DeepCopy() *NetworkFlapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkFlapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkFlapStatus) DeepCopyInto(out *NetworkFlapStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFlapStatus.
+func (in *NetworkFlapStatus) DeepCopy() *NetworkFlapStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkFlapStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkFloodSpec) DeepCopyInto(out *NetworkFloodSpec) {
 	*out = *in
@@ -2821,6 +3207,33 @@ func (in *NetworkFloodSpec) DeepCopy() *NetworkFloodSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInjectionStatus) DeepCopyInto(out *NetworkInjectionStatus) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conflicts != nil {
+		in, out := &in.Conflicts, &out.Conflicts
+		*out = make([]NetworkConflict, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInjectionStatus.
+func (in *NetworkInjectionStatus) DeepCopy() *NetworkInjectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInjectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkLossSpec) DeepCopyInto(out *NetworkLossSpec) {
 	*out = *in
@@ -2837,6 +3250,32 @@ func (in *NetworkLossSpec) DeepCopy() *NetworkLossSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkMatrixEntry) DeepCopyInto(out *NetworkMatrixEntry) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(PodSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.TcParameter.DeepCopyInto(&out.TcParameter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkMatrixEntry.
+func (in *NetworkMatrixEntry) DeepCopy() *NetworkMatrixEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkMatrixEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPartitionSpec) DeepCopyInto(out *NetworkPartitionSpec) {
 	*out = *in
@@ -2852,6 +3291,76 @@ func (in *NetworkPartitionSpec) DeepCopy() *NetworkPartitionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProfileSpec) DeepCopyInto(out *NetworkProfileSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]NetworkProfileStep, len(*in))
+		copy(*out, *in)
+	}
+	if in.Trace != nil {
+		in, out := &in.Trace, &out.Trace
+		*out = new(NetworkTraceSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProfileSpec.
+func (in *NetworkProfileSpec) DeepCopy() *NetworkProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProfileStatus) DeepCopyInto(out *NetworkProfileStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProfileStatus.
+func (in *NetworkProfileStatus) DeepCopy() *NetworkProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProfileStep) DeepCopyInto(out *NetworkProfileStep) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProfileStep.
+func (in *NetworkProfileStep) DeepCopy() *NetworkProfileStep {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProfileStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkTraceSource) DeepCopyInto(out *NetworkTraceSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkTraceSource.
+func (in *NetworkTraceSource) DeepCopy() *NetworkTraceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkTraceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PMJVMMySQLSpec) DeepCopyInto(out *PMJVMMySQLSpec) {
 	*out = *in
@@ -3238,6 +3747,21 @@ func (in *PodHttpChaosActions) DeepCopyInto(out *PodHttpChaosActions) {
 		*out = new(PodHttpChaosPatchActions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(PodHttpChaosStreamActions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Frames != nil {
+		in, out := &in.Frames, &out.Frames
+		*out = new(PodHttpChaosFrameActions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Observe != nil {
+		in, out := &in.Observe, &out.Observe
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosActions.
@@ -3255,6 +3779,21 @@ func (in *PodHttpChaosBaseRule) DeepCopyInto(out *PodHttpChaosBaseRule) {
 	*out = *in
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Actions.DeepCopyInto(&out.Actions)
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int)
+		**out = **in
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(PodHttpChaosEgress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosBaseRule.
@@ -3267,6 +3806,87 @@ func (in *PodHttpChaosBaseRule) DeepCopy() *PodHttpChaosBaseRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosBodySelector) DeepCopyInto(out *PodHttpChaosBodySelector) {
+	*out = *in
+	out.Pattern = in.Pattern
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosBodySelector.
+func (in *PodHttpChaosBodySelector) DeepCopy() *PodHttpChaosBodySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosBodySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosEgress) DeepCopyInto(out *PodHttpChaosEgress) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosEgress.
+func (in *PodHttpChaosEgress) DeepCopy() *PodHttpChaosEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosFrameActions) DeepCopyInto(out *PodHttpChaosFrameActions) {
+	*out = *in
+	if in.DropAfterFrames != nil {
+		in, out := &in.DropAfterFrames, &out.DropAfterFrames
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DropAfter != nil {
+		in, out := &in.DropAfter, &out.DropAfter
+		*out = new(string)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replace != nil {
+		in, out := &in.Replace, &out.Replace
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Corrupt != nil {
+		in, out := &in.Corrupt, &out.Corrupt
+		*out = new(int)
+		**out = **in
+	}
+	if in.CloseCode != nil {
+		in, out := &in.CloseCode, &out.CloseCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosFrameActions.
+func (in *PodHttpChaosFrameActions) DeepCopy() *PodHttpChaosFrameActions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosFrameActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaosList) DeepCopyInto(out *PodHttpChaosList) {
 	*out = *in
@@ -3356,6 +3976,21 @@ func (in *PodHttpChaosPatchBodyAction) DeepCopy() *PodHttpChaosPatchBodyAction {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosPattern) DeepCopyInto(out *PodHttpChaosPattern) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosPattern.
+func (in *PodHttpChaosPattern) DeepCopy() *PodHttpChaosPattern {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosPattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaosReplaceActions) DeepCopyInto(out *PodHttpChaosReplaceActions) {
 	*out = *in
@@ -3458,6 +4093,30 @@ func (in *PodHttpChaosSelector) DeepCopyInto(out *PodHttpChaosSelector) {
 			(*out)[key] = val
 		}
 	}
+	if in.PathPattern != nil {
+		in, out := &in.PathPattern, &out.PathPattern
+		*out = new(PodHttpChaosPattern)
+		**out = **in
+	}
+	if in.Queries != nil {
+		in, out := &in.Queries, &out.Queries
+		*out = make(map[string]PodHttpChaosPattern, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestHeaderPatterns != nil {
+		in, out := &in.RequestHeaderPatterns, &out.RequestHeaderPatterns
+		*out = make(map[string]PodHttpChaosPattern, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RequestBody != nil {
+		in, out := &in.RequestBody, &out.RequestBody
+		*out = new(PodHttpChaosBodySelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosSelector.
@@ -3512,6 +4171,36 @@ func (in *PodHttpChaosStatus) DeepCopy() *PodHttpChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosStreamActions) DeepCopyInto(out *PodHttpChaosStreamActions) {
+	*out = *in
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Ttfb != nil {
+		in, out := &in.Ttfb, &out.Ttfb
+		*out = new(string)
+		**out = **in
+	}
+	if in.Truncate != nil {
+		in, out := &in.Truncate, &out.Truncate
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosStreamActions.
+func (in *PodHttpChaosStreamActions) DeepCopy() *PodHttpChaosStreamActions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosStreamActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaosTLS) DeepCopyInto(out *PodHttpChaosTLS) {
 	*out = *in
@@ -3808,6 +4497,21 @@ func (in *PodSelectorSpec) DeepCopy() *PodSelectorSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortFilter) DeepCopyInto(out *PortFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortFilter.
+func (in *PortFilter) DeepCopy() *PortFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(PortFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProcessSpec) DeepCopyInto(out *ProcessSpec) {
 	*out = *in
@@ -3823,6 +4527,114 @@ func (in *ProcessSpec) DeepCopy() *ProcessSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolChaos) DeepCopyInto(out *ProtocolChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolChaos.
+func (in *ProtocolChaos) DeepCopy() *ProtocolChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtocolChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolChaosList) DeepCopyInto(out *ProtocolChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ProtocolChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolChaosList.
+func (in *ProtocolChaosList) DeepCopy() *ProtocolChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProtocolChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolChaosSpec) DeepCopyInto(out *ProtocolChaosSpec) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolChaosSpec.
+func (in *ProtocolChaosSpec) DeepCopy() *ProtocolChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtocolChaosStatus) DeepCopyInto(out *ProtocolChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtocolChaosStatus.
+func (in *ProtocolChaosStatus) DeepCopy() *ProtocolChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtocolChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateSpec) DeepCopyInto(out *RateSpec) {
 	*out = *in
@@ -3877,6 +4689,16 @@ func (in *RawIptables) DeepCopyInto(out *RawIptables) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	out.PortFilter = in.PortFilter
+	if in.Flap != nil {
+		in, out := &in.Flap, &out.Flap
+		*out = new(NetworkFlapSpec)
+		**out = **in
+	}
+	if in.FlapStartTime != nil {
+		in, out := &in.FlapStartTime, &out.FlapStartTime
+		*out = (*in).DeepCopy()
+	}
 	out.RawRuleSource = in.RawRuleSource
 }
 
@@ -3909,6 +4731,25 @@ func (in *RawRuleSource) DeepCopy() *RawRuleSource {
 func (in *RawTrafficControl) DeepCopyInto(out *RawTrafficControl) {
 	*out = *in
 	in.TcParameter.DeepCopyInto(&out.TcParameter)
+	out.PortFilter = in.PortFilter
+	if in.Profile != nil {
+		in, out := &in.Profile, &out.Profile
+		*out = new(NetworkProfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProfileStartTime != nil {
+		in, out := &in.ProfileStartTime, &out.ProfileStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Flap != nil {
+		in, out := &in.Flap, &out.Flap
+		*out = new(NetworkFlapSpec)
+		**out = **in
+	}
+	if in.FlapStartTime != nil {
+		in, out := &in.FlapStartTime, &out.FlapStartTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawTrafficControl.
@@ -4057,6 +4898,21 @@ func (in *RedisSentinelStopSpec) DeepCopy() *RedisSentinelStopSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RejectSpec) DeepCopyInto(out *RejectSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RejectSpec.
+func (in *RejectSpec) DeepCopy() *RejectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RejectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteCluster) DeepCopyInto(out *RemoteCluster) {
 	*out = *in
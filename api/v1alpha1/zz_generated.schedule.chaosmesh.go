@@ -29,6 +29,7 @@ const (
 	ScheduleTypeBlockChaos ScheduleTemplateType = "BlockChaos"
 	ScheduleTypeDNSChaos ScheduleTemplateType = "DNSChaos"
 	ScheduleTypeGCPChaos ScheduleTemplateType = "GCPChaos"
+	ScheduleTypeGRPCChaos ScheduleTemplateType = "GRPCChaos"
 	ScheduleTypeHTTPChaos ScheduleTemplateType = "HTTPChaos"
 	ScheduleTypeIOChaos ScheduleTemplateType = "IOChaos"
 	ScheduleTypeJVMChaos ScheduleTemplateType = "JVMChaos"
@@ -36,6 +37,7 @@ const (
 	ScheduleTypeNetworkChaos ScheduleTemplateType = "NetworkChaos"
 	ScheduleTypePhysicalMachineChaos ScheduleTemplateType = "PhysicalMachineChaos"
 	ScheduleTypePodChaos ScheduleTemplateType = "PodChaos"
+	ScheduleTypeProtocolChaos ScheduleTemplateType = "ProtocolChaos"
 	ScheduleTypeRuntimeMutatorChaos ScheduleTemplateType = "RuntimeMutatorChaos"
 	ScheduleTypeStressChaos ScheduleTemplateType = "StressChaos"
 	ScheduleTypeTimeChaos ScheduleTemplateType = "TimeChaos"
@@ -49,6 +51,7 @@ var allScheduleTemplateType = []ScheduleTemplateType{
 	ScheduleTypeBlockChaos,
 	ScheduleTypeDNSChaos,
 	ScheduleTypeGCPChaos,
+	ScheduleTypeGRPCChaos,
 	ScheduleTypeHTTPChaos,
 	ScheduleTypeIOChaos,
 	ScheduleTypeJVMChaos,
@@ -56,6 +59,7 @@ var allScheduleTemplateType = []ScheduleTemplateType{
 	ScheduleTypeNetworkChaos,
 	ScheduleTypePhysicalMachineChaos,
 	ScheduleTypePodChaos,
+	ScheduleTypeProtocolChaos,
 	ScheduleTypeRuntimeMutatorChaos,
 	ScheduleTypeStressChaos,
 	ScheduleTypeTimeChaos,
@@ -85,6 +89,10 @@ func (it *ScheduleItem) SpawnNewObject(templateType ScheduleTemplateType) (Gener
 		result := GCPChaos{}
 		result.Spec = *it.GCPChaos
 		return &result, nil
+	case ScheduleTypeGRPCChaos:
+		result := GRPCChaos{}
+		result.Spec = *it.GRPCChaos
+		return &result, nil
 	case ScheduleTypeHTTPChaos:
 		result := HTTPChaos{}
 		result.Spec = *it.HTTPChaos
@@ -113,6 +121,10 @@ func (it *ScheduleItem) SpawnNewObject(templateType ScheduleTemplateType) (Gener
 		result := PodChaos{}
 		result.Spec = *it.PodChaos
 		return &result, nil
+	case ScheduleTypeProtocolChaos:
+		result := ProtocolChaos{}
+		result.Spec = *it.ProtocolChaos
+		return &result, nil
 	case ScheduleTypeRuntimeMutatorChaos:
 		result := RuntimeMutatorChaos{}
 		result.Spec = *it.RuntimeMutatorChaos
@@ -152,6 +164,9 @@ func (it *ScheduleItem) RestoreChaosSpec(root interface{}) error {
 	case *GCPChaos:
 		*it.GCPChaos = chaos.Spec
 		return nil
+	case *GRPCChaos:
+		*it.GRPCChaos = chaos.Spec
+		return nil
 	case *HTTPChaos:
 		*it.HTTPChaos = chaos.Spec
 		return nil
@@ -173,6 +188,9 @@ func (it *ScheduleItem) RestoreChaosSpec(root interface{}) error {
 	case *PodChaos:
 		*it.PodChaos = chaos.Spec
 		return nil
+	case *ProtocolChaos:
+		*it.ProtocolChaos = chaos.Spec
+		return nil
 	case *RuntimeMutatorChaos:
 		*it.RuntimeMutatorChaos = chaos.Spec
 		return nil
@@ -35,6 +35,7 @@ const (
 	TypeBlockChaos TemplateType = "BlockChaos"
 	TypeDNSChaos TemplateType = "DNSChaos"
 	TypeGCPChaos TemplateType = "GCPChaos"
+	TypeGRPCChaos TemplateType = "GRPCChaos"
 	TypeHTTPChaos TemplateType = "HTTPChaos"
 	TypeIOChaos TemplateType = "IOChaos"
 	TypeJVMChaos TemplateType = "JVMChaos"
@@ -42,6 +43,7 @@ const (
 	TypeNetworkChaos TemplateType = "NetworkChaos"
 	TypePhysicalMachineChaos TemplateType = "PhysicalMachineChaos"
 	TypePodChaos TemplateType = "PodChaos"
+	TypeProtocolChaos TemplateType = "ProtocolChaos"
 	TypeRuntimeMutatorChaos TemplateType = "RuntimeMutatorChaos"
 	TypeStressChaos TemplateType = "StressChaos"
 	TypeTimeChaos TemplateType = "TimeChaos"
@@ -55,6 +57,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeBlockChaos,
 	TypeDNSChaos,
 	TypeGCPChaos,
+	TypeGRPCChaos,
 	TypeHTTPChaos,
 	TypeIOChaos,
 	TypeJVMChaos,
@@ -62,6 +65,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeNetworkChaos,
 	TypePhysicalMachineChaos,
 	TypePodChaos,
+	TypeProtocolChaos,
 	TypeRuntimeMutatorChaos,
 	TypeStressChaos,
 	TypeTimeChaos,
@@ -80,6 +84,8 @@ type EmbedChaos struct {
 	// +optional
 	GCPChaos *GCPChaosSpec `json:"gcpChaos,omitempty"`
 	// +optional
+	GRPCChaos *GRPCChaosSpec `json:"grpcChaos,omitempty"`
+	// +optional
 	HTTPChaos *HTTPChaosSpec `json:"httpChaos,omitempty"`
 	// +optional
 	IOChaos *IOChaosSpec `json:"ioChaos,omitempty"`
@@ -94,6 +100,8 @@ type EmbedChaos struct {
 	// +optional
 	PodChaos *PodChaosSpec `json:"podChaos,omitempty"`
 	// +optional
+	ProtocolChaos *ProtocolChaosSpec `json:"protocolChaos,omitempty"`
+	// +optional
 	RuntimeMutatorChaos *RuntimeMutatorChaosSpec `json:"runtimemutatorChaos,omitempty"`
 	// +optional
 	StressChaos *StressChaosSpec `json:"stressChaos,omitempty"`
@@ -124,6 +132,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := GCPChaos{}
 		result.Spec = *it.GCPChaos
 		return &result, nil
+	case TypeGRPCChaos:
+		result := GRPCChaos{}
+		result.Spec = *it.GRPCChaos
+		return &result, nil
 	case TypeHTTPChaos:
 		result := HTTPChaos{}
 		result.Spec = *it.HTTPChaos
@@ -152,6 +164,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := PodChaos{}
 		result.Spec = *it.PodChaos
 		return &result, nil
+	case TypeProtocolChaos:
+		result := ProtocolChaos{}
+		result.Spec = *it.ProtocolChaos
+		return &result, nil
 	case TypeRuntimeMutatorChaos:
 		result := RuntimeMutatorChaos{}
 		result.Spec = *it.RuntimeMutatorChaos
@@ -187,6 +203,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *GCPChaos:
 		*it.GCPChaos = chaos.Spec
 		return nil
+	case *GRPCChaos:
+		*it.GRPCChaos = chaos.Spec
+		return nil
 	case *HTTPChaos:
 		*it.HTTPChaos = chaos.Spec
 		return nil
@@ -208,6 +227,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *PodChaos:
 		*it.PodChaos = chaos.Spec
 		return nil
+	case *ProtocolChaos:
+		*it.ProtocolChaos = chaos.Spec
+		return nil
 	case *RuntimeMutatorChaos:
 		*it.RuntimeMutatorChaos = chaos.Spec
 		return nil
@@ -240,6 +262,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypeGCPChaos:
 		result := GCPChaosList{}
 		return &result, nil
+	case TypeGRPCChaos:
+		result := GRPCChaosList{}
+		return &result, nil
 	case TypeHTTPChaos:
 		result := HTTPChaosList{}
 		return &result, nil
@@ -261,6 +286,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypePodChaos:
 		result := PodChaosList{}
 		return &result, nil
+	case TypeProtocolChaos:
+		result := ProtocolChaosList{}
+		return &result, nil
 	case TypeRuntimeMutatorChaos:
 		result := RuntimeMutatorChaosList{}
 		return &result, nil
@@ -316,6 +344,14 @@ func (in *GCPChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *GRPCChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *HTTPChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
@@ -372,6 +408,14 @@ func (in *PodChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *ProtocolChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *RuntimeMutatorChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
@@ -33,6 +33,11 @@ We can write these logic inside this helper, and execute them through nsexec.`,
 
 func main() {
 	rootCmd.AddCommand(helper.NormalizeVolumeNameCmd)
+	rootCmd.AddCommand(helper.HttpProxyCmd)
+	rootCmd.AddCommand(helper.GrpcProxyCmd)
+	rootCmd.AddCommand(helper.ProtocolProxyCmd)
+	rootCmd.AddCommand(helper.DNSProxyCmd)
+	rootCmd.AddCommand(helper.CleanRedirectsCmd)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -21,6 +21,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
@@ -51,6 +52,8 @@ func init() {
 	flag.StringVar(&conf.Cert, "cert", "", "certificate of grpc server")
 	flag.StringVar(&conf.Key, "key", "", "key of grpc server")
 	flag.BoolVar(&conf.Profiling, "pprof", false, "enable pprof")
+	flag.DurationVar(&conf.NetworkRulesCheckInterval, "network-rules-check-interval", 30*time.Second, "the interval of checking and healing the drift of injected network rules, 0 to disable")
+	flag.StringVar(&conf.TcBackend, "tc-backend", chaosdaemon.NetemTcBackend, "the backend to apply the traffic controls of NetworkChaos, netem or bpf")
 
 	flag.Parse()
 }
@@ -98,6 +98,21 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 			EnterNS: true,
 		})
 
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
+	} else if blockchaos.Spec.Action == v1alpha1.BlockLimit {
+		res, err = pbClient.ApplyBlockChaos(ctx, &pb.ApplyBlockChaosRequest{
+			ContainerId: containerId,
+			VolumePath:  volumePath,
+			Action:      pb.ApplyBlockChaosRequest_Limit,
+			Limit: &pb.BlockLimitSpec{
+				Iops: blockchaos.Spec.Limit.IOPS,
+				Bps:  blockchaos.Spec.Limit.BPS,
+			},
+			EnterNS: true,
+		})
+
 		if err != nil {
 			return v1alpha1.NotInjected, err
 		}
@@ -136,9 +151,20 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.NotInjected, nil
 	}
 
-	if _, err = pbClient.RecoverBlockChaos(ctx, &pb.RecoverBlockChaosRequest{
+	req := &pb.RecoverBlockChaosRequest{
 		InjectionId: int32(injection_id),
-	}); err != nil {
+	}
+	if blockchaos.Spec.Action == v1alpha1.BlockLimit {
+		// the limit is set on the cgroup of container, which has no injection id
+		_, _, volumePath, err := controller.ParseNamespacedNameContainerVolumePath(records[index].Id)
+		if err != nil {
+			return v1alpha1.Injected, errors.Wrapf(err, "parse container and volumePath %s", records[index].Id)
+		}
+		req.ContainerId = decodedContainer.ContainerId
+		req.VolumePath = volumePath
+		req.Action = pb.ApplyBlockChaosRequest_Limit
+	}
+	if _, err = pbClient.RecoverBlockChaos(ctx, req); err != nil {
 		// TODO: check whether the error still exists
 		return v1alpha1.Injected, err
 	}
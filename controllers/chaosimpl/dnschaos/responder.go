@@ -0,0 +1,83 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnschaos
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// applyPodResponder starts the DNS responder in the network namespace of the pod,
+// or updates its rules if it has been started
+func (impl *Impl) applyPodResponder(ctx context.Context, record *v1alpha1.Record, dnschaos *v1alpha1.DNSChaos, decodedContainer utils.DecodedContainerRecord) (v1alpha1.Phase, error) {
+	rules, err := json.Marshal([]tproxyconfig.DNSRule{ruleOf(&dnschaos.Spec)})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	if dnschaos.Status.Instances == nil {
+		dnschaos.Status.Instances = make(map[string]string)
+	}
+	resp, err := decodedContainer.PbClient.ApplyDNSChaos(ctx, &pb.ApplyDNSChaosRequest{
+		Rules:       string(rules),
+		ContainerId: decodedContainer.ContainerId,
+		EnterNS:     true,
+		InstanceUid: dnschaos.Status.Instances[record.Id],
+	})
+	if err != nil {
+		impl.Log.Error(err, "fail to apply dns responder", "record", record.Id)
+		return v1alpha1.NotInjected, err
+	}
+	dnschaos.Status.Instances[record.Id] = resp.InstanceUid
+
+	return v1alpha1.Injected, nil
+}
+
+// recoverPodResponder stops the DNS responder in the pod
+func (impl *Impl) recoverPodResponder(ctx context.Context, record *v1alpha1.Record, dnschaos *v1alpha1.DNSChaos, decodedContainer utils.DecodedContainerRecord) (v1alpha1.Phase, error) {
+	uid, ok := dnschaos.Status.Instances[record.Id]
+	if !ok {
+		return v1alpha1.NotInjected, nil
+	}
+
+	_, err := decodedContainer.PbClient.RecoverDNSChaos(ctx, &pb.RecoverDNSChaosRequest{
+		InstanceUid: uid,
+	})
+	if err != nil {
+		impl.Log.Error(err, "fail to recover dns responder", "record", record.Id)
+		return v1alpha1.Injected, err
+	}
+	delete(dnschaos.Status.Instances, record.Id)
+
+	return v1alpha1.NotInjected, nil
+}
+
+// ruleOf converts the spec of DNSChaos into the rule of DNS responder
+func ruleOf(spec *v1alpha1.DNSChaosSpec) tproxyconfig.DNSRule {
+	return tproxyconfig.DNSRule{
+		Patterns: spec.DomainNamePatterns,
+		Action:   string(spec.Action),
+		Delay:    spec.Delay,
+		Rcode:    string(spec.Rcode),
+		Truncate: spec.Truncate,
+		Records:  spec.Records,
+	}
+}
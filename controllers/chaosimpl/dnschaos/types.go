@@ -57,6 +57,14 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
+	dnschaos := obj.(*v1alpha1.DNSChaos)
+	if dnschaos.Spec.Responder == v1alpha1.PodResponder {
+		return impl.applyPodResponder(ctx, records[index], dnschaos, decodedContainer)
+	}
+	if err := checkServerSupport(&dnschaos.Spec); err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
 	service, err := impl.getService(ctx, config.ControllerCfg.Namespace, config.ControllerCfg.DNSServiceName)
 	if err != nil {
 		impl.Log.Error(err, "fail to get dns service")
@@ -69,7 +77,6 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		return v1alpha1.NotInjected, err
 	}
 
-	dnschaos := obj.(*v1alpha1.DNSChaos)
 	for _, pod := range dnsPods {
 		err = impl.setDNSServerRules(pod.Status.PodIP, config.ControllerCfg.DNSServicePort, dnschaos.Name, decodedContainer.Pod, dnschaos.Spec.Action, dnschaos.Spec.DomainNamePatterns)
 		if err != nil {
@@ -93,6 +100,24 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 	return v1alpha1.Injected, nil
 }
 
+// checkServerSupport checks whether the spec can be injected by the chaos DNS server,
+// the k8s_dns_chaos plugin only returns SERVFAIL for error action or random IPs, and
+// it doesn't carry the parameters of delay, fixed records, rcode and truncate.
+func checkServerSupport(spec *v1alpha1.DNSChaosSpec) error {
+	switch spec.Action {
+	case v1alpha1.ErrorAction, v1alpha1.RandomAction:
+	default:
+		return errors.Errorf("action %s is not supported by the chaos DNS server", spec.Action)
+	}
+	if len(spec.Rcode) > 0 && spec.Rcode != v1alpha1.ServFailRcode {
+		return errors.Errorf("rcode %s is not supported by the chaos DNS server", spec.Rcode)
+	}
+	if spec.Truncate {
+		return errors.New("truncate is not supported by the chaos DNS server")
+	}
+	return nil
+}
+
 func (impl *Impl) setDNSServerRules(dnsServerIP string, port int, name string, pod *v1.Pod, action v1alpha1.DNSChaosAction, patterns []string) error {
 	impl.Log.Info("setDNSServerRules", "name", name)
 
@@ -144,6 +169,9 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	}
 
 	dnschaos := obj.(*v1alpha1.DNSChaos)
+	if dnschaos.Spec.Responder == v1alpha1.PodResponder {
+		return impl.recoverPodResponder(ctx, records[index], dnschaos, decodedContainer)
+	}
 
 	// get dns server's ip used for chaos
 	service, err := impl.getService(ctx, config.ControllerCfg.Namespace, config.ControllerCfg.DNSServiceName)
@@ -0,0 +1,131 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcchaos
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/fx"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	proxy *utils.RedirectedProxy
+}
+
+// Apply applies GRPCChaos
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	grpcchaos := obj.(*v1alpha1.GRPCChaos)
+
+	rules, err := json.Marshal([]tproxyconfig.GrpcRule{ruleOf(&grpcchaos.Spec)})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	if grpcchaos.Status.Instances == nil {
+		grpcchaos.Status.Instances = make(map[string]string)
+	}
+	return impl.proxy.Apply(ctx, records[index], obj, grpcchaos.Status.Instances, func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, containerID string, source string) (string, error) {
+		resp, err := pbClient.ApplyGrpcChaos(ctx, &pb.ApplyGrpcChaosRequest{
+			Rules:       string(rules),
+			Port:        uint32(grpcchaos.Spec.Port),
+			ContainerId: containerID,
+			EnterNS:     true,
+			Source:      source,
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.InstanceUid, nil
+	})
+}
+
+// Recover means the reconciler recovers the chaos action
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	grpcchaos := obj.(*v1alpha1.GRPCChaos)
+
+	return impl.proxy.Recover(ctx, records[index], obj, grpcchaos.Status.Instances, func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, uid string, source string) error {
+		_, err := pbClient.RecoverGrpcChaos(ctx, &pb.RecoverGrpcChaosRequest{
+			InstanceUid: uid,
+			Source:      source,
+		})
+		return err
+	})
+}
+
+// ruleOf converts the spec of GRPCChaos into the rule of gRPC proxy
+func ruleOf(spec *v1alpha1.GRPCChaosSpec) tproxyconfig.GrpcRule {
+	rule := tproxyconfig.GrpcRule{
+		Selector: tproxyconfig.GrpcSelector{
+			Metadata: spec.Metadata,
+		},
+	}
+	if len(spec.Service) > 0 {
+		service := spec.Service
+		rule.Selector.Service = &service
+	}
+	if len(spec.Method) > 0 {
+		method := spec.Method
+		rule.Selector.Method = &method
+	}
+
+	switch spec.Action {
+	case v1alpha1.GRPCStatusAction:
+		status := &tproxyconfig.GrpcStatus{Message: spec.Message}
+		if spec.Code != nil {
+			status.Code = *spec.Code
+		}
+		rule.Actions.Status = status
+	case v1alpha1.GRPCDelayAction:
+		delay := spec.Delay
+		rule.Actions.Delay = &delay
+	case v1alpha1.GRPCAbortAction:
+		abortAfter := spec.AbortAfterMessages
+		rule.Actions.AbortAfterMessages = &abortAfter
+	}
+
+	return rule
+}
+
+func NewImpl(c client.Client, log logr.Logger, builder *chaosdaemon.ChaosDaemonClientBuilder) *impltypes.ChaosImplPair {
+	return &impltypes.ChaosImplPair{
+		Name:   "grpcchaos",
+		Object: &v1alpha1.GRPCChaos{},
+		Impl: &Impl{
+			proxy: utils.NewRedirectedProxy(c, log.WithName("grpcchaos"), builder),
+		},
+		ObjectList: &v1alpha1.GRPCChaosList{},
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+)
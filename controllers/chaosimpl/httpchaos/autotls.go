@@ -0,0 +1,338 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpchaos
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/netutils"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	autoTLSCACert = "ca.crt"
+	autoTLSCAKey  = "ca.key"
+
+	// autoTLSValidity is the validity of the generated certificates when the chaos has no duration
+	autoTLSValidity = 24 * time.Hour
+)
+
+func autoTLSSecretName(httpchaos *v1alpha1.HTTPChaos) string {
+	return httpchaos.Name + "-autotls"
+}
+
+// autoTLSKeyNames returns the data names of certificate and key of the pod in the secret
+func autoTLSKeyNames(pod *v1.Pod) (string, string) {
+	prefix := pod.Namespace + "." + pod.Name
+	return prefix + ".crt", prefix + ".key"
+}
+
+// autoTLSHosts returns the hosts of the certificate of the pod
+func autoTLSHosts(httpchaos *v1alpha1.HTTPChaos, pod *v1.Pod) []string {
+	if len(httpchaos.Spec.AutoTLS.Hosts) > 0 {
+		return httpchaos.Spec.AutoTLS.Hosts
+	}
+
+	if httpchaos.Spec.Egress != nil {
+		var hosts []string
+		for _, target := range httpchaos.Spec.Egress.Targets {
+			// a cidr cannot be a name of certificate
+			if !strings.Contains(target, "/") {
+				hosts = append(hosts, target)
+			}
+		}
+		return hosts
+	}
+
+	return netutils.PodIPs(pod)
+}
+
+// applyAutoTLS signs a certificate for the pod with the CA of this experiment, and
+// stores it into the secret of this experiment. The secret and the CA are created
+// for the first pod, and owned by this experiment. It returns the tls config
+// referring to the secret and the CA, or an error if the secret exists but isn't
+// owned by this experiment.
+func (impl *Impl) applyAutoTLS(ctx context.Context, httpchaos *v1alpha1.HTTPChaos, pod *v1.Pod) (*v1alpha1.PodHttpChaosTLS, []byte, error) {
+	notAfter := time.Now().Add(autoTLSValidity)
+	if duration, err := httpchaos.Spec.GetDuration(); err == nil && duration != nil {
+		notAfter = time.Now().Add(*duration + time.Hour)
+	}
+
+	key := types.NamespacedName{
+		Namespace: httpchaos.Namespace,
+		Name:      autoTLSSecretName(httpchaos),
+	}
+	certName, keyName := autoTLSKeyNames(pod)
+	hosts := autoTLSHosts(httpchaos, pod)
+
+	var ca []byte
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return k8sError.IsConflict(err) || k8sError.IsAlreadyExists(err)
+	}, func() error {
+		secret := &v1.Secret{}
+		err := impl.Client.Get(ctx, key, secret)
+		if k8sError.IsNotFound(err) {
+			caCert, caKey, err := generateCA(httpchaos.Namespace+"/"+httpchaos.Name, notAfter)
+			if err != nil {
+				return err
+			}
+
+			secret = &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: key.Namespace,
+					Name:      key.Name,
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(httpchaos, v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.KindHTTPChaos)),
+					},
+				},
+				Type: v1.SecretTypeOpaque,
+				Data: map[string][]byte{
+					autoTLSCACert: caCert,
+					autoTLSCAKey:  caKey,
+				},
+			}
+			if err := impl.Client.Create(ctx, secret); err != nil {
+				return err
+			}
+		} else if err != nil {
+			return err
+		} else if !metav1.IsControlledBy(secret, httpchaos) {
+			// the secret with the same name isn't created by this experiment, and
+			// its contents are kept
+			return errors.Errorf("secret %s is not controlled by this experiment", key)
+		}
+
+		cert, certKey, err := generateCertificate(secret.Data[autoTLSCACert], secret.Data[autoTLSCAKey], hosts, notAfter)
+		if err != nil {
+			return err
+		}
+		secret.Data[certName] = cert
+		secret.Data[keyName] = certKey
+		ca = secret.Data[autoTLSCACert]
+
+		return impl.Client.Update(ctx, secret)
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "generate certificate")
+	}
+
+	caName := autoTLSCACert
+	return &v1alpha1.PodHttpChaosTLS{
+		SecretName:      key.Name,
+		SecretNamespace: key.Namespace,
+		CertName:        certName,
+		KeyName:         keyName,
+		CAName:          &caName,
+	}, ca, nil
+}
+
+// releaseAutoTLS removes the certificate of the pod from the secret of this
+// experiment, and the secret is deleted after all the certificates are removed.
+// The secret not owned by this experiment is left untouched.
+func (impl *Impl) releaseAutoTLS(ctx context.Context, httpchaos *v1alpha1.HTTPChaos, pod *v1.Pod) error {
+	key := types.NamespacedName{
+		Namespace: httpchaos.Namespace,
+		Name:      autoTLSSecretName(httpchaos),
+	}
+	certName, keyName := autoTLSKeyNames(pod)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &v1.Secret{}
+		if err := impl.Client.Get(ctx, key, secret); err != nil {
+			if k8sError.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if !metav1.IsControlledBy(secret, httpchaos) {
+			impl.Log.Info("skip releasing the certificate in the secret not controlled by this experiment", "secret", key)
+			return nil
+		}
+
+		delete(secret.Data, certName)
+		delete(secret.Data, keyName)
+		for name := range secret.Data {
+			if name != autoTLSCACert && name != autoTLSCAKey {
+				return impl.Client.Update(ctx, secret)
+			}
+		}
+
+		if err := impl.Client.Delete(ctx, secret); err != nil && !k8sError.IsNotFound(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// setTrustedCA adds the CA into or removes it from the trust store of every container in the pod
+func (impl *Impl) setTrustedCA(ctx context.Context, httpchaos *v1alpha1.HTTPChaos, pod *v1.Pod, ca []byte, enable bool) error {
+	pbClient, err := impl.chaosDaemonClientBuilder.Build(ctx, pod, &types.NamespacedName{
+		Namespace: httpchaos.Namespace,
+		Name:      httpchaos.Name,
+	})
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	for _, container := range pod.Status.ContainerStatuses {
+		_, err = pbClient.SetTrustedCA(ctx, &pb.SetTrustedCARequest{
+			ContainerId: container.ContainerID,
+			Ca:          string(ca),
+			Enable:      enable,
+			EnterNS:     true,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "set trusted ca of container %s", container.Name)
+		}
+	}
+	return nil
+}
+
+// generateCA generates a self-signed CA, and returns the PEM encoded certificate and key
+func generateCA(name string, notAfter time.Time) ([]byte, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	template, err := certificateTemplate(fmt.Sprintf("Chaos Mesh HTTPChaos CA %s", name), notAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.IsCA = true
+	template.BasicConstraintsValid = true
+	template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertificate(der, key)
+}
+
+// generateCertificate generates a certificate of the hosts signed by the CA, and
+// returns the PEM encoded certificate and key. The certificate expires no later than the CA.
+func generateCertificate(caCert, caKey []byte, hosts []string, notAfter time.Time) ([]byte, []byte, error) {
+	ca, signer, err := decodeCA(caCert, caKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if notAfter.After(ca.NotAfter) {
+		notAfter = ca.NotAfter
+	}
+	commonName := "chaos-mesh"
+	if len(hosts) > 0 {
+		commonName = hosts[0]
+	}
+	template, err := certificateTemplate(commonName, notAfter)
+	if err != nil {
+		return nil, nil, err
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, signer)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertificate(der, key)
+}
+
+func certificateTemplate(commonName string, notAfter time.Time) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Organization: []string{"Chaos Mesh"},
+			CommonName:   commonName,
+		},
+		// tolerate the clock skew between nodes
+		NotBefore: time.Now().Add(-time.Hour),
+		NotAfter:  notAfter,
+	}, nil
+}
+
+func decodeCA(caCert, caKey []byte) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(caCert)
+	if certBlock == nil {
+		return nil, nil, errors.New("invalid certificate of ca")
+	}
+	cert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse certificate of ca")
+	}
+
+	keyBlock, _ := pem.Decode(caKey)
+	if keyBlock == nil {
+		return nil, nil, errors.New("invalid key of ca")
+	}
+	key, err := x509.ParseECPrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse key of ca")
+	}
+
+	return cert, key, nil
+}
+
+func encodeCertificate(der []byte, key *ecdsa.PrivateKey) ([]byte, []byte, error) {
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return cert, keyPem, nil
+}
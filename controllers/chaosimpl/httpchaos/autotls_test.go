@@ -0,0 +1,136 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpchaos
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/cmd/chaos-controller-manager/provider"
+)
+
+func TestGenerateCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	caNotAfter := time.Now().Add(time.Hour)
+	caCert, caKey, err := generateCA("default/foo", caNotAfter)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cert, _, err := generateCertificate(caCert, caKey, []string{"www.example.com", "10.0.0.1"}, caNotAfter.Add(time.Hour))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	roots := x509.NewCertPool()
+	g.Expect(roots.AppendCertsFromPEM(caCert)).To(BeTrue())
+
+	block, _ := pem.Decode(cert)
+	g.Expect(block).NotTo(BeNil())
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(leaf.DNSNames).To(Equal([]string{"www.example.com"}))
+	g.Expect(leaf.IPAddresses[0].Equal(net.ParseIP("10.0.0.1"))).To(BeTrue())
+	g.Expect(leaf.NotAfter.After(caNotAfter)).To(BeFalse())
+
+	for _, host := range []string{"www.example.com", "10.0.0.1"} {
+		_, err = leaf.Verify(x509.VerifyOptions{DNSName: host, Roots: roots})
+		g.Expect(err).NotTo(HaveOccurred(), host)
+	}
+	_, err = leaf.Verify(x509.VerifyOptions{DNSName: "www.example.org", Roots: roots})
+	g.Expect(err).To(HaveOccurred())
+
+	_, _, err = generateCertificate(cert, caKey, nil, caNotAfter)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestAutoTLSHosts(t *testing.T) {
+	g := NewWithT(t)
+
+	pod := &v1.Pod{
+		Status: v1.PodStatus{
+			PodIP: "10.0.0.1",
+		},
+	}
+
+	chaos := &v1alpha1.HTTPChaos{
+		Spec: v1alpha1.HTTPChaosSpec{
+			AutoTLS: &v1alpha1.HTTPChaosAutoTLS{},
+		},
+	}
+	g.Expect(autoTLSHosts(chaos, pod)).To(Equal([]string{"10.0.0.1"}))
+
+	chaos.Spec.Egress = &v1alpha1.PodHttpChaosEgress{Targets: []string{"www.example.com", "10.0.1.0/24", "10.0.2.1"}}
+	g.Expect(autoTLSHosts(chaos, pod)).To(Equal([]string{"www.example.com", "10.0.2.1"}))
+
+	chaos.Spec.AutoTLS.Hosts = []string{"foo.default.svc"}
+	g.Expect(autoTLSHosts(chaos, pod)).To(Equal([]string{"foo.default.svc"}))
+}
+
+func TestAutoTLSSecretOwnership(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	chaos := &v1alpha1.HTTPChaos{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default", UID: "chaos-uid"},
+		Spec: v1alpha1.HTTPChaosSpec{
+			AutoTLS: &v1alpha1.HTTPChaosAutoTLS{},
+		},
+	}
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+		Status:     v1.PodStatus{PodIP: "10.0.0.1"},
+	}
+	key := types.NamespacedName{Namespace: "default", Name: autoTLSSecretName(chaos)}
+
+	// the secret of user with the same name is neither adopted nor deleted
+	userSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Data:       map[string][]byte{"token": []byte("user")},
+	}
+	impl := &Impl{
+		Client: fake.NewClientBuilder().WithScheme(provider.NewScheme()).WithObjects(userSecret).Build(),
+		Log:    logr.Discard(),
+	}
+	_, _, err := impl.applyAutoTLS(ctx, chaos, pod)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(impl.releaseAutoTLS(ctx, chaos, pod)).To(Succeed())
+	secret := &v1.Secret{}
+	g.Expect(impl.Client.Get(ctx, key, secret)).To(Succeed())
+	g.Expect(secret.Data).To(Equal(map[string][]byte{"token": []byte("user")}))
+
+	// the secret created by the experiment is owned by it, and deleted with the last certificate
+	impl.Client = fake.NewClientBuilder().WithScheme(provider.NewScheme()).Build()
+	tls, _, err := impl.applyAutoTLS(ctx, chaos, pod)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tls.SecretName).To(Equal(key.Name))
+	g.Expect(impl.Client.Get(ctx, key, secret)).To(Succeed())
+	g.Expect(metav1.IsControlledBy(secret, chaos)).To(BeTrue())
+
+	g.Expect(impl.releaseAutoTLS(ctx, chaos, pod)).To(Succeed())
+	g.Expect(k8sError.IsNotFound(impl.Client.Get(ctx, key, secret))).To(BeTrue())
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/httpchaos/podhttpchaosmanager"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/iochaos/podiochaosmanager"
 	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
 )
 
@@ -46,6 +47,8 @@ type Impl struct {
 	Log logr.Logger
 
 	builder *podhttpchaosmanager.Builder
+
+	chaosDaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder
 }
 
 func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
@@ -104,14 +107,21 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		PodHttpChaosBaseRule: v1alpha1.PodHttpChaosBaseRule{
 			Target: httpchaos.Spec.Target,
 			Selector: v1alpha1.PodHttpChaosSelector{
-				Port:            &httpchaos.Spec.Port,
-				Path:            httpchaos.Spec.Path,
-				Method:          httpchaos.Spec.Method,
-				Code:            httpchaos.Spec.Code,
-				RequestHeaders:  httpchaos.Spec.RequestHeaders,
-				ResponseHeaders: httpchaos.Spec.ResponseHeaders,
+				Port:                  &httpchaos.Spec.Port,
+				Path:                  httpchaos.Spec.Path,
+				Method:                httpchaos.Spec.Method,
+				Code:                  httpchaos.Spec.Code,
+				RequestHeaders:        httpchaos.Spec.RequestHeaders,
+				ResponseHeaders:       httpchaos.Spec.ResponseHeaders,
+				PathPattern:           httpchaos.Spec.PathPattern,
+				Queries:               httpchaos.Spec.Queries,
+				RequestHeaderPatterns: httpchaos.Spec.RequestHeaderPatterns,
+				RequestBody:           httpchaos.Spec.RequestBody,
 			},
-			Actions: httpchaos.Spec.PodHttpChaosActions,
+			Actions:   httpchaos.Spec.PodHttpChaosActions,
+			Percent:   httpchaos.Spec.Percent,
+			RateLimit: httpchaos.Spec.RateLimit,
+			Egress:    httpchaos.Spec.Egress,
 		},
 	})
 
@@ -119,6 +129,20 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		m.T.Append(httpchaos.Spec.TLS)
 	}
 
+	if httpchaos.Spec.AutoTLS != nil {
+		tls, ca, err := impl.applyAutoTLS(ctx, httpchaos, &pod)
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
+		m.T.Append(tls)
+
+		if httpchaos.Spec.AutoTLS.InjectCA {
+			if err := impl.setTrustedCA(ctx, httpchaos, &pod, ca, true); err != nil {
+				return v1alpha1.NotInjected, err
+			}
+		}
+	}
+
 	generationNumber, err := m.Commit(ctx)
 	if err != nil {
 		return v1alpha1.NotInjected, err
@@ -188,11 +212,20 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.Injected, err
 	}
 
+	if httpchaos.Spec.AutoTLS != nil && httpchaos.Spec.AutoTLS.InjectCA {
+		if err := impl.setTrustedCA(ctx, httpchaos, &pod, nil, false); err != nil {
+			return v1alpha1.Injected, err
+		}
+	}
+
 	source := httpchaos.Namespace + "/" + httpchaos.Name
 	m := impl.builder.WithInit(source, types.NamespacedName{
 		Namespace: pod.Namespace,
 		Name:      pod.Name,
 	})
+	if httpchaos.Spec.AutoTLS != nil {
+		m.T.ClearTLS(httpchaos.Namespace, autoTLSSecretName(httpchaos))
+	}
 
 	generationNumber, err := m.Commit(ctx)
 	if err != nil {
@@ -208,12 +241,18 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 		return v1alpha1.Injected, err
 	}
 
+	if httpchaos.Spec.AutoTLS != nil {
+		if err := impl.releaseAutoTLS(ctx, httpchaos, &pod); err != nil {
+			return v1alpha1.Injected, err
+		}
+	}
+
 	// Now modify the custom status and phase
 	httpchaos.Status.Instances[record.Id] = generationNumber
 	return waitForRecoverSync, nil
 }
 
-func NewImpl(c client.Client, b *podhttpchaosmanager.Builder, log logr.Logger) *impltypes.ChaosImplPair {
+func NewImpl(c client.Client, b *podhttpchaosmanager.Builder, log logr.Logger, chaosDaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder) *impltypes.ChaosImplPair {
 	return &impltypes.ChaosImplPair{
 		Name:   "httpchaos",
 		Object: &v1alpha1.HTTPChaos{},
@@ -221,6 +260,8 @@ func NewImpl(c client.Client, b *podhttpchaosmanager.Builder, log logr.Logger) *
 			Client:  c,
 			Log:     log.WithName("httpchaos"),
 			builder: b,
+
+			chaosDaemonClientBuilder: chaosDaemonClientBuilder,
 		},
 		ObjectList: &v1alpha1.HTTPChaosList{},
 		Controlls:  []client.Object{&v1alpha1.PodHttpChaos{}},
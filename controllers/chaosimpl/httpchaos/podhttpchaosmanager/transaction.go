@@ -50,6 +50,21 @@ func (s *Clear) Apply(chaos *v1alpha1.PodHttpChaos) error {
 	return nil
 }
 
+// ClearTLS removes the tls config which refers to the secret
+type ClearTLS struct {
+	SecretNamespace string
+	SecretName      string
+}
+
+// Apply runs this action
+func (s *ClearTLS) Apply(chaos *v1alpha1.PodHttpChaos) error {
+	tls := chaos.Spec.TLS
+	if tls != nil && tls.SecretNamespace == s.SecretNamespace && tls.SecretName == s.SecretName {
+		chaos.Spec.TLS = nil
+	}
+	return nil
+}
+
 // Append adds an item to corresponding list in podhttpchaos
 type Append struct {
 	Item interface{}
@@ -76,6 +91,14 @@ func (t *PodHttpTransaction) Clear(source string) {
 	})
 }
 
+// ClearTLS will clear the tls config which refers to the secret in podhttpchaos
+func (t *PodHttpTransaction) ClearTLS(secretNamespace, secretName string) {
+	t.Steps = append(t.Steps, &ClearTLS{
+		SecretNamespace: secretNamespace,
+		SecretName:      secretName,
+	})
+}
+
 // Append adds an item to corresponding list in podnetworkchaos
 func (t *PodHttpTransaction) Append(item interface{}) error {
 	switch item.(type) {
@@ -31,7 +31,7 @@ type Impl struct {
 	fx.In
 
 	TrafficControl *trafficcontrol.Impl `action:"bandwidth,netem,delay,loss,duplicate,corrupt"`
-	Partition      *partition.Impl      `action:"partition"`
+	Partition      *partition.Impl      `action:"partition,reset,reject"`
 }
 
 func NewImpl(impl Impl) *impltypes.ChaosImplPair {
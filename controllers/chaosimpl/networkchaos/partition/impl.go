@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -152,7 +153,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.SetDrop(ctx, m, targets, networkchaos, targetIPSetPostFix, v1alpha1.Output, networkchaos.Spec.Device)
+			err := impl.SetDrop(ctx, m, targets, networkchaos, targetIPSetPostFix, v1alpha1.Output, networkchaos.Spec.PortFilter, networkchaos.Spec.Device)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
@@ -168,7 +169,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.SetDrop(ctx, m, targets, networkchaos, targetIPSetPostFix, v1alpha1.Input, networkchaos.Spec.Device)
+			err := impl.SetDrop(ctx, m, targets, networkchaos, targetIPSetPostFix, v1alpha1.Input, networkchaos.Spec.PortFilter.Reverse(), networkchaos.Spec.Device)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
@@ -199,7 +200,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.SetDrop(ctx, m, targets, networkchaos, sourceIPSetPostFix, v1alpha1.Output, networkchaos.Spec.TargetDevice)
+			err := impl.SetDrop(ctx, m, targets, networkchaos, sourceIPSetPostFix, v1alpha1.Output, networkchaos.Spec.PortFilter.Reverse(), networkchaos.Spec.TargetDevice)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
@@ -215,7 +216,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.SetDrop(ctx, m, targets, networkchaos, sourceIPSetPostFix, v1alpha1.Input, networkchaos.Spec.TargetDevice)
+			err := impl.SetDrop(ctx, m, targets, networkchaos, sourceIPSetPostFix, v1alpha1.Input, networkchaos.Spec.PortFilter, networkchaos.Spec.TargetDevice)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
@@ -321,7 +322,7 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	return waitForRecoverSync, nil
 }
 
-func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, chainDirection v1alpha1.ChainDirection, device string) error {
+func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, chainDirection v1alpha1.ChainDirection, portFilter v1alpha1.PortFilter, device string) error {
 	externalCidrs, err := netutils.ResolveCidrs(networkchaos.Spec.ExternalTargets)
 	if err != nil {
 		return err
@@ -331,6 +332,26 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 	if chainDirection == v1alpha1.Input {
 		pbChainDirection = pb.Chain_INPUT
 	}
+
+	// the packets are rejected instead of being dropped by reset and reject action
+	var rejectWith v1alpha1.RejectType
+	switch networkchaos.Spec.Action {
+	case v1alpha1.ResetAction:
+		rejectWith = v1alpha1.TCPReset
+		// tcp reset can only be sent for tcp packets
+		portFilter.Protocol = "tcp"
+	case v1alpha1.RejectAction:
+		rejectWith = v1alpha1.ICMPPortUnreachable
+	}
+	var probability string
+	if networkchaos.Spec.Reject != nil {
+		probability = networkchaos.Spec.Reject.Probability
+	}
+	var flapStartTime *metav1.Time
+	if networkchaos.Spec.Flap != nil {
+		flapStartTime = networkchaos.CreationTimestamp.DeepCopy()
+	}
+
 	if len(targets)+len(externalCidrs) == 0 {
 		impl.Log.Info("apply traffic control", "sources", m.Source)
 		m.T.Append(v1alpha1.RawIptables{
@@ -340,7 +361,13 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: m.Source,
 			},
-			Device: device,
+			Device:      device,
+			PortFilter:  portFilter,
+			RejectWith:  rejectWith,
+			Probability: probability,
+
+			Flap:          networkchaos.Spec.Flap,
+			FlapStartTime: flapStartTime,
 		})
 		return nil
 	}
@@ -376,7 +403,13 @@ func (impl *Impl) SetDrop(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		RawRuleSource: v1alpha1.RawRuleSource{
 			Source: m.Source,
 		},
-		Device: device,
+		Device:      device,
+		PortFilter:  portFilter,
+		RejectWith:  rejectWith,
+		Probability: probability,
+
+		Flap:          networkchaos.Spec.Flap,
+		FlapStartTime: flapStartTime,
 	})
 
 	return nil
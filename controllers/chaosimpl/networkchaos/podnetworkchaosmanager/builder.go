@@ -21,30 +21,35 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 )
 
 type Builder struct {
 	Log logr.Logger
 	client.Client
 	client.Reader
-	scheme *runtime.Scheme
+	scheme   *runtime.Scheme
+	recorder recorder.ChaosRecorder
 }
 
 type Params struct {
 	fx.In
 
-	Logger logr.Logger
-	Client client.Client
-	Reader client.Reader `name:"no-cache"`
-	Scheme *runtime.Scheme
+	Logger          logr.Logger
+	Client          client.Client
+	Reader          client.Reader `name:"no-cache"`
+	Scheme          *runtime.Scheme
+	RecorderBuilder *recorder.RecorderBuilder
 }
 
 func NewBuilder(params Params) *Builder {
 	return &Builder{
-		Log:    params.Logger,
-		Client: params.Client,
-		Reader: params.Reader,
-		scheme: params.Scheme,
+		Log:      params.Logger,
+		Client:   params.Client,
+		Reader:   params.Reader,
+		scheme:   params.Scheme,
+		recorder: params.RecorderBuilder.Build("podnetworkchaos-manager"),
 	}
 }
 
@@ -52,11 +57,12 @@ func (b *Builder) Build(source string, key types.NamespacedName) *PodNetworkMana
 	t := &PodNetworkTransaction{}
 
 	return &PodNetworkManager{
-		Source: source,
-		Log:    b.Log,
-		Client: b.Client,
-		Reader: b.Reader,
-		scheme: b.scheme,
+		Source:   source,
+		Log:      b.Log,
+		Client:   b.Client,
+		Reader:   b.Reader,
+		scheme:   b.scheme,
+		recorder: b.recorder,
 
 		Key: key,
 		T:   t,
@@ -68,11 +74,12 @@ func (b *Builder) WithInit(source string, key types.NamespacedName) *PodNetworkM
 	t.Clear(source)
 
 	return &PodNetworkManager{
-		Source: source,
-		Log:    b.Log,
-		Client: b.Client,
-		Reader: b.Reader,
-		scheme: b.scheme,
+		Source:   source,
+		Log:      b.Log,
+		Client:   b.Client,
+		Reader:   b.Reader,
+		scheme:   b.scheme,
+		recorder: b.recorder,
 
 		Key: key,
 		T:   t,
@@ -0,0 +1,295 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podnetworkchaosmanager
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// defaultDevice is the device affected by the rules without device, which is the same as chaos daemon
+const defaultDevice = "eth0"
+
+// ErrConflict means the rules overlap with the rules of other sources, and the conflict policy is reject
+var ErrConflict = errors.New("rules conflict with other network chaos")
+
+// Conflict represents the rules of a source overlap with the rules of another source on the same pod
+type Conflict struct {
+	// Source is the other source
+	Source string
+
+	// Rules describes the overlapping rules
+	Rules []string
+
+	// the indexes of the overlapping rules of the other source
+	tcs      []int
+	iptables []int
+}
+
+// DetectConflicts finds the rules of other sources overlapping with the rules of source.
+// The traffic controls overlap when they have the same type on the same device, and their
+// filters match the same packets. The iptables rules overlap when they match the same
+// packets, but respond to them in different ways. The displaced rules are skipped, as
+// they aren't applied on the pod.
+func DetectConflicts(chaos *v1alpha1.PodNetworkChaos, source string) []Conflict {
+	conflicts := make(map[string]*Conflict)
+	conflictOf := func(source string) *Conflict {
+		if conflicts[source] == nil {
+			conflicts[source] = &Conflict{Source: source}
+		}
+		return conflicts[source]
+	}
+
+	for _, tc := range chaos.Spec.TrafficControls {
+		if tc.Source != source {
+			continue
+		}
+		for j, other := range chaos.Spec.TrafficControls {
+			if other.Source == source || other.DisplacedBy != "" || other.Type != tc.Type || other.Ingress != tc.Ingress ||
+				deviceOf(other.Device) != deviceOf(tc.Device) ||
+				!portFilterOverlaps(tc.PortFilter, other.PortFilter) ||
+				!ipsetsOverlap(chaos, []string{tc.IPSet}, []string{other.IPSet}) {
+				continue
+			}
+
+			rule := fmt.Sprintf("%s on device %s", tc.Type, deviceOf(tc.Device))
+			if tc.Ingress {
+				rule = fmt.Sprintf("%s on the ingress of device %s", tc.Type, deviceOf(tc.Device))
+			}
+			conflict := conflictOf(other.Source)
+			conflict.Rules = appendUnique(conflict.Rules, rule)
+			conflict.tcs = appendUniqueIndex(conflict.tcs, j)
+		}
+	}
+
+	for _, chain := range chaos.Spec.Iptables {
+		if chain.Source != source {
+			continue
+		}
+		for j, other := range chaos.Spec.Iptables {
+			if other.Source == source || other.DisplacedBy != "" || other.Direction != chain.Direction || other.RejectWith == chain.RejectWith ||
+				deviceOf(other.Device) != deviceOf(chain.Device) ||
+				!portFilterOverlaps(chain.PortFilter, other.PortFilter) ||
+				!ipsetsOverlap(chaos, chain.IPSets, other.IPSets) {
+				continue
+			}
+
+			rule := fmt.Sprintf("iptables %s on device %s", chain.Direction, deviceOf(chain.Device))
+			conflict := conflictOf(other.Source)
+			conflict.Rules = appendUnique(conflict.Rules, rule)
+			conflict.iptables = appendUniqueIndex(conflict.iptables, j)
+		}
+	}
+
+	sources := make([]string, 0, len(conflicts))
+	for source := range conflicts {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	result := make([]Conflict, 0, len(sources))
+	for _, source := range sources {
+		result = append(result, *conflicts[source])
+	}
+	return result
+}
+
+// DisplaceConflicts marks the overlapping rules of other sources as displaced by source, they
+// are kept in the podnetworkchaos but not applied, until the rules of source are cleared
+func DisplaceConflicts(chaos *v1alpha1.PodNetworkChaos, source string, conflicts []Conflict) {
+	for _, conflict := range conflicts {
+		for _, index := range conflict.tcs {
+			chaos.Spec.TrafficControls[index].DisplacedBy = source
+		}
+		for _, index := range conflict.iptables {
+			chaos.Spec.Iptables[index].DisplacedBy = source
+		}
+	}
+}
+
+// sourcesOf returns all the sources of rules in the podnetworkchaos except the source
+func sourcesOf(chaos *v1alpha1.PodNetworkChaos, except string) []string {
+	set := make(map[string]bool)
+	for _, ipset := range chaos.Spec.IPSets {
+		set[ipset.Source] = true
+	}
+	for _, chain := range chaos.Spec.Iptables {
+		set[chain.Source] = true
+	}
+	for _, tc := range chaos.Spec.TrafficControls {
+		set[tc.Source] = true
+	}
+	delete(set, except)
+
+	sources := make([]string, 0, len(set))
+	for source := range set {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	return sources
+}
+
+func deviceOf(device string) string {
+	if device == "" {
+		return defaultDevice
+	}
+	return device
+}
+
+func portFilterOverlaps(a, b v1alpha1.PortFilter) bool {
+	if a.Protocol != "" && b.Protocol != "" && a.Protocol != b.Protocol {
+		return false
+	}
+
+	return portsOverlap(a.SourcePorts, b.SourcePorts) && portsOverlap(a.DestinationPorts, b.DestinationPorts)
+}
+
+// portsOverlap returns whether two lists of ports have common ports, an empty list matches all ports
+func portsOverlap(a, b string) bool {
+	if a == "" || b == "" {
+		return true
+	}
+
+	for _, x := range parsePortRanges(a) {
+		for _, y := range parsePortRanges(b) {
+			if x[0] <= y[1] && y[0] <= x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// parsePortRanges parses ports like "80", "8000:8080" or "80,443" into ranges,
+// the invalid items are ignored as they have been rejected by the webhook
+func parsePortRanges(ports string) [][2]int {
+	var ranges [][2]int
+	for _, item := range strings.Split(ports, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(item), ":", 2)
+		low, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			continue
+		}
+		high := low
+		if len(bounds) == 2 {
+			high, err = strconv.Atoi(bounds[1])
+			if err != nil {
+				continue
+			}
+		}
+		ranges = append(ranges, [2]int{low, high})
+	}
+	return ranges
+}
+
+// ipsetsOverlap returns whether the ipsets have common addresses, an empty list or
+// an empty name matches all addresses
+func ipsetsOverlap(chaos *v1alpha1.PodNetworkChaos, a, b []string) bool {
+	x := ipsetsCidrs(chaos, a)
+	y := ipsetsCidrs(chaos, b)
+	if x == nil || y == nil {
+		return true
+	}
+
+	for _, i := range x {
+		for _, j := range y {
+			if i.Contains(j.IP) || j.Contains(i.IP) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ipsetsCidrs returns the cidrs in the ipsets, or nil if the ipsets match all addresses
+func ipsetsCidrs(chaos *v1alpha1.PodNetworkChaos, names []string) []*net.IPNet {
+	cidrs := []*net.IPNet{}
+	for _, name := range names {
+		if name == "" {
+			return nil
+		}
+		cidrs = append(cidrs, ipsetCidrs(chaos, name, map[string]bool{})...)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return cidrs
+}
+
+func ipsetCidrs(chaos *v1alpha1.PodNetworkChaos, name string, visited map[string]bool) []*net.IPNet {
+	if visited[name] {
+		return nil
+	}
+	visited[name] = true
+
+	var cidrs []*net.IPNet
+	for _, ipset := range chaos.Spec.IPSets {
+		if ipset.Name != name {
+			continue
+		}
+
+		for _, cidr := range ipset.Cidrs {
+			cidrs = appendCidr(cidrs, cidr)
+		}
+		for _, cidrAndPort := range ipset.CidrAndPorts {
+			cidrs = appendCidr(cidrs, cidrAndPort.Cidr)
+		}
+		for _, setName := range ipset.SetNames {
+			cidrs = append(cidrs, ipsetCidrs(chaos, setName, visited)...)
+		}
+	}
+	return cidrs
+}
+
+func appendCidr(cidrs []*net.IPNet, cidr string) []*net.IPNet {
+	if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+		return append(cidrs, ipNet)
+	}
+	if ip := net.ParseIP(cidr); ip != nil {
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 8 * net.IPv4len
+		}
+		return append(cidrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+	}
+	return cidrs
+}
+
+func appendUnique(items []string, item string) []string {
+	for _, existing := range items {
+		if existing == item {
+			return items
+		}
+	}
+	return append(items, item)
+}
+
+func appendUniqueIndex(items []int, item int) []int {
+	for _, existing := range items {
+		if existing == item {
+			return items
+		}
+	}
+	return append(items, item)
+}
@@ -0,0 +1,88 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podnetworkchaosmanager
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestDetectConflicts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	chaos := &v1alpha1.PodNetworkChaos{
+		Spec: v1alpha1.PodNetworkChaosSpec{
+			IPSets: []v1alpha1.RawIPSet{
+				{Name: "a", IPSetType: v1alpha1.NetIPSet, Cidrs: []string{"10.0.0.0/24"}, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/a"}},
+				{Name: "b", IPSetType: v1alpha1.NetIPSet, Cidrs: []string{"10.0.0.1/32"}, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/b"}},
+				{Name: "c", IPSetType: v1alpha1.NetIPSet, Cidrs: []string{"192.168.0.1/32"}, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/c"}},
+			},
+			TrafficControls: []v1alpha1.RawTrafficControl{
+				{Type: v1alpha1.Netem, IPSet: "a", Source: "ns/a"},
+				{Type: v1alpha1.Netem, IPSet: "b", Source: "ns/b"},
+				{Type: v1alpha1.Netem, IPSet: "c", Source: "ns/c"},
+				{Type: v1alpha1.Bandwidth, IPSet: "b", Source: "ns/b"},
+			},
+			Iptables: []v1alpha1.RawIptables{
+				{Name: "a", IPSets: []string{"a"}, Direction: v1alpha1.Output, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/a"}},
+				{Name: "b", IPSets: []string{"b"}, Direction: v1alpha1.Output, RejectWith: v1alpha1.TCPReset, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/b"}},
+				{Name: "c", IPSets: []string{"a"}, Direction: v1alpha1.Input, RejectWith: v1alpha1.TCPReset, RawRuleSource: v1alpha1.RawRuleSource{Source: "ns/c"}},
+			},
+		},
+	}
+
+	conflicts := DetectConflicts(chaos, "ns/a")
+	g.Expect(conflicts).Should(HaveLen(1))
+	g.Expect(conflicts[0].Source).Should(Equal("ns/b"))
+	g.Expect(conflicts[0].Rules).Should(Equal([]string{"netem on device eth0", "iptables output on device eth0"}))
+
+	DisplaceConflicts(chaos, "ns/a", conflicts)
+	g.Expect(chaos.Spec.TrafficControls[1].DisplacedBy).Should(Equal("ns/a"))
+	g.Expect(chaos.Spec.TrafficControls[2].DisplacedBy).Should(BeEmpty())
+	g.Expect(chaos.Spec.TrafficControls[3].DisplacedBy).Should(BeEmpty())
+	g.Expect(chaos.Spec.Iptables[1].DisplacedBy).Should(Equal("ns/a"))
+	g.Expect(DetectConflicts(chaos, "ns/a")).Should(BeEmpty())
+
+	// the displaced rules are restored when the rules of ns/a are cleared
+	g.Expect((&Clear{Source: "ns/a"}).Apply(chaos)).Should(Succeed())
+	g.Expect(chaos.Spec.TrafficControls).Should(HaveLen(3))
+	g.Expect(chaos.Spec.TrafficControls[0].DisplacedBy).Should(BeEmpty())
+	g.Expect(chaos.Spec.Iptables).Should(HaveLen(2))
+	g.Expect(chaos.Spec.Iptables[0].DisplacedBy).Should(BeEmpty())
+}
+
+func TestPortsOverlap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cases := []struct {
+		a, b    string
+		overlap bool
+	}{
+		{"", "80", true},
+		{"80", "80", true},
+		{"80,443", "443", true},
+		{"1000:2000", "1500", true},
+		{"1000:2000", "2001:3000", false},
+		{"80", "8080", false},
+	}
+
+	for _, c := range cases {
+		g.Expect(portsOverlap(c.a, c.b)).Should(Equal(c.overlap), c.a+" and "+c.b)
+	}
+}
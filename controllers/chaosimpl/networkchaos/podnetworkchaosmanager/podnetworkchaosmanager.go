@@ -17,6 +17,8 @@ package podnetworkchaosmanager
 
 import (
 	"context"
+	"reflect"
+	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -29,6 +31,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 )
 
 var (
@@ -47,7 +51,8 @@ type PodNetworkManager struct {
 	Log logr.Logger
 	client.Client
 	client.Reader
-	scheme *runtime.Scheme
+	scheme   *runtime.Scheme
+	recorder recorder.ChaosRecorder
 
 	Key types.NamespacedName
 	T   *PodNetworkTransaction
@@ -59,10 +64,20 @@ type CommitResponse struct {
 	Err error
 }
 
-// Commit will update all modifications to the cluster
+// Commit will update all modifications to the cluster. The rules overlapping with the rules
+// of other sources are resolved according to the conflict policy of owner, and the conflicts
+// are recorded in the status of all the related NetworkChaos.
 func (m *PodNetworkManager) Commit(ctx context.Context, owner *v1alpha1.NetworkChaos) (int64, error) {
 	m.Log.Info("running modification on pod", "key", m.Key, "modification", m.T)
+
+	policy := owner.Spec.ConflictPolicy
+	if policy == "" {
+		policy = v1alpha1.MergeAdditiveConflictPolicy
+	}
+	var conflicts []Conflict
+	var others []string
 	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		conflicts, others = nil, nil
 		chaos := &v1alpha1.PodNetworkChaos{}
 
 		err := m.Client.Get(ctx, m.Key, chaos)
@@ -87,9 +102,25 @@ func (m *PodNetworkManager) Commit(ctx context.Context, owner *v1alpha1.NetworkC
 			return err
 		}
 
+		others = sourcesOf(chaos, m.Source)
+		conflicts = DetectConflicts(chaos, m.Source)
+		if len(conflicts) > 0 {
+			m.Log.Info("rules conflict with other sources", "key", m.Key, "conflicts", conflicts, "policy", policy)
+			switch policy {
+			case v1alpha1.RejectConflictPolicy:
+				return ErrConflict
+			case v1alpha1.LastWinsConflictPolicy:
+				DisplaceConflicts(chaos, m.Source, conflicts)
+			}
+		}
+
 		return m.Client.Update(ctx, chaos)
 	})
+	m.reportConflicts(ctx, owner, others, conflicts, policy)
 	if updateError != nil {
+		if errors.Is(updateError, ErrConflict) {
+			return 0, errors.Wrapf(updateError, "pod %s", m.Key)
+		}
 		return 0, updateError
 	}
 
@@ -143,3 +174,109 @@ func (m *PodNetworkManager) CreateNewPodNetworkChaos(ctx context.Context) error
 
 	return m.Client.Create(ctx, chaos)
 }
+
+// reportConflicts replaces the conflicts on this pod in the status of owner, and the conflicts
+// with owner on this pod in the status of other sources. An event is recorded on both sides
+// when a new conflict is found. The status of owner is changed in memory, and it's saved
+// together with the records by the caller.
+func (m *PodNetworkManager) reportConflicts(ctx context.Context, owner *v1alpha1.NetworkChaos, others []string, conflicts []Conflict, policy v1alpha1.NetworkConflictPolicy) {
+	pod := m.Key.String()
+
+	ownerConflicts := []v1alpha1.NetworkConflict{}
+	for _, conflict := range conflicts {
+		ownerConflicts = append(ownerConflicts, v1alpha1.NetworkConflict{
+			Pod:    pod,
+			Source: conflict.Source,
+			Rules:  strings.Join(conflict.Rules, ", "),
+			Policy: policy,
+		})
+	}
+	existing := owner.Status.Conflicts
+	owner.Status.Conflicts = replaceConflicts(existing, pod, "", ownerConflicts)
+	m.recordNewConflicts(owner, existing, ownerConflicts)
+
+	for _, other := range others {
+		key, err := controller.ParseNamespacedName(other)
+		if err != nil {
+			continue
+		}
+
+		otherConflicts := []v1alpha1.NetworkConflict{}
+		for _, conflict := range ownerConflicts {
+			if conflict.Source == other {
+				conflict.Source = m.Source
+				otherConflicts = append(otherConflicts, conflict)
+			}
+		}
+
+		chaos := &v1alpha1.NetworkChaos{}
+		if err := m.Client.Get(ctx, key, chaos); err != nil {
+			if !k8sError.IsNotFound(err) {
+				m.Log.Error(err, "error while getting networkchaos", "key", key)
+			}
+			continue
+		}
+		m.updateConflicts(ctx, chaos, pod, m.Source, otherConflicts)
+	}
+}
+
+// replaceConflicts replaces the conflicts on the pod, which are with the source if it's not empty
+func replaceConflicts(existing []v1alpha1.NetworkConflict, pod string, source string, conflicts []v1alpha1.NetworkConflict) []v1alpha1.NetworkConflict {
+	result := []v1alpha1.NetworkConflict{}
+	for _, conflict := range existing {
+		if conflict.Pod != pod || (source != "" && conflict.Source != source) {
+			result = append(result, conflict)
+		}
+	}
+	result = append(result, conflicts...)
+	if len(result) == 0 {
+		return nil
+	}
+	return result
+}
+
+// updateConflicts replaces the conflicts of other chaos on the pod, which are with the source,
+// and records an event for every new conflict
+func (m *PodNetworkManager) updateConflicts(ctx context.Context, chaos *v1alpha1.NetworkChaos, pod string, source string, conflicts []v1alpha1.NetworkConflict) {
+	existing := chaos.Status.Conflicts
+	if reflect.DeepEqual(existing, replaceConflicts(existing, pod, source, conflicts)) {
+		return
+	}
+
+	key := types.NamespacedName{Namespace: chaos.Namespace, Name: chaos.Name}
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := &v1alpha1.NetworkChaos{}
+		if err := m.Client.Get(ctx, key, obj); err != nil {
+			return err
+		}
+
+		obj.Status.Conflicts = replaceConflicts(obj.Status.Conflicts, pod, source, conflicts)
+		return m.Client.Update(ctx, obj)
+	})
+	if err != nil {
+		m.Log.Error(err, "error while updating conflicts of networkchaos", "key", key)
+		return
+	}
+
+	m.recordNewConflicts(chaos, existing, conflicts)
+}
+
+// recordNewConflicts records an event for every conflict which isn't in the existing conflicts
+func (m *PodNetworkManager) recordNewConflicts(chaos *v1alpha1.NetworkChaos, existing []v1alpha1.NetworkConflict, conflicts []v1alpha1.NetworkConflict) {
+	for _, conflict := range conflicts {
+		found := false
+		for _, e := range existing {
+			if e.Pod == conflict.Pod && e.Source == conflict.Source {
+				found = true
+				break
+			}
+		}
+		if !found {
+			m.recorder.Event(chaos, recorder.Conflicted{
+				Pod:    conflict.Pod,
+				Source: conflict.Source,
+				Policy: string(conflict.Policy),
+			})
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podnetworkchaosmanager
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/cmd/chaos-controller-manager/provider"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+)
+
+func TestCommitWithConflicts(t *testing.T) {
+	key := types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "pod"}
+	newClient := func() client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(provider.NewScheme()).
+			WithObjects(
+				&v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+					Status:     v1.PodStatus{Phase: v1.PodRunning},
+				},
+				&v1alpha1.PodNetworkChaos{
+					ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+					Spec: v1alpha1.PodNetworkChaosSpec{
+						TrafficControls: []v1alpha1.RawTrafficControl{
+							{Type: v1alpha1.Netem, Source: "default/other"},
+						},
+					},
+				},
+				&v1alpha1.NetworkChaos{
+					ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "other"},
+				},
+			).
+			Build()
+	}
+	commit := func(c client.Client, policy v1alpha1.NetworkConflictPolicy, inject bool) (*v1alpha1.NetworkChaos, error) {
+		owner := &v1alpha1.NetworkChaos{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "owner"},
+			Spec:       v1alpha1.NetworkChaosSpec{ConflictPolicy: policy},
+		}
+		m := &PodNetworkManager{
+			Source:   "default/owner",
+			Log:      zap.New(),
+			Client:   c,
+			Reader:   c,
+			recorder: recorder.NewDebugRecorder(),
+			Key:      key,
+			T:        &PodNetworkTransaction{},
+		}
+		m.T.Clear(m.Source)
+		if inject {
+			m.T.Append(v1alpha1.RawTrafficControl{Type: v1alpha1.Netem, Source: m.Source})
+		}
+
+		_, err := m.Commit(context.TODO(), owner)
+		return owner, err
+	}
+	otherConflicts := func(c client.Client) []v1alpha1.NetworkConflict {
+		other := &v1alpha1.NetworkChaos{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "other"}, other)).To(Succeed())
+		return other.Status.Conflicts
+	}
+	tcs := func(c client.Client) []v1alpha1.RawTrafficControl {
+		chaos := &v1alpha1.PodNetworkChaos{}
+		Expect(c.Get(context.TODO(), key, chaos)).To(Succeed())
+		return chaos.Spec.TrafficControls
+	}
+	sources := func(c client.Client) []string {
+		var sources []string
+		for _, tc := range tcs(c) {
+			sources = append(sources, tc.Source)
+		}
+		return sources
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		RegisterTestingT(t)
+		c := newClient()
+
+		owner, err := commit(c, v1alpha1.RejectConflictPolicy, true)
+		Expect(err).To(MatchError(ErrConflict))
+		Expect(sources(c)).To(Equal([]string{"default/other"}))
+
+		// the conflicts of owner are set in memory, and saved with the records by the caller
+		Expect(owner.Status.Conflicts).To(Equal([]v1alpha1.NetworkConflict{{
+			Pod:    "default/pod",
+			Source: "default/other",
+			Rules:  "netem on device eth0",
+			Policy: v1alpha1.RejectConflictPolicy,
+		}}))
+		Expect(owner.GetCustomStatus()).To(Equal(&owner.Status.NetworkInjectionStatus))
+		Expect(otherConflicts(c)).To(Equal([]v1alpha1.NetworkConflict{{
+			Pod:    "default/pod",
+			Source: "default/owner",
+			Rules:  "netem on device eth0",
+			Policy: v1alpha1.RejectConflictPolicy,
+		}}))
+	})
+
+	t.Run("last wins", func(t *testing.T) {
+		RegisterTestingT(t)
+		c := newClient()
+
+		owner, err := commit(c, v1alpha1.LastWinsConflictPolicy, true)
+		Expect(err).To(BeNil())
+		Expect(sources(c)).To(Equal([]string{"default/other", "default/owner"}))
+		Expect(tcs(c)[0].DisplacedBy).To(Equal("default/owner"))
+		Expect(owner.Status.Conflicts).To(HaveLen(1))
+		Expect(owner.Status.Conflicts[0].Source).To(Equal("default/other"))
+		Expect(owner.Status.Conflicts[0].Policy).To(Equal(v1alpha1.LastWinsConflictPolicy))
+		Expect(otherConflicts(c)).To(HaveLen(1))
+		Expect(otherConflicts(c)[0].Source).To(Equal("default/owner"))
+
+		// the displaced rules are applied again when owner is recovered
+		owner, err = commit(c, v1alpha1.LastWinsConflictPolicy, false)
+		Expect(err).To(BeNil())
+		Expect(tcs(c)).To(Equal([]v1alpha1.RawTrafficControl{{Type: v1alpha1.Netem, Source: "default/other"}}))
+		Expect(owner.Status.Conflicts).To(BeEmpty())
+		Expect(otherConflicts(c)).To(BeEmpty())
+	})
+}
@@ -33,7 +33,7 @@ type Step interface {
 	Apply(chaos *v1alpha1.PodNetworkChaos) error
 }
 
-// Clear removes all resources with the same source
+// Clear removes all resources with the same source, and restores the rules displaced by them
 type Clear struct {
 	Source string
 }
@@ -51,6 +51,9 @@ func (s *Clear) Apply(chaos *v1alpha1.PodNetworkChaos) error {
 	chains := []v1alpha1.RawIptables{}
 	for _, chain := range chaos.Spec.Iptables {
 		if chain.Source != s.Source {
+			if chain.DisplacedBy == s.Source {
+				chain.DisplacedBy = ""
+			}
 			chains = append(chains, chain)
 		}
 	}
@@ -59,6 +62,9 @@ func (s *Clear) Apply(chaos *v1alpha1.PodNetworkChaos) error {
 	qdiscs := []v1alpha1.RawTrafficControl{}
 	for _, qdisc := range chaos.Spec.TrafficControls {
 		if qdisc.Source != s.Source {
+			if qdisc.DisplacedBy == s.Source {
+				qdisc.DisplacedBy = ""
+			}
 			qdiscs = append(qdiscs, qdisc)
 		}
 	}
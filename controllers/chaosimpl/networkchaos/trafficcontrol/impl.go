@@ -17,12 +17,14 @@ package trafficcontrol
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -30,6 +32,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/networkchaos/podnetworkchaosmanager"
 	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkprofile"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/ipset"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/netutils"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
@@ -38,8 +41,12 @@ import (
 var _ impltypes.ChaosImpl = (*Impl)(nil)
 
 const (
-	targetIPSetPostFix = "tgt"
-	sourceIPSetPostFix = "src"
+	targetIPSetPostFix  = "tgt"
+	sourceIPSetPostFix  = "src"
+	ingressIPSetPostFix = "igr"
+
+	// matrixIPSetPreFix is followed by the post fix and the index of matrix entry
+	matrixIPSetPreFix = "mx"
 )
 
 const (
@@ -117,6 +124,8 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 	})
 
 	if record.SelectorKey == "." {
+		shouldCommit := false
+
 		if networkchaos.Spec.Direction == v1alpha1.To || networkchaos.Spec.Direction == v1alpha1.Both {
 			var targets []*v1alpha1.Record
 			for _, record := range records {
@@ -125,11 +134,32 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.ApplyTc(ctx, m, targets, networkchaos, targetIPSetPostFix, networkchaos.Spec.Device)
+			err := impl.applyTcOrMatrix(ctx, m, records, targets, networkchaos, targetIPSetPostFix, networkchaos.Spec.PortFilter, networkchaos.Spec.Device, false)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
 
+			shouldCommit = true
+		}
+
+		if networkchaos.Spec.ShapeIngress &&
+			(networkchaos.Spec.Direction == v1alpha1.From || networkchaos.Spec.Direction == v1alpha1.Both) {
+			var targets []*v1alpha1.Record
+			for _, record := range records {
+				if record.SelectorKey == ".Target" {
+					targets = append(targets, record)
+				}
+			}
+
+			err := impl.applyTcOrMatrix(ctx, m, records, targets, networkchaos, ingressIPSetPostFix, networkchaos.Spec.PortFilter.Reverse(), networkchaos.Spec.Device, true)
+			if err != nil {
+				return v1alpha1.NotInjected, err
+			}
+
+			shouldCommit = true
+		}
+
+		if shouldCommit {
 			generationNumber, err := m.Commit(ctx, networkchaos)
 			if err != nil {
 				return v1alpha1.NotInjected, err
@@ -142,7 +172,9 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 
 		return v1alpha1.Injected, nil
 	} else if record.SelectorKey == ".Target" {
-		if networkchaos.Spec.Direction == v1alpha1.From || networkchaos.Spec.Direction == v1alpha1.Both {
+		// the `from` traffic has been shaped on the ingress of the selected pods
+		if !networkchaos.Spec.ShapeIngress &&
+			(networkchaos.Spec.Direction == v1alpha1.From || networkchaos.Spec.Direction == v1alpha1.Both) {
 			var targets []*v1alpha1.Record
 			for _, record := range records {
 				if record.SelectorKey == "." {
@@ -150,7 +182,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 				}
 			}
 
-			err := impl.ApplyTc(ctx, m, targets, networkchaos, sourceIPSetPostFix, networkchaos.Spec.TargetDevice)
+			err := impl.ApplyTc(ctx, m, targets, networkchaos, sourceIPSetPostFix, networkchaos.Spec.PortFilter.Reverse(), networkchaos.Spec.TargetDevice, false)
 			if err != nil {
 				return v1alpha1.NotInjected, err
 			}
@@ -165,6 +197,46 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 			return waitForApplySync, nil
 		}
 
+		return v1alpha1.Injected, nil
+	} else if strings.HasPrefix(record.SelectorKey, ".Matrix") {
+		if !networkchaos.Spec.ShapeIngress &&
+			(networkchaos.Spec.Direction == v1alpha1.From || networkchaos.Spec.Direction == v1alpha1.Both) {
+			var targets []*v1alpha1.Record
+			for _, record := range records {
+				if record.SelectorKey == "." {
+					targets = append(targets, record)
+				}
+			}
+
+			// the pod may be selected by the targets of several entries, and all of them
+			// should be committed together
+			for i := range networkchaos.Spec.Matrix {
+				selected := false
+				for _, r := range records {
+					if r.SelectorKey == v1alpha1.MatrixTargetSelectorKey(i) && r.Id == record.Id {
+						selected = true
+					}
+				}
+				if !selected {
+					continue
+				}
+
+				err := impl.ApplyMatrixTc(ctx, m, i, targets, networkchaos, sourceIPSetPostFix, networkchaos.Spec.PortFilter.Reverse(), networkchaos.Spec.TargetDevice, false)
+				if err != nil {
+					return v1alpha1.NotInjected, err
+				}
+			}
+
+			generationNumber, err := m.Commit(ctx, networkchaos)
+			if err != nil {
+				return v1alpha1.NotInjected, err
+			}
+
+			// modify the custom status
+			networkchaos.Status.Instances[record.Id] = generationNumber
+			return waitForApplySync, nil
+		}
+
 		return v1alpha1.Injected, nil
 	} else {
 		impl.Log.Info("unknown selector key", "record", record)
@@ -250,7 +322,30 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	return waitForRecoverSync, nil
 }
 
-func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, device string) error {
+// applyTcOrMatrix applies the traffic control of spec, or every entry of matrix with its own targets
+func (impl *Impl) applyTcOrMatrix(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, records []*v1alpha1.Record, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, portFilter v1alpha1.PortFilter, device string, ingress bool) error {
+	if len(networkchaos.Spec.Matrix) == 0 {
+		return impl.ApplyTc(ctx, m, targets, networkchaos, ipSetPostFix, portFilter, device, ingress)
+	}
+
+	for i := range networkchaos.Spec.Matrix {
+		var matrixTargets []*v1alpha1.Record
+		for _, record := range records {
+			if record.SelectorKey == v1alpha1.MatrixTargetSelectorKey(i) {
+				matrixTargets = append(matrixTargets, record)
+			}
+		}
+
+		err := impl.ApplyMatrixTc(ctx, m, i, matrixTargets, networkchaos, ipSetPostFix, portFilter, device, ingress)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, portFilter v1alpha1.PortFilter, device string, ingress bool) error {
 	spec := networkchaos.Spec
 	tcType := v1alpha1.Bandwidth
 	switch spec.Action {
@@ -262,19 +357,89 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		return errors.Wrapf(utils.ErrUnknownAction, "action: %s", spec.Action)
 	}
 
-	externalCidrs, err := netutils.ResolveCidrs(networkchaos.Spec.ExternalTargets)
+	profile, err := networkprofile.ResolveProfile(ctx, impl.Client, networkchaos)
 	if err != nil {
 		return err
 	}
+	var profileStartTime *metav1.Time
+	if profile != nil {
+		// the profile starts from the injection on the record, so it's replayed from
+		// the beginning after the chaos is paused and resumed
+		now := metav1.Now()
+		profileStartTime = &now
+	}
 
-	if len(targets)+len(externalCidrs) == 0 {
-		impl.Log.Info("apply traffic control", "sources", m.Source)
-		m.T.Append(v1alpha1.RawTrafficControl{
-			Type:        tcType,
-			TcParameter: spec.TcParameter,
+	tc := v1alpha1.RawTrafficControl{
+		Type:        tcType,
+		TcParameter: spec.TcParameter,
+		Source:      m.Source,
+		Device:      device,
+		PortFilter:  portFilter,
+		Ingress:     ingress,
+
+		Profile:          profile,
+		ProfileStartTime: profileStartTime,
+	}
+
+	ipSetWithTcPostFix := string(tcType[0:2]) + ipSetPostFix
+	return impl.applyFilteredTcs(ctx, m, targets, spec.ExternalTargets, networkchaos, ipSetWithTcPostFix, []v1alpha1.RawTrafficControl{tc})
+}
+
+// ApplyMatrixTc applies the traffic control of the index-th entry of matrix, the netem and
+// bandwidth of the entry share the same filter, so they are chained in the same band.
+func (impl *Impl) ApplyMatrixTc(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, index int, targets []*v1alpha1.Record, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, portFilter v1alpha1.PortFilter, device string, ingress bool) error {
+	entry := networkchaos.Spec.Matrix[index]
+
+	var tcs []v1alpha1.RawTrafficControl
+	netemParameter := entry.TcParameter
+	netemParameter.Bandwidth = nil
+	if netemParameter != (v1alpha1.TcParameter{}) {
+		tcs = append(tcs, v1alpha1.RawTrafficControl{
+			Type:        v1alpha1.Netem,
+			TcParameter: netemParameter,
 			Source:      m.Source,
 			Device:      device,
+			PortFilter:  portFilter,
+			Ingress:     ingress,
 		})
+	}
+	if entry.Bandwidth != nil {
+		tcs = append(tcs, v1alpha1.RawTrafficControl{
+			Type: v1alpha1.Bandwidth,
+			TcParameter: v1alpha1.TcParameter{
+				Bandwidth: entry.Bandwidth,
+			},
+			Source:     m.Source,
+			Device:     device,
+			PortFilter: portFilter,
+			Ingress:    ingress,
+		})
+	}
+
+	ipSetWithTcPostFix := fmt.Sprintf("%s%s%d", matrixIPSetPreFix, ipSetPostFix, index)
+	return impl.applyFilteredTcs(ctx, m, targets, entry.ExternalTargets, networkchaos, ipSetWithTcPostFix, tcs)
+}
+
+// applyFilteredTcs appends the traffic controls with the ipset of targets as filter,
+// or without filter if there is no target
+func (impl *Impl) applyFilteredTcs(ctx context.Context, m *podnetworkchaosmanager.PodNetworkManager, targets []*v1alpha1.Record, externalTargets []string, networkchaos *v1alpha1.NetworkChaos, ipSetPostFix string, tcs []v1alpha1.RawTrafficControl) error {
+	externalCidrs, err := netutils.ResolveCidrs(externalTargets)
+	if err != nil {
+		return err
+	}
+
+	if networkchaos.Spec.Flap != nil {
+		for i := range tcs {
+			tcs[i].Flap = networkchaos.Spec.Flap
+			tcs[i].FlapStartTime = networkchaos.CreationTimestamp.DeepCopy()
+		}
+	}
+
+	if len(targets)+len(externalCidrs) == 0 {
+		impl.Log.Info("apply traffic control", "sources", m.Source)
+		for _, tc := range tcs {
+			m.T.Append(tc)
+		}
 		return nil
 	}
 
@@ -293,9 +458,8 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 		}
 		targetPods = append(targetPods, pod)
 	}
-	ipSetWithTcPostFix := string(tcType[0:2]) + ipSetPostFix
-	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, networkchaos, ipSetWithTcPostFix, m.Source)
-	dstSetIPSet := ipset.BuildSetIPSet(dstIPSets, networkchaos, ipSetWithTcPostFix, m.Source)
+	dstIPSets := ipset.BuildIPSets(targetPods, externalCidrs, networkchaos, ipSetPostFix, m.Source)
+	dstSetIPSet := ipset.BuildSetIPSet(dstIPSets, networkchaos, ipSetPostFix, m.Source)
 	impl.Log.Info("apply traffic control with filter", "sources", m.Source, "setIpset", dstSetIPSet, "ipSets", dstIPSets)
 
 	for _, ipSet := range dstIPSets {
@@ -304,13 +468,10 @@ func (impl *Impl) ApplyTc(ctx context.Context, m *podnetworkchaosmanager.PodNetw
 
 	m.T.Append(dstSetIPSet)
 
-	m.T.Append(v1alpha1.RawTrafficControl{
-		Type:        tcType,
-		TcParameter: spec.TcParameter,
-		Source:      m.Source,
-		IPSet:       dstSetIPSet.Name,
-		Device:      device,
-	})
+	for _, tc := range tcs {
+		tc.IPSet = dstSetIPSet.Name
+		m.T.Append(tc)
+	}
 
 	return nil
 }
@@ -0,0 +1,128 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package trafficcontrol
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/networkchaos/podnetworkchaosmanager"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/ipset"
+)
+
+func TestApplyMatrixTc(t *testing.T) {
+	g := NewWithT(t)
+
+	target := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "target"},
+		Status:     v1.PodStatus{PodIP: "172.16.0.2"},
+	}
+	c := fake.NewClientBuilder().WithObjects(target).Build()
+	impl := NewImpl(c, &podnetworkchaosmanager.Builder{Log: zap.New(), Client: c}, zap.New())
+
+	networkchaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "matrix"},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action: v1alpha1.NetemAction,
+			Matrix: []v1alpha1.NetworkMatrixEntry{
+				{
+					Target: &v1alpha1.PodSelector{},
+					TcParameter: v1alpha1.TcParameter{
+						Delay:     &v1alpha1.DelaySpec{Latency: "10ms"},
+						Bandwidth: &v1alpha1.BandwidthSpec{Rate: "1mbps", Limit: 100, Buffer: 10000},
+					},
+				},
+				{
+					ExternalTargets: []string{"10.0.0.0/8"},
+					TcParameter: v1alpha1.TcParameter{
+						Loss: &v1alpha1.LossSpec{Loss: "50"},
+					},
+				},
+			},
+		},
+	}
+	records := []*v1alpha1.Record{
+		{Id: "default/source", SelectorKey: "."},
+		{Id: "default/target", SelectorKey: v1alpha1.MatrixTargetSelectorKey(0)},
+	}
+
+	source := networkchaos.Namespace + "/" + networkchaos.Name
+	m := impl.builder.Build(source, types.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "source"})
+	err := impl.applyTcOrMatrix(context.TODO(), m, records, nil, networkchaos, targetIPSetPostFix, v1alpha1.PortFilter{}, "eth0", false)
+	g.Expect(err).To(BeNil())
+
+	chaos := &v1alpha1.PodNetworkChaos{}
+	g.Expect(m.T.Apply(chaos)).To(Succeed())
+
+	name := func(prefix string, index string) string {
+		return ipset.GenerateIPSetName(networkchaos, prefix+matrixIPSetPreFix+targetIPSetPostFix+index)
+	}
+	netSet0, netportSet0, setSet0 := name("net_", "0"), name("netport_", "0"), name("set_", "0")
+	netSet1, setSet1 := name("net_", "1"), name("set_", "1")
+
+	t.Run("ipsets of every entry", func(t *testing.T) {
+		g := NewWithT(t)
+
+		names := map[string]v1alpha1.RawIPSet{}
+		for _, set := range chaos.Spec.IPSets {
+			g.Expect(set.Source).To(Equal(source))
+			names[set.Name] = set
+		}
+		g.Expect(names).To(HaveLen(6))
+
+		g.Expect(names).To(HaveKey(netSet0))
+		g.Expect(names[netSet0].Cidrs).To(Equal([]string{"172.16.0.2/32"}))
+		g.Expect(names).To(HaveKey(setSet0))
+		g.Expect(names[setSet0].SetNames).To(Equal([]string{netSet0, netportSet0}))
+
+		g.Expect(names).To(HaveKey(netSet1))
+		g.Expect(names[netSet1].Cidrs).To(Equal([]string{"10.0.0.0/8"}))
+		g.Expect(names).To(HaveKey(setSet1))
+	})
+
+	t.Run("traffic controls of every entry", func(t *testing.T) {
+		g := NewWithT(t)
+
+		tcs := chaos.Spec.TrafficControls
+		g.Expect(tcs).To(HaveLen(3))
+
+		// the netem and bandwidth of an entry share the same filter
+		g.Expect(tcs[0].Type).To(Equal(v1alpha1.Netem))
+		g.Expect(tcs[0].Delay).To(Equal(&v1alpha1.DelaySpec{Latency: "10ms"}))
+		g.Expect(tcs[0].Bandwidth).To(BeNil())
+		g.Expect(tcs[0].IPSet).To(Equal(setSet0))
+		g.Expect(tcs[1].Type).To(Equal(v1alpha1.Bandwidth))
+		g.Expect(tcs[1].Bandwidth.Rate).To(Equal("1mbps"))
+		g.Expect(tcs[1].IPSet).To(Equal(setSet0))
+
+		g.Expect(tcs[2].Type).To(Equal(v1alpha1.Netem))
+		g.Expect(tcs[2].Loss).To(Equal(&v1alpha1.LossSpec{Loss: "50"}))
+		g.Expect(tcs[2].IPSet).To(Equal(setSet1))
+
+		for _, tc := range tcs {
+			g.Expect(tc.Source).To(Equal(source))
+			g.Expect(tc.Device).To(Equal("eth0"))
+		}
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolchaos
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"go.uber.org/fx"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	proxy *utils.RedirectedProxy
+}
+
+// Apply applies ProtocolChaos
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	protocolchaos := obj.(*v1alpha1.ProtocolChaos)
+
+	rules, err := json.Marshal([]tproxyconfig.ProtocolRule{ruleOf(&protocolchaos.Spec)})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	if protocolchaos.Status.Instances == nil {
+		protocolchaos.Status.Instances = make(map[string]string)
+	}
+	return impl.proxy.Apply(ctx, records[index], obj, protocolchaos.Status.Instances, func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, containerID string, source string) (string, error) {
+		resp, err := pbClient.ApplyProtocolChaos(ctx, &pb.ApplyProtocolChaosRequest{
+			Protocol:    string(protocolchaos.Spec.Protocol),
+			Rules:       string(rules),
+			Port:        uint32(protocolchaos.Spec.Port),
+			ContainerId: containerID,
+			EnterNS:     true,
+			Source:      source,
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.InstanceUid, nil
+	})
+}
+
+// Recover means the reconciler recovers the chaos action
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	protocolchaos := obj.(*v1alpha1.ProtocolChaos)
+
+	return impl.proxy.Recover(ctx, records[index], obj, protocolchaos.Status.Instances, func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, uid string, source string) error {
+		_, err := pbClient.RecoverProtocolChaos(ctx, &pb.RecoverProtocolChaosRequest{
+			InstanceUid: uid,
+			Source:      source,
+		})
+		return err
+	})
+}
+
+// ruleOf converts the spec of ProtocolChaos into the rule of protocol proxy
+func ruleOf(spec *v1alpha1.ProtocolChaosSpec) tproxyconfig.ProtocolRule {
+	rule := tproxyconfig.ProtocolRule{}
+	if len(spec.Command) > 0 {
+		command := spec.Command
+		rule.Selector.Command = &command
+	}
+	if len(spec.KeyPattern) > 0 {
+		keyPattern := spec.KeyPattern
+		rule.Selector.KeyPattern = &keyPattern
+	}
+	if len(spec.QueryPattern) > 0 {
+		queryPattern := spec.QueryPattern
+		rule.Selector.QueryPattern = &queryPattern
+	}
+
+	switch spec.Action {
+	case v1alpha1.ProtocolErrorAction:
+		rule.Actions.Error = &tproxyconfig.ProtocolError{
+			Code:    spec.Code,
+			Message: spec.Message,
+		}
+	case v1alpha1.ProtocolDelayAction:
+		delay := spec.Delay
+		rule.Actions.Delay = &delay
+	case v1alpha1.ProtocolAbortAction:
+		rule.Actions.Abort = true
+	}
+
+	return rule
+}
+
+func NewImpl(c client.Client, log logr.Logger, builder *chaosdaemon.ChaosDaemonClientBuilder) *impltypes.ChaosImplPair {
+	return &impltypes.ChaosImplPair{
+		Name:   "protocolchaos",
+		Object: &v1alpha1.ProtocolChaos{},
+		Impl: &Impl{
+			proxy: utils.NewRedirectedProxy(c, log.WithName("protocolchaos"), builder),
+		},
+		ObjectList: &v1alpha1.ProtocolChaosList{},
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+)
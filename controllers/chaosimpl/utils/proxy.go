@@ -0,0 +1,130 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package utils
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	k8sError "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+)
+
+// RedirectedProxy applies the rules of chaos into the proxy, which chaos daemon redirects the
+// connections to a port of the first container in pod into. The uid of proxy is kept in the
+// instances of chaos by the records.
+type RedirectedProxy struct {
+	client.Client
+	*chaosdaemon.ChaosDaemonClientBuilder
+	Log logr.Logger
+}
+
+func NewRedirectedProxy(c client.Client, log logr.Logger, builder *chaosdaemon.ChaosDaemonClientBuilder) *RedirectedProxy {
+	return &RedirectedProxy{
+		Client:                   c,
+		ChaosDaemonClientBuilder: builder,
+		Log:                      log,
+	}
+}
+
+// Apply calls apply with the chaos daemon client of the pod in record, the container id and the
+// namespaced name of chaos as the source of rules, and keeps the returned uid of proxy in instances.
+func (p *RedirectedProxy) Apply(ctx context.Context, record *v1alpha1.Record, obj v1alpha1.InnerObject, instances map[string]string,
+	apply func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, containerID string, source string) (string, error)) (v1alpha1.Phase, error) {
+	pod, err := p.getPod(ctx, record)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+	if len(pod.Status.ContainerStatuses) == 0 {
+		return v1alpha1.NotInjected, errors.Wrapf(ErrContainerNotFound, "pod %s/%s has empty container status", pod.Namespace, pod.Name)
+	}
+
+	pbClient, err := p.Build(ctx, pod, &types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+	defer pbClient.Close()
+
+	uid, err := apply(pbClient, pod.Status.ContainerStatuses[0].ContainerID, obj.GetNamespace()+"/"+obj.GetName())
+	if err != nil {
+		p.Log.Error(err, "fail to apply the rules in proxy", "pod", record.Id)
+		return v1alpha1.NotInjected, err
+	}
+	instances[record.Id] = uid
+
+	return v1alpha1.Injected, nil
+}
+
+// Recover calls remove with the chaos daemon client of the pod in record, the uid of proxy in
+// instances and the source of rules, and removes the uid from instances.
+func (p *RedirectedProxy) Recover(ctx context.Context, record *v1alpha1.Record, obj v1alpha1.InnerObject, instances map[string]string,
+	remove func(pbClient chaosdaemonclient.ChaosDaemonClientInterface, uid string, source string) error) (v1alpha1.Phase, error) {
+	uid, ok := instances[record.Id]
+	if !ok {
+		return v1alpha1.NotInjected, nil
+	}
+
+	pod, err := p.getPod(ctx, record)
+	if err != nil {
+		if k8sError.IsNotFound(errors.Cause(err)) {
+			// the proxy has gone with the pod
+			delete(instances, record.Id)
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	pbClient, err := p.Build(ctx, pod, &types.NamespacedName{
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	})
+	if err != nil {
+		return v1alpha1.Injected, err
+	}
+	defer pbClient.Close()
+
+	if err := remove(pbClient, uid, obj.GetNamespace()+"/"+obj.GetName()); err != nil {
+		p.Log.Error(err, "fail to recover the rules in proxy", "pod", record.Id)
+		return v1alpha1.Injected, err
+	}
+	delete(instances, record.Id)
+
+	return v1alpha1.NotInjected, nil
+}
+
+func (p *RedirectedProxy) getPod(ctx context.Context, record *v1alpha1.Record) (*v1.Pod, error) {
+	namespacedName, err := controller.ParseNamespacedName(record.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pod v1.Pod
+	if err := p.Client.Get(ctx, namespacedName, &pod); err != nil {
+		return nil, errors.Wrapf(err, "get pod %s", record.Id)
+	}
+	return &pod, nil
+}
@@ -142,6 +142,16 @@ func diffConditions(obj v1alpha1.InnerObject) (newConditionMap map[v1alpha1.Chao
 		Status: allRecovered,
 	}
 
+	if conflicted, ok := obj.(v1alpha1.ConflictedObject); ok {
+		status := corev1.ConditionFalse
+		if conflicted.IsConflicted() {
+			status = corev1.ConditionTrue
+		}
+		newConditionMap[v1alpha1.ConditionConflicted] = StatusAndReason{
+			Status: status,
+		}
+	}
+
 	if obj.IsPaused() {
 		newConditionMap[v1alpha1.ConditionPaused] = StatusAndReason{
 			Status: corev1.ConditionTrue,
@@ -73,5 +73,23 @@ var _ = Describe("Test condition controller", func() {
 
 			Expect(newConditionMap[v1alpha1.ConditionAllRecovered].Status).To(Equal(corev1.ConditionTrue))
 		})
+
+		It("Conflicted state should only be set on the objects which may conflict", func() {
+			newConditionMap := diffConditions(reconciler.Object.DeepCopyObject().(v1alpha1.InnerObject))
+			Expect(newConditionMap).NotTo(HaveKey(v1alpha1.ConditionConflicted))
+
+			obj := &v1alpha1.NetworkChaos{}
+			newConditionMap = diffConditions(obj)
+			Expect(newConditionMap[v1alpha1.ConditionConflicted].Status).To(Equal(corev1.ConditionFalse))
+
+			obj.Status.Conflicts = []v1alpha1.NetworkConflict{{
+				Pod:    "default/pod",
+				Source: "default/other",
+				Rules:  "netem on device eth0",
+				Policy: v1alpha1.MergeAdditiveConflictPolicy,
+			}}
+			newConditionMap = diffConditions(obj)
+			Expect(newConditionMap[v1alpha1.ConditionConflicted].Status).To(Equal(corev1.ConditionTrue))
+		})
 	})
 })
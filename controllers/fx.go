@@ -23,6 +23,8 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotechaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotecluster"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkflap"
+	"github.com/chaos-mesh/chaos-mesh/controllers/networkprofile"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podhttpchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podiochaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
@@ -43,6 +45,8 @@ var Module = fx.Options(
 	fx.Invoke(common.Bootstrap),
 	fx.Invoke(podhttpchaos.Bootstrap),
 	fx.Invoke(podnetworkchaos.Bootstrap),
+	fx.Invoke(networkprofile.Bootstrap),
+	fx.Invoke(networkflap.Bootstrap),
 	fx.Invoke(podiochaos.Bootstrap),
 	fx.Invoke(wfcontrollers.BootstrapWorkflowControllers),
 	fx.Invoke(statuscheck.Bootstrap),
@@ -0,0 +1,89 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkflap
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/flap"
+	"github.com/chaos-mesh/chaos-mesh/pkg/netem"
+)
+
+// Reconciler updates the current cycle of flapping in the status of NetworkChaos
+type Reconciler struct {
+	client.Client
+
+	Log logr.Logger
+}
+
+// Reconcile the network flap status
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	networkchaos := &v1alpha1.NetworkChaos{}
+	if err := r.Client.Get(ctx, req.NamespacedName, networkchaos); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to get networkchaos")
+		return ctrl.Result{}, err
+	}
+
+	if networkchaos.Spec.Flap == nil || networkchaos.Status.Experiment.DesiredPhase != v1alpha1.RunningPhase {
+		return ctrl.Result{}, nil
+	}
+
+	spec, err := netem.FromFlap(networkchaos.Spec.Flap, networkchaos.CreationTimestamp.Time)
+	if err != nil {
+		r.Log.Error(err, "unable to convert network flap")
+		return ctrl.Result{}, nil
+	}
+
+	cycle, down, next := flap.Phase(networkchaos.CreationTimestamp.Time, time.Duration(spec.UpDuration), time.Duration(spec.DownDuration), time.Duration(spec.Jitter), time.Now())
+	status := &v1alpha1.NetworkFlapStatus{
+		Cycle: cycle,
+		Down:  down,
+	}
+
+	if !reflect.DeepEqual(networkchaos.Status.Flap, status) {
+		err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			obj := &v1alpha1.NetworkChaos{}
+			if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+				return err
+			}
+
+			obj.Status.Flap = status
+			return r.Client.Update(ctx, obj)
+		})
+		if err != nil {
+			r.Log.Error(err, "unable to update network flap status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if next.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: time.Until(next)}, nil
+}
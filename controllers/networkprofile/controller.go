@@ -0,0 +1,119 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkprofile
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	chaosdaemonnetem "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/netem"
+	"github.com/chaos-mesh/chaos-mesh/pkg/netem"
+)
+
+// minStatusInterval limits how often the status of profile is updated,
+// the netem on the pods may be changed more frequently by chaos daemon
+const minStatusInterval = 10 * time.Second
+
+// Reconciler updates the current phase of network profile in the status of NetworkChaos
+type Reconciler struct {
+	client.Client
+
+	Log logr.Logger
+}
+
+// Reconcile the network profile status
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	networkchaos := &v1alpha1.NetworkChaos{}
+	if err := r.Client.Get(ctx, req.NamespacedName, networkchaos); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to get networkchaos")
+		return ctrl.Result{}, err
+	}
+
+	if networkchaos.Spec.Profile == nil || networkchaos.Status.Experiment.DesiredPhase != v1alpha1.RunningPhase {
+		return ctrl.Result{}, nil
+	}
+
+	spec, err := ResolveProfile(ctx, r.Client, networkchaos)
+	if err != nil {
+		r.Log.Error(err, "unable to resolve network profile")
+		return ctrl.Result{}, err
+	}
+
+	injectionTime, ok := InjectionTime(networkchaos.Status.Experiment.Records)
+	if !ok {
+		return ctrl.Result{}, nil
+	}
+
+	profile, err := netem.FromProfile(spec, injectionTime)
+	if err != nil {
+		r.Log.Error(err, "unable to convert network profile")
+		return ctrl.Result{}, nil
+	}
+
+	step, point, finished := chaosdaemonnetem.EvaluateProfile(profile, time.Since(injectionTime))
+	status := &v1alpha1.NetworkProfileStatus{
+		CurrentStep: step,
+		Finished:    finished,
+	}
+	if point.Latency > 0 {
+		status.Latency = time.Duration(point.Latency).String()
+	}
+	if point.Loss > 0 {
+		status.Loss = strconv.FormatFloat(float64(point.Loss), 'f', -1, 32)
+	}
+	if point.Rate > 0 {
+		status.Rate = fmt.Sprintf("%dbps", point.Rate)
+	}
+
+	if !reflect.DeepEqual(networkchaos.Status.Profile, status) {
+		err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			obj := &v1alpha1.NetworkChaos{}
+			if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+				return err
+			}
+
+			obj.Status.Profile = status
+			return r.Client.Update(ctx, obj)
+		})
+		if err != nil {
+			r.Log.Error(err, "unable to update network profile status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if finished {
+		return ctrl.Result{}, nil
+	}
+
+	interval := time.Duration(profile.Interval)
+	if interval < minStatusInterval {
+		interval = minStatusInterval
+	}
+	return ctrl.Result{RequeueAfter: interval}, nil
+}
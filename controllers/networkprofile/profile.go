@@ -0,0 +1,94 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkprofile
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/netem"
+)
+
+// ResolveProfile returns the network profile of the chaos, with the steps of
+// trace profile loaded from the ConfigMap. It returns nil if the chaos has no profile.
+func ResolveProfile(ctx context.Context, c client.Reader, networkchaos *v1alpha1.NetworkChaos) (*v1alpha1.NetworkProfileSpec, error) {
+	if networkchaos.Spec.Profile == nil {
+		return nil, nil
+	}
+
+	profile := networkchaos.Spec.Profile.DeepCopy()
+	if profile.Type != v1alpha1.TraceProfile {
+		return profile, nil
+	}
+
+	if profile.Trace == nil {
+		return nil, errors.New("trace is required by trace profile")
+	}
+
+	var cm v1.ConfigMap
+	err := c.Get(ctx, types.NamespacedName{
+		Namespace: networkchaos.Namespace,
+		Name:      profile.Trace.Name,
+	}, &cm)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get trace configmap %s", profile.Trace.Name)
+	}
+
+	content, ok := cm.Data[profile.Trace.Key]
+	if !ok {
+		return nil, errors.Errorf("key %s not found in configmap %s", profile.Trace.Key, profile.Trace.Name)
+	}
+
+	steps, err := netem.ParseTrace(content)
+	if err != nil {
+		return nil, err
+	}
+	profile.Steps = steps
+
+	return profile, nil
+}
+
+// InjectionTime returns the time when the records are injected in this run, which is
+// the earliest of their last successful applies. The profile restarts from the beginning
+// on every injection, e.g. after the chaos is resumed, rather than following the creation
+// of the chaos. It returns false if none of the records is injected.
+func InjectionTime(records []*v1alpha1.Record) (time.Time, bool) {
+	var injectionTime time.Time
+	found := false
+	for _, record := range records {
+		if record.Phase != v1alpha1.Injected {
+			continue
+		}
+		for i := len(record.Events) - 1; i >= 0; i-- {
+			event := record.Events[i]
+			if event.Type != v1alpha1.TypeSucceeded || event.Operation != v1alpha1.Apply || event.Timestamp == nil {
+				continue
+			}
+			if !found || event.Timestamp.Time.Before(injectionTime) {
+				injectionTime = event.Timestamp.Time
+				found = true
+			}
+			break
+		}
+	}
+	return injectionTime, found
+}
@@ -0,0 +1,75 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package networkprofile
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestInjectionTime(t *testing.T) {
+	g := NewWithT(t)
+
+	base := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	event := func(typ v1alpha1.RecordEventType, operation v1alpha1.RecordEventOperation, offset time.Duration) v1alpha1.RecordEvent {
+		return *v1alpha1.NewRecordEvent(typ, operation, "", metav1.NewTime(base.Add(offset)))
+	}
+
+	t.Run("not injected", func(t *testing.T) {
+		_, ok := InjectionTime([]*v1alpha1.Record{{
+			Phase:  v1alpha1.NotInjected,
+			Events: []v1alpha1.RecordEvent{event(v1alpha1.TypeSucceeded, v1alpha1.Apply, 0)},
+		}})
+		g.Expect(ok).To(BeFalse())
+	})
+
+	t.Run("resumed", func(t *testing.T) {
+		injectionTime, ok := InjectionTime([]*v1alpha1.Record{{
+			Phase: v1alpha1.Injected,
+			Events: []v1alpha1.RecordEvent{
+				event(v1alpha1.TypeSucceeded, v1alpha1.Apply, 0),
+				event(v1alpha1.TypeSucceeded, v1alpha1.Recover, time.Minute),
+				event(v1alpha1.TypeFailed, v1alpha1.Apply, 2*time.Minute),
+				event(v1alpha1.TypeSucceeded, v1alpha1.Apply, 3*time.Minute),
+			},
+		}})
+		g.Expect(ok).To(BeTrue())
+		g.Expect(injectionTime).To(Equal(base.Add(3 * time.Minute)))
+	})
+
+	t.Run("earliest of records", func(t *testing.T) {
+		injectionTime, ok := InjectionTime([]*v1alpha1.Record{
+			{
+				Phase:  v1alpha1.Injected,
+				Events: []v1alpha1.RecordEvent{event(v1alpha1.TypeSucceeded, v1alpha1.Apply, 2*time.Second)},
+			},
+			{
+				Phase:  v1alpha1.Injected,
+				Events: []v1alpha1.RecordEvent{event(v1alpha1.TypeSucceeded, v1alpha1.Apply, time.Second)},
+			},
+			{
+				Phase: v1alpha1.NotInjected,
+			},
+		})
+		g.Expect(ok).To(BeTrue())
+		g.Expect(injectionTime).To(Equal(base.Add(time.Second)))
+	})
+}
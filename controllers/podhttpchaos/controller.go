@@ -63,7 +63,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration && obj.Status.FailedMessage == "" {
 		r.Log.Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
-		return ctrl.Result{}, nil
+		if len(obj.Spec.Rules) == 0 || obj.Status.Pid == 0 {
+			return ctrl.Result{}, nil
+		}
+
+		if err := r.collectStats(ctx, obj); err != nil {
+			r.Log.Error(err, "fail to collect stats", "pod", obj.Namespace+"/"+obj.Name)
+		}
+		return ctrl.Result{RequeueAfter: statsInterval}, nil
 	}
 
 	r.Log.Info("updating http chaos", "pod", obj.Namespace+"/"+obj.Name, "spec", obj.Spec)
@@ -136,7 +143,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	proxyPortsMap := make(map[uint32]bool)
 
 	for _, rule := range obj.Spec.Rules {
-		proxyPortsMap[uint32(rule.Port)] = true
+		if rule.Egress == nil {
+			proxyPortsMap[uint32(rule.Port)] = true
+		}
 		rules = append(rules, rule.PodHttpChaosBaseRule)
 	}
 
@@ -161,7 +170,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				Namespace: tlsKeys.SecretNamespace,
 			},
 		}
-		if err := r.Client.Get(context.TODO(), req.NamespacedName, &secret); err != nil {
+		if err := r.Client.Get(context.TODO(), types.NamespacedName{
+			Namespace: tlsKeys.SecretNamespace,
+			Name:      tlsKeys.SecretName,
+		}, &secret); err != nil {
 			r.Log.Error(err, "unable to get secret")
 			return ctrl.Result{}, nil
 		}
@@ -219,10 +231,11 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	r.Log.Info("input with", "rules", string(inputRules))
 
 	res, err := pbClient.ApplyHttpChaos(ctx, &pb.ApplyHttpChaosRequest{
-		Rules:       string(inputRules),
-		Tls:         string(inputTLS),
-		ProxyPorts:  proxyPorts,
-		ContainerId: containerID,
+		Rules:         string(inputRules),
+		Tls:           string(inputTLS),
+		ProxyPorts:    proxyPorts,
+		EgressTargets: egressTargets(obj.Spec.Rules),
+		ContainerId:   containerID,
 
 		Instance:  obj.Status.Pid,
 		StartTime: obj.Status.StartTime,
@@ -245,5 +258,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	pid = res.Instance
 	startTime = res.StartTime
 
+	if len(rules) > 0 {
+		// collect the stats of rules periodically
+		return ctrl.Result{RequeueAfter: statsInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
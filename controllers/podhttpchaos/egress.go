@@ -0,0 +1,88 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podhttpchaos
+
+import (
+	"net"
+	"sort"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// egressTargets merges the destinations of egress rules by port. All the
+// destinations of a port are redirected to the http proxy if any rule on this
+// port doesn't limit the targets or has a domain name in targets, as the domain
+// names are matched with the host of requests by the proxy.
+func egressTargets(rules []v1alpha1.PodHttpChaosRule) []*pb.HttpEgressTarget {
+	cidrsByPort := make(map[uint32]map[string]bool)
+	for _, rule := range rules {
+		if rule.Egress == nil {
+			continue
+		}
+
+		port := uint32(rule.Port)
+		cidrs, ok := cidrsByPort[port]
+		if !ok {
+			cidrs = make(map[string]bool)
+			cidrsByPort[port] = cidrs
+		} else if cidrs == nil {
+			continue
+		}
+
+		if len(rule.Egress.Targets) == 0 {
+			cidrsByPort[port] = nil
+			continue
+		}
+		for _, target := range rule.Egress.Targets {
+			cidr, ok := targetCidr(target)
+			if !ok {
+				cidrsByPort[port] = nil
+				break
+			}
+			cidrs[cidr] = true
+		}
+	}
+
+	targets := make([]*pb.HttpEgressTarget, 0, len(cidrsByPort))
+	for port, cidrs := range cidrsByPort {
+		target := &pb.HttpEgressTarget{Port: port}
+		for cidr := range cidrs {
+			target.Cidrs = append(target.Cidrs, cidr)
+		}
+		sort.Strings(target.Cidrs)
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].Port < targets[j].Port
+	})
+
+	return targets
+}
+
+// targetCidr returns the cidr of an IP or CIDR target, or false if it's a domain name
+func targetCidr(target string) (string, bool) {
+	if ip := net.ParseIP(target); ip != nil {
+		if ip.To4() != nil {
+			return ip.String() + "/32", true
+		}
+		return ip.String() + "/128", true
+	}
+	if _, ipNet, err := net.ParseCIDR(target); err == nil {
+		return ipNet.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,59 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podhttpchaos
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestEgressTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	egressRule := func(port int32, targets ...string) v1alpha1.PodHttpChaosRule {
+		return v1alpha1.PodHttpChaosRule{
+			Port: port,
+			PodHttpChaosBaseRule: v1alpha1.PodHttpChaosBaseRule{
+				Egress: &v1alpha1.PodHttpChaosEgress{Targets: targets},
+			},
+		}
+	}
+
+	targets := egressTargets([]v1alpha1.PodHttpChaosRule{
+		{Port: 80},
+		egressRule(443, "10.0.0.2", "10.0.0.1/32"),
+		egressRule(443, "10.0.1.1/24"),
+		egressRule(8080, "10.0.0.3/32"),
+		egressRule(8080),
+		egressRule(8080, "10.0.0.4/32"),
+		egressRule(9090, "10.0.0.5"),
+		egressRule(9090, "api.example.com"),
+	})
+
+	g.Expect(targets).To(HaveLen(3))
+	g.Expect(targets[0].Port).To(Equal(uint32(443)))
+	g.Expect(targets[0].Cidrs).To(Equal([]string{"10.0.0.1/32", "10.0.0.2/32", "10.0.1.0/24"}))
+	g.Expect(targets[1].Port).To(Equal(uint32(8080)))
+	g.Expect(targets[1].Cidrs).To(BeEmpty())
+	// the domain names are matched by the proxy, so all the destinations are redirected
+	g.Expect(targets[2].Port).To(Equal(uint32(9090)))
+	g.Expect(targets[2].Cidrs).To(BeEmpty())
+
+	g.Expect(egressTargets([]v1alpha1.PodHttpChaosRule{{Port: 80}})).To(BeEmpty())
+}
@@ -0,0 +1,206 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podhttpchaos
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	// statsInterval is the interval of collecting the stats of rules from the http proxy
+	statsInterval = 30 * time.Second
+
+	// maxObservations is the maximum number of observations reported on every pod
+	maxObservations = 10
+)
+
+// collectStats reads the counters of rules from the http proxy, and reports them to the
+// status of HTTPChaos which the rules come from
+func (r *Reconciler) collectStats(ctx context.Context, obj *v1alpha1.PodHttpChaos) error {
+	pod := &v1.Pod{}
+	if err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}, pod); err != nil {
+		return errors.Wrap(err, "get pod")
+	}
+
+	pbClient, err := r.ChaosDaemonClientBuilder.Build(ctx, pod, &types.NamespacedName{
+		Namespace: obj.Namespace,
+		Name:      obj.Name,
+	})
+	if err != nil {
+		return err
+	}
+	defer pbClient.Close()
+
+	var containerID string
+	if len(pod.Status.ContainerStatuses) > 0 {
+		containerID = pod.Status.ContainerStatuses[0].ContainerID
+	}
+
+	res, err := pbClient.GetHttpChaosStats(ctx, &pb.HttpChaosStatsRequest{
+		Instance:    obj.Status.Pid,
+		StartTime:   obj.Status.StartTime,
+		ContainerId: containerID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "get stats from http proxy")
+	}
+
+	sources, stats, err := aggregateStats(obj.Spec.Rules, res.Rules)
+	if err != nil {
+		return err
+	}
+
+	return r.updateStats(ctx, obj.Namespace+"/"+obj.Name, sources, stats)
+}
+
+// updateStats reports the stats of the pod to the HTTPChaos of every source, the
+// sources which have been deleted are skipped
+func (r *Reconciler) updateStats(ctx context.Context, podKey string, sources []string, stats map[string]v1alpha1.HTTPChaosStats) error {
+	for _, source := range sources {
+		namespacedName, err := controller.ParseNamespacedName(source)
+		if err != nil {
+			return err
+		}
+
+		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			chaos := &v1alpha1.HTTPChaos{}
+			if err := r.Client.Get(ctx, namespacedName, chaos); err != nil {
+				return err
+			}
+
+			if current, ok := chaos.Status.Stats[podKey]; ok && equality.Semantic.DeepEqual(current, stats[source]) {
+				return nil
+			}
+			if chaos.Status.Stats == nil {
+				chaos.Status.Stats = make(map[string]v1alpha1.HTTPChaosStats)
+			}
+			chaos.Status.Stats[podKey] = stats[source]
+
+			// the stats are a part of status, while HTTPChaos has no status subresource
+			return r.Client.Update(ctx, chaos)
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "update stats of httpchaos %s", source)
+		}
+	}
+
+	return nil
+}
+
+// aggregateStats sums up the counters of rules by their sources, the sources
+// are returned in the order of their first rules.
+func aggregateStats(rules []v1alpha1.PodHttpChaosRule, ruleStats []*pb.HttpChaosRuleStats) ([]string, map[string]v1alpha1.HTTPChaosStats, error) {
+	if len(rules) != len(ruleStats) {
+		return nil, nil, errors.Errorf("http proxy reports the stats of %d rules, but %d rules are applied", len(ruleStats), len(rules))
+	}
+
+	var sources []string
+	stats := make(map[string]v1alpha1.HTTPChaosStats)
+	observations := make(map[string][]tproxyconfig.Observation)
+	for i, rule := range rules {
+		item, ok := stats[rule.Source]
+		if !ok {
+			sources = append(sources, rule.Source)
+		}
+		item.Matched += ruleStats[i].GetMatched()
+		item.Faulted += ruleStats[i].GetFaulted()
+		stats[rule.Source] = item
+
+		for _, observation := range ruleStats[i].GetObservations() {
+			observations[rule.Source] = append(observations[rule.Source], tproxyconfig.Observation{
+				Path:           observation.GetPath(),
+				Method:         observation.GetMethod(),
+				Code:           observation.GetCode(),
+				Count:          observation.GetCount(),
+				LatencySum:     observation.GetLatencySum(),
+				LatencyBuckets: observation.GetLatencyBuckets(),
+			})
+		}
+	}
+
+	for source, items := range observations {
+		item := stats[source]
+		item.Observations = summarizeObservations(items)
+		stats[source] = item
+	}
+
+	return sources, stats, nil
+}
+
+// summarizeObservations merges the observations by path, method and status code,
+// and keeps the most frequent ones with the percentiles of their latency.
+func summarizeObservations(observations []tproxyconfig.Observation) []v1alpha1.HTTPChaosObservation {
+	merged := tproxyconfig.MergeObservations(observations)
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Count > merged[j].Count
+	})
+	if len(merged) > maxObservations {
+		merged = merged[:maxObservations]
+	}
+
+	summary := make([]v1alpha1.HTTPChaosObservation, 0, len(merged))
+	for _, observation := range merged {
+		summary = append(summary, v1alpha1.HTTPChaosObservation{
+			Path:       observation.Path,
+			Method:     observation.Method,
+			Code:       observation.Code,
+			Count:      observation.Count,
+			P50Latency: latencyPercentile(observation, 0.5),
+			P99Latency: latencyPercentile(observation, 0.99),
+		})
+	}
+	return summary
+}
+
+// latencyPercentile returns the upper bound of the bucket where the percentile
+// of latency falls in, or an empty string if there are no requests in buckets.
+func latencyPercentile(observation tproxyconfig.Observation, q float64) string {
+	if observation.Count <= 0 || len(observation.LatencyBuckets) == 0 {
+		return ""
+	}
+
+	rank := uint64(math.Ceil(q * float64(observation.Count)))
+	for i, count := range observation.LatencyBuckets {
+		if count >= rank {
+			return boundDuration(tproxyconfig.ObservationLatencyBuckets[i]).String()
+		}
+	}
+
+	buckets := tproxyconfig.ObservationLatencyBuckets
+	return ">" + boundDuration(buckets[len(buckets)-1]).String()
+}
+
+func boundDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
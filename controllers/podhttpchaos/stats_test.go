@@ -0,0 +1,114 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podhttpchaos
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/cmd/chaos-controller-manager/provider"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestAggregateStats(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := []v1alpha1.PodHttpChaosRule{
+		{Source: "default/foo", Port: 80},
+		{Source: "default/bar", Port: 80},
+		{Source: "default/foo", Port: 8080},
+	}
+
+	sources, stats, err := aggregateStats(rules, []*pb.HttpChaosRuleStats{
+		{Matched: 10, Faulted: 1},
+		{Matched: 5, Faulted: 5},
+		{Matched: 20, Faulted: 2},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(sources).Should(Equal([]string{"default/foo", "default/bar"}))
+	g.Expect(stats).Should(Equal(map[string]v1alpha1.HTTPChaosStats{
+		"default/foo": {Matched: 30, Faulted: 3},
+		"default/bar": {Matched: 5, Faulted: 5},
+	}))
+
+	_, _, err = aggregateStats(rules, []*pb.HttpChaosRuleStats{{Matched: 1}})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestAggregateObservations(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := []v1alpha1.PodHttpChaosRule{
+		{Source: "default/foo", Port: 80},
+		{Source: "default/foo", Port: 8080},
+	}
+
+	buckets := func(counts ...uint64) []uint64 {
+		for len(counts) < 11 {
+			counts = append(counts, counts[len(counts)-1])
+		}
+		return counts
+	}
+
+	_, stats, err := aggregateStats(rules, []*pb.HttpChaosRuleStats{
+		{Matched: 100, Observations: []*pb.HttpObservation{
+			{Path: "/api", Method: "GET", Code: 200, Count: 60, LatencyBuckets: buckets(0, 30, 60)},
+			{Path: "/api", Method: "POST", Code: 500, Count: 10, LatencyBuckets: buckets(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 9)},
+		}},
+		{Matched: 40, Observations: []*pb.HttpObservation{
+			{Path: "/api", Method: "GET", Code: 200, Count: 40, LatencyBuckets: buckets(0, 0, 40)},
+		}},
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(stats["default/foo"]).Should(Equal(v1alpha1.HTTPChaosStats{
+		Matched: 140,
+		Observations: []v1alpha1.HTTPChaosObservation{
+			{Path: "/api", Method: "GET", Code: 200, Count: 100, P50Latency: "25ms", P99Latency: "25ms"},
+			{Path: "/api", Method: "POST", Code: 500, Count: 10, P50Latency: "10s", P99Latency: ">10s"},
+		},
+	}))
+}
+
+func TestUpdateStats(t *testing.T) {
+	g := NewWithT(t)
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(provider.NewScheme()).
+		WithObjects(&v1alpha1.HTTPChaos{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		}).
+		Build()
+	r := &Reconciler{Client: fakeClient}
+
+	stats := map[string]v1alpha1.HTTPChaosStats{
+		"default/foo": {Matched: 30, Faulted: 3},
+		"default/bar": {Matched: 5, Faulted: 5},
+	}
+	// the deleted httpchaos default/bar is skipped
+	g.Expect(r.updateStats(context.Background(), "default/pod", []string{"default/foo", "default/bar"}, stats)).Should(Succeed())
+
+	chaos := &v1alpha1.HTTPChaos{}
+	g.Expect(fakeClient.Get(context.Background(), types.NamespacedName{Name: "foo", Namespace: "default"}, chaos)).Should(Succeed())
+	g.Expect(chaos.Status.Stats).Should(Equal(map[string]v1alpha1.HTTPChaosStats{
+		"default/pod": {Matched: 30, Faulted: 3},
+	}))
+}
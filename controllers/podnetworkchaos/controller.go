@@ -17,11 +17,14 @@ package podnetworkchaos
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -51,6 +54,9 @@ type Reconciler struct {
 	Log                      logr.Logger
 	AllowHostNetworkTesting  bool
 	ChaosDaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder
+
+	// NetworkRulesCheckInterval is the interval of reading back the network rules from chaos daemon
+	NetworkRulesCheckInterval time.Duration
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -68,7 +74,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration && obj.Status.FailedMessage == "" {
 		r.Log.Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
-		return ctrl.Result{}, nil
+		if !r.shouldCheckNetworkRules(obj) {
+			return ctrl.Result{}, nil
+		}
+
+		tracked, err := r.CheckNetworkRules(ctx, obj)
+		if err != nil {
+			r.Log.Error(err, "fail to check network rules", "pod", obj.Namespace+"/"+obj.Name)
+			return ctrl.Result{RequeueAfter: r.NetworkRulesCheckInterval}, nil
+		}
+		if tracked {
+			return ctrl.Result{RequeueAfter: r.NetworkRulesCheckInterval}, nil
+		}
+
+		// chaos daemon may have restarted and lost the applied rules, so they are applied again
+		r.Log.Info("network rules are not tracked by chaos daemon, apply them again", "pod", obj.Namespace+"/"+obj.Name)
 	}
 
 	r.Log.Info("updating podnetworkchaos", "pod", obj.Namespace+"/"+obj.Name, "spec", obj.Spec)
@@ -175,6 +195,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if r.shouldCheckNetworkRules(obj) {
+		return ctrl.Result{RequeueAfter: r.NetworkRulesCheckInterval}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -195,6 +218,7 @@ func (r *Reconciler) SetIPSets(ctx context.Context, pod *corev1.Pod, chaos *v1al
 			Cidrs:        ipset.Cidrs,
 			CidrAndPorts: cidrAndPorts,
 			SetNames:     ipset.SetNames,
+			Family:       string(ipset.IPFamily),
 		})
 	}
 	return ipset.FlushIPSets(ctx, chaosdaemonClient, pod, ipsets)
@@ -204,6 +228,9 @@ func (r *Reconciler) SetIPSets(ctx context.Context, pod *corev1.Pod, chaos *v1al
 func (r *Reconciler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.PodNetworkChaos, chaosdaemonClient chaosdaemonclient.ChaosDaemonClientInterface) error {
 	chains := []*pb.Chain{}
 	for _, chain := range chaos.Spec.Iptables {
+		if chain.DisplacedBy != "" {
+			continue
+		}
 		var direction pb.Chain_Direction
 		if chain.Direction == v1alpha1.Input {
 			direction = pb.Chain_INPUT
@@ -214,12 +241,35 @@ func (r *Reconciler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1
 			r.Log.Error(err, "unknown direction")
 			return err
 		}
+		target := "DROP"
+		if len(chain.RejectWith) > 0 {
+			target = "REJECT"
+		}
+		var probability float32
+		if len(chain.Probability) > 0 {
+			percentage, err := strconv.ParseFloat(chain.Probability, 32)
+			if err != nil {
+				return err
+			}
+			probability = float32(percentage / 100)
+		}
+		flap, err := fromFlap(chain.Flap, chain.FlapStartTime)
+		if err != nil {
+			return err
+		}
 		chains = append(chains, &pb.Chain{
 			Name:      chain.Name,
 			Ipsets:    chain.IPSets,
 			Direction: direction,
-			Target:    "DROP",
+			Target:    target,
 			Device:    chain.Device,
+
+			Protocol:         chain.Protocol,
+			SourcePorts:      chain.SourcePorts,
+			DestinationPorts: chain.DestinationPorts,
+			RejectWith:       string(chain.RejectWith),
+			Probability:      probability,
+			Flap:             flap,
 		})
 	}
 	return iptable.SetIptablesChains(ctx, chaosdaemonClient, pod, chains)
@@ -229,6 +279,13 @@ func (r *Reconciler) SetIptables(ctx context.Context, pod *corev1.Pod, chaos *v1
 func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha1.PodNetworkChaos, chaosdaemonClient chaosdaemonclient.ChaosDaemonClientInterface) error {
 	tcs := []*pb.Tc{}
 	for _, tc := range chaos.Spec.TrafficControls {
+		if tc.DisplacedBy != "" {
+			continue
+		}
+		flap, err := fromFlap(tc.Flap, tc.FlapStartTime)
+		if err != nil {
+			return err
+		}
 		if tc.Type == v1alpha1.Bandwidth {
 			tbf, err := netem.FromBandwidth(tc.Bandwidth)
 			if err != nil {
@@ -239,8 +296,22 @@ func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 				Tbf:    tbf,
 				Ipset:  tc.IPSet,
 				Device: tc.Device,
+
+				Protocol:   tc.Protocol,
+				SourcePort: tc.SourcePorts,
+				EgressPort: tc.DestinationPorts,
+				Ingress:    tc.Ingress,
+				Flap:       flap,
 			})
 		} else if tc.Type == v1alpha1.Netem {
+			var profile *pb.NetemProfile
+			if tc.Profile != nil && tc.ProfileStartTime != nil {
+				var err error
+				profile, err = netem.FromProfile(tc.Profile, tc.ProfileStartTime.Time)
+				if err != nil {
+					return err
+				}
+			}
 			netem, err := mergeNetem(tc.TcParameter)
 			if err != nil {
 				return err
@@ -250,6 +321,13 @@ func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 				Netem:  netem,
 				Ipset:  tc.IPSet,
 				Device: tc.Device,
+
+				Protocol:   tc.Protocol,
+				SourcePort: tc.SourcePorts,
+				EgressPort: tc.DestinationPorts,
+				Ingress:    tc.Ingress,
+				Profile:    profile,
+				Flap:       flap,
 			})
 		} else {
 			return errors.New("unknown tc type")
@@ -260,6 +338,15 @@ func (r *Reconciler) SetTcs(ctx context.Context, pod *corev1.Pod, chaos *v1alpha
 	return tcpkg.SetTcs(ctx, chaosdaemonClient, pod, tcs)
 }
 
+// fromFlap converts the flap of a rule, it returns nil if the rule doesn't flap
+func fromFlap(flap *v1alpha1.NetworkFlapSpec, startTime *metav1.Time) (*pb.Flap, error) {
+	if flap == nil || startTime == nil {
+		return nil, nil
+	}
+
+	return netem.FromFlap(flap, startTime.Time)
+}
+
 // NetemSpec defines the interface to convert to a Netem protobuf
 type NetemSpec interface {
 	ToNetem() (*pb.Netem, error)
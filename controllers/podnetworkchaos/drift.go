@@ -0,0 +1,111 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podnetworkchaos
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// shouldCheckNetworkRules returns whether the network rules of the pod should be read back periodically
+func (r *Reconciler) shouldCheckNetworkRules(chaos *v1alpha1.PodNetworkChaos) bool {
+	if r.NetworkRulesCheckInterval <= 0 {
+		return false
+	}
+
+	return len(chaos.Spec.IPSets) > 0 || len(chaos.Spec.Iptables) > 0 || len(chaos.Spec.TrafficControls) > 0
+}
+
+// CheckNetworkRules reads back the network rules on the pod from chaos daemon, and records the drifts
+// healed by chaos daemon as events. It returns false if the rules are not tracked by chaos daemon.
+func (r *Reconciler) CheckNetworkRules(ctx context.Context, chaos *v1alpha1.PodNetworkChaos) (bool, error) {
+	pod := &corev1.Pod{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      chaos.Name,
+		Namespace: chaos.Namespace,
+	}, pod)
+	if err != nil {
+		return false, err
+	}
+
+	pbClient, err := r.ChaosDaemonClientBuilder.Build(ctx, pod, &types.NamespacedName{
+		Name:      chaos.Name,
+		Namespace: chaos.Namespace,
+	})
+	if err != nil {
+		return false, err
+	}
+	defer pbClient.Close()
+
+	// the rules are applied through one of the containers, which share the same network namespace
+	var resp *pb.NetworkRulesResponse
+	for _, containerStatus := range pod.Status.ContainerStatuses {
+		resp, err = pbClient.GetNetworkRules(ctx, &pb.NetworkRulesRequest{
+			ContainerId: containerStatus.ContainerID,
+			EnterNS:     true,
+		})
+		if err != nil {
+			return false, errors.Wrapf(err, "get network rules of container %s", containerStatus.Name)
+		}
+		if resp.Tracked {
+			break
+		}
+	}
+	if resp == nil || !resp.Tracked {
+		return false, nil
+	}
+
+	if len(resp.Missing) > 0 {
+		r.Log.Info("network rules are missing on the pod", "pod", chaos.Namespace+"/"+chaos.Name, "missing", resp.Missing)
+	}
+
+	if resp.DriftCount == chaos.Status.DriftCount {
+		return true, nil
+	}
+
+	// the count is reset when chaos daemon restarts, so only the increments are reported
+	if resp.DriftCount > chaos.Status.DriftCount {
+		r.Recorder.Event(chaos, recorder.Drifted{
+			Count: int(resp.DriftCount - chaos.Status.DriftCount),
+		})
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		obj := &v1alpha1.PodNetworkChaos{}
+		if err := r.Client.Get(ctx, types.NamespacedName{
+			Name:      chaos.Name,
+			Namespace: chaos.Namespace,
+		}, obj); err != nil {
+			return err
+		}
+
+		obj.Status.DriftCount = resp.DriftCount
+		return r.Client.Status().Update(ctx, obj)
+	})
+	if err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
@@ -53,7 +53,8 @@ func Bootstrap(mgr ctrl.Manager, client client.Client, logger logr.Logger, b *ch
 			Recorder: recorderBuilder.Build("podnetworkchaos"),
 
 			// TODO:
-			AllowHostNetworkTesting:  config.ControllerCfg.AllowHostNetworkTesting,
-			ChaosDaemonClientBuilder: b,
+			AllowHostNetworkTesting:   config.ControllerCfg.AllowHostNetworkTesting,
+			ChaosDaemonClientBuilder:  b,
+			NetworkRulesCheckInterval: config.ControllerCfg.NetworkRulesCheckInterval,
 		})
 }
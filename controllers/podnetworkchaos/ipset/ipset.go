@@ -33,45 +33,69 @@ import (
 var log = ctrl.Log.WithName("ipset")
 
 // BuildIPSets builds IP sets with provided pod ip list.
+// IPv6 addresses are stored in separate sets with inet6 family, which will only
+// be built if there is any IPv6 address.
 func BuildIPSets(pods []v1.Pod, externalCidrs []v1alpha1.CidrAndPort, networkchaos *v1alpha1.NetworkChaos, namePostFix string, source string) []v1alpha1.RawIPSet {
-	netName := GenerateIPSetName(networkchaos, "net_"+namePostFix)
-	netPortName := GenerateIPSetName(networkchaos, "netport_"+namePostFix)
-
-	cidrs := []string{}
-	cidrAndPorts := []v1alpha1.CidrAndPort{}
+	cidrs := map[v1alpha1.IPFamily][]string{}
+	cidrAndPorts := map[v1alpha1.IPFamily][]v1alpha1.CidrAndPort{}
 
 	for _, cidr := range externalCidrs {
+		family := netutils.CidrFamily(cidr.Cidr)
 		if cidr.Port == 0 {
-			cidrs = append(cidrs, cidr.Cidr)
+			cidrs[family] = append(cidrs[family], cidr.Cidr)
 		} else {
-			cidrAndPorts = append(cidrAndPorts, cidr)
+			cidrAndPorts[family] = append(cidrAndPorts[family], cidr)
 		}
 	}
 
-	for _, pod := range pods {
-		if len(pod.Status.PodIP) > 0 {
-			cidrs = append(cidrs, netutils.IPToCidr(pod.Status.PodIP))
+	for i := range pods {
+		for _, ip := range netutils.PodIPs(&pods[i]) {
+			cidr := netutils.IPToCidr(ip)
+			family := netutils.CidrFamily(cidr)
+			cidrs[family] = append(cidrs[family], cidr)
 		}
 	}
 
-	return []v1alpha1.RawIPSet{
+	sets := []v1alpha1.RawIPSet{
 		{
-			Name:      netName,
+			Name:      GenerateIPSetName(networkchaos, "net_"+namePostFix),
 			IPSetType: v1alpha1.NetIPSet,
-			Cidrs:     cidrs,
+			Cidrs:     cidrs[v1alpha1.IPv4Family],
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: source,
 			},
 		},
 		{
-			Name:         netPortName,
+			Name:         GenerateIPSetName(networkchaos, "netport_"+namePostFix),
 			IPSetType:    v1alpha1.NetPortIPSet,
-			CidrAndPorts: cidrAndPorts,
+			CidrAndPorts: cidrAndPorts[v1alpha1.IPv4Family],
 			RawRuleSource: v1alpha1.RawRuleSource{
 				Source: source,
 			},
 		},
 	}
+
+	if len(cidrs[v1alpha1.IPv6Family])+len(cidrAndPorts[v1alpha1.IPv6Family]) > 0 {
+		sets = append(sets, v1alpha1.RawIPSet{
+			Name:      GenerateIPSetName(networkchaos, "net6_"+namePostFix),
+			IPSetType: v1alpha1.NetIPSet,
+			IPFamily:  v1alpha1.IPv6Family,
+			Cidrs:     cidrs[v1alpha1.IPv6Family],
+			RawRuleSource: v1alpha1.RawRuleSource{
+				Source: source,
+			},
+		}, v1alpha1.RawIPSet{
+			Name:         GenerateIPSetName(networkchaos, "netport6_"+namePostFix),
+			IPSetType:    v1alpha1.NetPortIPSet,
+			IPFamily:     v1alpha1.IPv6Family,
+			CidrAndPorts: cidrAndPorts[v1alpha1.IPv6Family],
+			RawRuleSource: v1alpha1.RawRuleSource{
+				Source: source,
+			},
+		})
+	}
+
+	return sets
 }
 
 // BuildSetIPSet builds list:set IP set that stores given sets
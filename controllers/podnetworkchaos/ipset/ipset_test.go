@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -54,3 +55,39 @@ func Test_generateIPSetName(t *testing.T) {
 		g.Expect(len(name)).Should(Equal(27))
 	})
 }
+
+func Test_buildIPSets(t *testing.T) {
+	g := NewWithT(t)
+
+	networkChaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test",
+		},
+	}
+
+	t.Run("ipv4 only", func(t *testing.T) {
+		pods := []v1.Pod{{Status: v1.PodStatus{PodIP: "10.0.0.1"}}}
+		sets := BuildIPSets(pods, []v1alpha1.CidrAndPort{{Cidr: "1.1.1.1/32", Port: 80}}, networkChaos, "tgt", "default/test")
+
+		g.Expect(sets).Should(HaveLen(2))
+		g.Expect(sets[0].Cidrs).Should(Equal([]string{"10.0.0.1/32"}))
+		g.Expect(sets[0].IPFamily).Should(BeEmpty())
+		g.Expect(sets[1].CidrAndPorts).Should(Equal([]v1alpha1.CidrAndPort{{Cidr: "1.1.1.1/32", Port: 80}}))
+	})
+
+	t.Run("dual stack", func(t *testing.T) {
+		pods := []v1.Pod{{Status: v1.PodStatus{
+			PodIP:  "10.0.0.1",
+			PodIPs: []v1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+		}}}
+		sets := BuildIPSets(pods, []v1alpha1.CidrAndPort{{Cidr: "2001:db8::/64", Port: 443}}, networkChaos, "tgt", "default/test")
+
+		g.Expect(sets).Should(HaveLen(4))
+		g.Expect(sets[0].Cidrs).Should(Equal([]string{"10.0.0.1/32"}))
+		g.Expect(sets[2].Name).Should(Equal("test_net6_tgt"))
+		g.Expect(sets[2].IPFamily).Should(Equal(v1alpha1.IPv6Family))
+		g.Expect(sets[2].Cidrs).Should(Equal([]string{"fd00::1/128"}))
+		g.Expect(sets[3].IPFamily).Should(Equal(v1alpha1.IPv6Family))
+		g.Expect(sets[3].CidrAndPorts).Should(Equal([]v1alpha1.CidrAndPort{{Cidr: "2001:db8::/64", Port: 443}}))
+	})
+}
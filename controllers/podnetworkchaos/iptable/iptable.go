@@ -51,6 +51,7 @@ func SetIptablesChains(ctx context.Context, pbClient chaosdaemonclient.ChaosDaem
 			Chains:      chains,
 			ContainerId: containerID,
 			EnterNS:     true,
+			EnableIpv6:  netutils.HasIPv6(pod),
 		})
 
 		if err != nil {
@@ -18,9 +18,9 @@ package netutils
 import (
 	"net"
 	"strconv"
-	"strings"
 
 	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
@@ -28,10 +28,61 @@ import (
 
 // IPToCidr converts from an ip to a full mask cidr
 func IPToCidr(ip string) string {
-	// TODO: support IPv6
+	if IsIPv6(ip) {
+		return ip + "/128"
+	}
 	return ip + "/32"
 }
 
+// IsIPv6 returns whether the ip or cidr is an IPv6 one
+func IsIPv6(ipOrCidr string) bool {
+	ip := net.ParseIP(ipOrCidr)
+	if ip == nil {
+		var err error
+		ip, _, err = net.ParseCIDR(ipOrCidr)
+		if err != nil {
+			return false
+		}
+	}
+
+	return ip.To4() == nil
+}
+
+// CidrFamily returns the ipset family of the cidr
+func CidrFamily(cidr string) v1alpha1.IPFamily {
+	if IsIPv6(cidr) {
+		return v1alpha1.IPv6Family
+	}
+	return v1alpha1.IPv4Family
+}
+
+// PodIPs returns all ips of the pod, including the IPv6 ones on a dual-stack pod
+func PodIPs(pod *v1.Pod) []string {
+	ips := []string{}
+	for _, podIP := range pod.Status.PodIPs {
+		if len(podIP.IP) > 0 {
+			ips = append(ips, podIP.IP)
+		}
+	}
+
+	if len(ips) == 0 && len(pod.Status.PodIP) > 0 {
+		ips = append(ips, pod.Status.PodIP)
+	}
+
+	return ips
+}
+
+// HasIPv6 returns whether the pod has been assigned with an IPv6 address
+func HasIPv6(pod *v1.Pod) bool {
+	for _, ip := range PodIPs(pod) {
+		if IsIPv6(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // ResolveCidrs converts multiple cidrs/ips/domains into cidr
 func ResolveCidrs(names []string) ([]v1alpha1.CidrAndPort, error) {
 	cidrs := []v1alpha1.CidrAndPort{}
@@ -80,12 +131,7 @@ func ResolveCidr(name string) ([]v1alpha1.CidrAndPort, error) {
 
 	cidrs := []v1alpha1.CidrAndPort{}
 	for _, addr := range addrs {
-		addr := addr.String()
-
-		// TODO: support IPv6
-		if strings.Contains(addr, ".") {
-			cidrs = append(cidrs, v1alpha1.CidrAndPort{Cidr: IPToCidr(addr), Port: port})
-		}
+		cidrs = append(cidrs, v1alpha1.CidrAndPort{Cidr: IPToCidr(addr.String()), Port: port})
 	}
 	return cidrs, nil
 }
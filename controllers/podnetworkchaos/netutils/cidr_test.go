@@ -20,6 +20,8 @@ import (
 	"reflect"
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
+
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
@@ -66,6 +68,21 @@ func TestResolveCidr(t *testing.T) {
 			args: args{name: "example.com:80"},
 			want: []v1alpha1.CidrAndPort{{Cidr: "1.1.1.1/32", Port: 80}, {Cidr: "2.2.2.2/32", Port: 80}},
 		},
+		{
+			name: "ipv6 address",
+			args: args{name: "2001:db8::1"},
+			want: []v1alpha1.CidrAndPort{{Cidr: "2001:db8::1/128"}},
+		},
+		{
+			name: "ipv6 address and port",
+			args: args{name: "[2001:db8::1]:80"},
+			want: []v1alpha1.CidrAndPort{{Cidr: "2001:db8::1/128", Port: 80}},
+		},
+		{
+			name: "ipv6 subnet",
+			args: args{name: "2001:db8::/64"},
+			want: []v1alpha1.CidrAndPort{{Cidr: "2001:db8::/64"}},
+		},
 		{
 			name:    "missing port",
 			args:    args{name: "1.1.1.1:"},
@@ -95,3 +112,57 @@ func TestResolveCidr(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveCidrDualStack(t *testing.T) {
+	defer mock.With("LookupIP", []net.IP{{1, 1, 1, 1}, net.ParseIP("2001:db8::1")})()
+
+	got, err := ResolveCidr("example.com:443")
+	if err != nil {
+		t.Fatalf("ResolveCidr() error = %v", err)
+	}
+
+	want := []v1alpha1.CidrAndPort{{Cidr: "1.1.1.1/32", Port: 443}, {Cidr: "2001:db8::1/128", Port: 443}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResolveCidr() got = %v, want %v", got, want)
+	}
+}
+
+func TestPodIPs(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   v1.PodStatus
+		want     []string
+		wantIPv6 bool
+	}{
+		{
+			name:   "single stack",
+			status: v1.PodStatus{PodIP: "10.0.0.1"},
+			want:   []string{"10.0.0.1"},
+		},
+		{
+			name: "dual stack",
+			status: v1.PodStatus{
+				PodIP:  "10.0.0.1",
+				PodIPs: []v1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+			},
+			want:     []string{"10.0.0.1", "fd00::1"},
+			wantIPv6: true,
+		},
+		{
+			name:   "no ip",
+			status: v1.PodStatus{},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &v1.Pod{Status: tt.status}
+			if got := PodIPs(pod); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PodIPs() got = %v, want %v", got, tt.want)
+			}
+			if got := HasIPv6(pod); got != tt.wantIPv6 {
+				t.Errorf("HasIPv6() got = %v, want %v", got, tt.wantIPv6)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos/netutils"
 	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 )
@@ -50,6 +51,7 @@ func SetTcs(ctx context.Context, pbClient chaosdaemonclient.ChaosDaemonClientInt
 			Tcs:         tcs,
 			ContainerId: containerID,
 			EnterNS:     true,
+			EnableIpv6:  netutils.HasIPv6(pod),
 		})
 
 		if err != nil {
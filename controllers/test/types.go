@@ -65,6 +65,10 @@ func (c *MockChaosDaemonClient) SetIptablesChains(ctx context.Context, in *chaos
 	return nil, mockError("SetIptablesChains")
 }
 
+func (c *MockChaosDaemonClient) GetNetworkRules(ctx context.Context, in *chaosdaemon.NetworkRulesRequest, opts ...grpc.CallOption) (*chaosdaemon.NetworkRulesResponse, error) {
+	return nil, mockError("GetNetworkRules")
+}
+
 func (c *MockChaosDaemonClient) SetTimeOffset(ctx context.Context, in *chaosdaemon.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetTimeOffset")
 }
@@ -85,10 +89,42 @@ func (c *MockChaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *chaosdae
 	return nil, mockError("ApplyHttpChaos")
 }
 
+func (c *MockChaosDaemonClient) GetHttpChaosStats(ctx context.Context, in *chaosdaemon.HttpChaosStatsRequest, opts ...grpc.CallOption) (*chaosdaemon.HttpChaosStatsResponse, error) {
+	return nil, mockError("GetHttpChaosStats")
+}
+
+func (c *MockChaosDaemonClient) ApplyGrpcChaos(ctx context.Context, in *chaosdaemon.ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyGrpcChaosResponse, error) {
+	return nil, mockError("ApplyGrpcChaos")
+}
+
+func (c *MockChaosDaemonClient) RecoverGrpcChaos(ctx context.Context, in *chaosdaemon.RecoverGrpcChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverGrpcChaos")
+}
+
+func (c *MockChaosDaemonClient) ApplyProtocolChaos(ctx context.Context, in *chaosdaemon.ApplyProtocolChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyProtocolChaosResponse, error) {
+	return nil, mockError("ApplyProtocolChaos")
+}
+
+func (c *MockChaosDaemonClient) RecoverProtocolChaos(ctx context.Context, in *chaosdaemon.RecoverProtocolChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverProtocolChaos")
+}
+
+func (c *MockChaosDaemonClient) ApplyDNSChaos(ctx context.Context, in *chaosdaemon.ApplyDNSChaosRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyDNSChaosResponse, error) {
+	return nil, mockError("ApplyDNSChaos")
+}
+
+func (c *MockChaosDaemonClient) RecoverDNSChaos(ctx context.Context, in *chaosdaemon.RecoverDNSChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverDNSChaos")
+}
+
 func (c *MockChaosDaemonClient) SetDNSServer(ctx context.Context, in *chaosdaemon.SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetDNSServer")
 }
 
+func (c *MockChaosDaemonClient) SetTrustedCA(ctx context.Context, in *chaosdaemon.SetTrustedCARequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("SetTrustedCA")
+}
+
 func (c *MockChaosDaemonClient) SetTcs(ctx context.Context, in *chaosdaemon.TcsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetTcs")
 }
@@ -156,6 +156,22 @@ var ChaosObjects = fx.Supply(
 			Object: &v1alpha1.RuntimeMutatorChaos{},
 		},
 	},
+
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "grpcchaos",
+			Object: &v1alpha1.GRPCChaos{},
+		},
+	},
+
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "protocolchaos",
+			Object: &v1alpha1.ProtocolChaos{},
+		},
+	},
 )
 
 // WebhookObject only used for registration the
@@ -35,6 +35,40 @@ func (u Updated) Message() string {
 	return fmt.Sprintf("Successfully update %s of resource", u.Field)
 }
 
+type Drifted struct {
+	Count int
+}
+
+func (d Drifted) Type() string {
+	return "Warning"
+}
+
+func (d Drifted) Reason() string {
+	return "Drifted"
+}
+
+func (d Drifted) Message() string {
+	return fmt.Sprintf("Injected rules drifted %d times and have been applied again", d.Count)
+}
+
+type Conflicted struct {
+	Pod    string
+	Source string
+	Policy string
+}
+
+func (c Conflicted) Type() string {
+	return "Warning"
+}
+
+func (c Conflicted) Reason() string {
+	return "Conflicted"
+}
+
+func (c Conflicted) Message() string {
+	return fmt.Sprintf("Rules on pod %s conflict with %s, resolved by %s policy", c.Pod, c.Source, c.Policy)
+}
+
 func init() {
-	register(Updated{})
+	register(Updated{}, Drifted{}, Conflicted{})
 }
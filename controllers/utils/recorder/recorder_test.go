@@ -38,6 +38,8 @@ func TestGenerateAnnotations(t *testing.T) {
 		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "recovered"}, Recovered{"test"}},
 
 		{map[string]string{"chaos-mesh.org/field": "test", "chaos-mesh.org/type": "updated"}, Updated{"test"}},
+		{map[string]string{"chaos-mesh.org/count": "2", "chaos-mesh.org/type": "drifted"}, Drifted{2}},
+		{map[string]string{"chaos-mesh.org/pod": "default/pod", "chaos-mesh.org/source": "default/chaos", "chaos-mesh.org/policy": "reject", "chaos-mesh.org/type": "conflicted"}, Conflicted{"default/pod", "default/chaos", "reject"}},
 
 		{map[string]string{"chaos-mesh.org/type": "deleted"}, Deleted{}},
 		{map[string]string{"chaos-mesh.org/type": "time-up"}, TimeUp{}},
@@ -82,6 +84,8 @@ func TestParse(t *testing.T) {
 		{"Successfully recover chaos for test", Recovered{"test"}},
 
 		{"Successfully update test of resource", Updated{"test"}},
+		{"Injected rules drifted 2 times and have been applied again", Drifted{2}},
+		{"Rules on pod default/pod conflict with default/chaos, resolved by reject policy", Conflicted{"default/pod", "default/chaos", "reject"}},
 
 		{"Experiment has been deleted", Deleted{}},
 		{"Time up according to the duration", TimeUp{}},
@@ -1079,6 +1079,7 @@ rules:
       - ""
     resources:
       - pods
+      - secrets
     verbs:
       - "create"
   - apiGroups:
@@ -0,0 +1,127 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	chaosdaemoncgroups "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+)
+
+// cgroupLimit is the value written to a file of cgroup to throttle the io
+type cgroupLimit struct {
+	file  string
+	value string
+}
+
+// setBlockLimit throttles the io of the container on the block device of volume with the
+// io controller of cgroup, and the zero limits remove the throttling
+func (s *DaemonServer) setBlockLimit(ctx context.Context, containerID string, volumePath string, iops, bps uint64) error {
+	volumeName, err := normalizeVolumeName(ctx, volumePath)
+	if err != nil {
+		return err
+	}
+
+	device, err := blockDeviceNumber("/dev/" + volumeName)
+	if err != nil {
+		return err
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return err
+	}
+
+	var limits []cgroupLimit
+	if cgroups.Mode() == cgroups.Unified {
+		groupPath, err := chaosdaemoncgroups.V2PidGroupPath(int(pid))
+		if err != nil {
+			return err
+		}
+		limits = ioMaxLimits(filepath.Join("/host-sys/fs/cgroup", groupPath), device, iops, bps)
+	} else {
+		groupPath, err := chaosdaemoncgroups.PidPath(int(pid))("blkio")
+		if err != nil {
+			return errors.Wrapf(err, "get blkio cgroup of pid %d", pid)
+		}
+		limits = blkioThrottleLimits(filepath.Join("/host-sys/fs/cgroup/blkio", groupPath), device, iops, bps)
+	}
+
+	for _, limit := range limits {
+		// it doesn't matter to pass any permission, because the file must exist
+		if err := os.WriteFile(limit.file, []byte(limit.value), 0000); err != nil {
+			return errors.Wrapf(err, "writing %s to %s", limit.value, limit.file)
+		}
+	}
+	return nil
+}
+
+// blockDeviceNumber returns the MAJ:MIN of the block device, which identifies it in cgroup
+func blockDeviceNumber(devicePath string) (string, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(devicePath, &stat); err != nil {
+		return "", errors.Wrapf(err, "stat %s", devicePath)
+	}
+	if stat.Mode&unix.S_IFMT != unix.S_IFBLK {
+		return "", errors.Errorf("%s is not a block device", devicePath)
+	}
+
+	return fmt.Sprintf("%d:%d", unix.Major(uint64(stat.Rdev)), unix.Minor(uint64(stat.Rdev))), nil
+}
+
+// ioMaxLimits returns the limits of cgroup v2, the limits are removed with "max"
+func ioMaxLimits(groupPath string, device string, iops, bps uint64) []cgroupLimit {
+	limit := func(value uint64) string {
+		if value == 0 {
+			return "max"
+		}
+		return strconv.FormatUint(value, 10)
+	}
+
+	return []cgroupLimit{{
+		file: filepath.Join(groupPath, "io.max"),
+		value: fmt.Sprintf("%s riops=%s wiops=%s rbps=%s wbps=%s",
+			device, limit(iops), limit(iops), limit(bps), limit(bps)),
+	}}
+}
+
+// blkioThrottleLimits returns the limits of cgroup v1, the limits are removed with 0
+func blkioThrottleLimits(groupPath string, device string, iops, bps uint64) []cgroupLimit {
+	var limits []cgroupLimit
+	for _, throttle := range []struct {
+		file  string
+		value uint64
+	}{
+		{"blkio.throttle.read_iops_device", iops},
+		{"blkio.throttle.write_iops_device", iops},
+		{"blkio.throttle.read_bps_device", bps},
+		{"blkio.throttle.write_bps_device", bps},
+	} {
+		limits = append(limits, cgroupLimit{
+			file:  filepath.Join(groupPath, throttle.file),
+			value: fmt.Sprintf("%s %d", device, throttle.value),
+		})
+	}
+	return limits
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_blockLimits(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ioMaxLimits("/group", "8:0", 100, 0)).To(Equal([]cgroupLimit{
+		{file: "/group/io.max", value: "8:0 riops=100 wiops=100 rbps=max wbps=max"},
+	}))
+	g.Expect(ioMaxLimits("/group", "8:0", 0, 0)).To(Equal([]cgroupLimit{
+		{file: "/group/io.max", value: "8:0 riops=max wiops=max rbps=max wbps=max"},
+	}))
+
+	g.Expect(blkioThrottleLimits("/group", "8:0", 0, 1024)).To(Equal([]cgroupLimit{
+		{file: "/group/blkio.throttle.read_iops_device", value: "8:0 0"},
+		{file: "/group/blkio.throttle.write_iops_device", value: "8:0 0"},
+		{file: "/group/blkio.throttle.read_bps_device", value: "8:0 1024"},
+		{file: "/group/blkio.throttle.write_bps_device", value: "8:0 1024"},
+	}))
+}
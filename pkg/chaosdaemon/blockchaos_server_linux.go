@@ -33,6 +33,16 @@ const chaosDaemonHelperCommand = "cdh"
 func (s *DaemonServer) ApplyBlockChaos(ctx context.Context, req *pb.ApplyBlockChaosRequest) (*pb.ApplyBlockChaosResponse, error) {
 	log := s.getLoggerFromContext(ctx)
 
+	if req.Action == pb.ApplyBlockChaosRequest_Limit {
+		log.Info("Limiting block device", "iops", req.Limit.Iops, "bps", req.Limit.Bps)
+
+		if err := s.setBlockLimit(ctx, req.ContainerId, req.VolumePath, req.Limit.Iops, req.Limit.Bps); err != nil {
+			log.Error(err, "limit block device", "volumePath", req.VolumePath)
+			return nil, err
+		}
+		return &pb.ApplyBlockChaosResponse{}, nil
+	}
+
 	volumeName, err := normalizeVolumeName(ctx, req.VolumePath)
 	if err != nil {
 		log.Error(err, "normalize volume name", "volumePath", req.VolumePath)
@@ -139,6 +149,16 @@ func enableIOEMElevator(volumeName string) error {
 func (s *DaemonServer) RecoverBlockChaos(ctx context.Context, req *pb.RecoverBlockChaosRequest) (*empty.Empty, error) {
 	log := s.getLoggerFromContext(ctx)
 
+	if req.Action == pb.ApplyBlockChaosRequest_Limit {
+		log.Info("Recovering block device limit", "volumePath", req.VolumePath)
+
+		if err := s.setBlockLimit(ctx, req.ContainerId, req.VolumePath, 0, 0); err != nil {
+			log.Error(err, "recover block device limit", "volumePath", req.VolumePath)
+			return nil, err
+		}
+		return &empty.Empty{}, nil
+	}
+
 	c, err := client.New()
 	if err != nil {
 		log.Error(err, "create chaos-driver client")
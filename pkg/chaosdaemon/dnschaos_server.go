@@ -0,0 +1,170 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+func (s *DaemonServer) ApplyDNSChaos(ctx context.Context, in *pb.ApplyDNSChaosRequest) (*pb.ApplyDNSChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying dns chaos")
+
+	if _, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid); !ok {
+		if in.InstanceUid != "" {
+			// chaos daemon may restart, create another dns proxy instance
+			if err := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); err != nil {
+				// ignore this error
+				log.Error(err, "kill background process", "uid", in.InstanceUid)
+			}
+		}
+
+		uid, err := s.createDNSChaos(ctx, in)
+		if err != nil {
+			return nil, errors.Wrap(err, "create dns chaos")
+		}
+		in.InstanceUid = uid
+	}
+
+	if err := s.applyDNSChaos(ctx, in); err != nil {
+		if killError := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); killError != nil {
+			log.Error(killError, "kill dns proxy", "uid", in.InstanceUid)
+		}
+		return nil, errors.Wrap(err, "apply config")
+	}
+
+	return &pb.ApplyDNSChaosResponse{InstanceUid: in.InstanceUid}, nil
+}
+
+func (s *DaemonServer) RecoverDNSChaos(ctx context.Context, in *pb.RecoverDNSChaosRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("recovering dns chaos", "uid", in.InstanceUid)
+
+	if _, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid); !ok {
+		// the proxy has exited, or chaos daemon has restarted
+		return &empty.Empty{}, nil
+	}
+
+	if err := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); err != nil {
+		return nil, errors.Wrapf(err, "kill dns proxy(%s)", in.InstanceUid)
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) applyDNSChaos(ctx context.Context, in *pb.ApplyDNSChaosRequest) error {
+	log := s.getLoggerFromContext(ctx)
+
+	var rules []tproxyconfig.DNSRule
+	if err := json.Unmarshal([]byte(in.Rules), &rules); err != nil {
+		return errors.Wrap(err, "unmarshal rules")
+	}
+
+	config, err := json.Marshal(&tproxyconfig.DNSConfig{Rules: rules})
+	if err != nil {
+		return err
+	}
+
+	if err := s.putProxyConfig(ctx, in.InstanceUid, config); err != nil {
+		return errors.Wrap(err, "dns proxy")
+	}
+
+	log.Info("dns chaos applied")
+	return nil
+}
+
+func (s *DaemonServer) createDNSChaos(ctx context.Context, in *pb.ApplyDNSChaosRequest) (string, error) {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+	if err != nil {
+		return "", errors.Wrapf(err, "get PID of container(%s)", in.ContainerId)
+	}
+
+	upstreams, err := s.getNameservers(ctx, pid, in.EnterNS)
+	if err != nil {
+		return "", err
+	}
+
+	// only one dns proxy is allowed in a pod
+	args := []string{"dns-proxy"}
+	for _, upstream := range upstreams {
+		args = append(args, "--upstream", upstream)
+	}
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, args...).
+		SetIdentifier(fmt.Sprintf("dns-proxy-%s", in.ContainerId)).
+		SetEnv(pathEnv, os.Getenv(pathEnv))
+
+	if in.EnterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	cmd.Stderr = os.Stderr
+
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
+	if err != nil {
+		return "", errors.Wrapf(err, "execute command(%s)", cmd)
+	}
+
+	return proc.Uid, nil
+}
+
+// getNameservers reads the addresses of nameservers from the resolv.conf of the container
+func (s *DaemonServer) getNameservers(ctx context.Context, pid uint32, enterNS bool) ([]string, error) {
+	processBuilder := bpm.DefaultProcessBuilder("cat", DNSServerConfFile).SetContext(ctx)
+	if enterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, util.EncodeOutputToError(output, err)
+	}
+
+	upstreams := parseNameservers(string(output))
+	if len(upstreams) == 0 {
+		return nil, errors.Errorf("no nameserver in %s", DNSServerConfFile)
+	}
+	return upstreams, nil
+}
+
+// parseNameservers returns the addresses of nameservers in the content of resolv.conf
+func parseNameservers(content string) []string {
+	var upstreams []string
+	for _, line := range strings.Split(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+		if net.ParseIP(fields[1]) == nil {
+			continue
+		}
+		upstreams = append(upstreams, net.JoinHostPort(fields[1], "53"))
+	}
+	return upstreams
+}
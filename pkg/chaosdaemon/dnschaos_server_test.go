@@ -0,0 +1,88 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon_test
+
+import (
+	"context"
+	"os/exec"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func Test_ApplyDNSChaos_Upstreams(t *testing.T) {
+	g := NewWithT(t)
+
+	var proxyArgs []string
+	mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+		if cmd == "cat" {
+			return exec.Command("echo", "search default.svc.cluster.local\nnameserver 10.96.0.10\nnameserver fd00::a\noptions ndots:5")
+		}
+		proxyArgs = args
+		return exec.Command("sleep", "1")
+	})
+
+	mock.With("MockContainerdClient", &test.MockClient{})
+
+	crc, err := crclients.CreateContainerRuntimeInfoClient(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server := chaosdaemon.NewDaemonServerWithCRClient(crc, nil, logr.Discard())
+
+	// the mocked process doesn't answer the config
+	_, _ = server.ApplyDNSChaos(context.TODO(), &pb.ApplyDNSChaosRequest{
+		Rules:       "[]",
+		ContainerId: "containerd://foo",
+		EnterNS:     false,
+	})
+	g.Expect(proxyArgs).To(Equal([]string{"dns-proxy", "--upstream", "10.96.0.10:53", "--upstream", "[fd00::a]:53"}))
+}
+
+func Test_ApplyDNSChaos_NoNameserver(t *testing.T) {
+	g := NewWithT(t)
+
+	mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+		if cmd != "cat" {
+			g.Fail("dns proxy should not be started")
+		}
+		return exec.Command("echo", "search default.svc.cluster.local")
+	})
+
+	mock.With("MockContainerdClient", &test.MockClient{})
+
+	crc, err := crclients.CreateContainerRuntimeInfoClient(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server := chaosdaemon.NewDaemonServerWithCRClient(crc, nil, logr.Discard())
+
+	_, err = server.ApplyDNSChaos(context.TODO(), &pb.ApplyDNSChaosRequest{
+		Rules:       "[]",
+		ContainerId: "containerd://foo",
+		EnterNS:     false,
+	})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnsproxy
+
+import (
+	"io"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	// UpstreamMark is the mark of the packets sent to the upstream nameservers by
+	// MarkUpstream, which should be excluded from the redirection to the responder
+	UpstreamMark = 0x5d4e5
+
+	// maxCNAMEDepth is the maximum number of CNAME records followed by the responder
+	maxCNAMEDepth = 8
+)
+
+// Proxy is a DNS responder. It answers the requests selected by the rules, and
+// forwards the others to the upstream nameservers.
+type Proxy struct {
+	upstreams []string
+
+	// control is called on the sockets to the upstream nameservers before
+	// connecting, which is usually MarkUpstream
+	control func(network, address string, c syscall.RawConn) error
+
+	sync.RWMutex
+	rules []*rule
+}
+
+// New creates a DNS responder forwarding the requests to the upstream nameservers
+func New(upstreams []string, control func(network, address string, c syscall.RawConn) error) (*Proxy, error) {
+	if len(upstreams) == 0 {
+		return nil, errors.New("no upstream nameserver")
+	}
+
+	return &Proxy{
+		upstreams: upstreams,
+		control:   control,
+	}, nil
+}
+
+// SetRules replaces the rules of responder, it's applied to the new requests.
+func (p *Proxy) SetRules(rules []tproxyconfig.DNSRule) error {
+	compiled := make([]*rule, 0, len(rules))
+	for _, r := range rules {
+		item, err := compileRule(r)
+		if err != nil {
+			return err
+		}
+		compiled = append(compiled, item)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.rules = compiled
+	return nil
+}
+
+// ServeConfig serves the config on in and out with tproxyconfig.Serve, a `PUT /` request
+// with the json of tproxyconfig.DNSConfig replaces the rules of responder.
+func (p *Proxy) ServeConfig(in io.Reader, out io.Writer) error {
+	return tproxyconfig.Serve(in, out, tproxyconfig.PutRules(p.SetRules))
+}
+
+// ServeUDP serves the requests on the packet conn until it's closed
+func (p *Proxy) ServeUDP(conn net.PacketConn) error {
+	server := &dns.Server{PacketConn: conn, Handler: p}
+	return server.ActivateAndServe()
+}
+
+// ServeTCP serves the requests on the listener until it's closed
+func (p *Proxy) ServeTCP(l net.Listener) error {
+	server := &dns.Server{Listener: l, Handler: p}
+	return server.ActivateAndServe()
+}
+
+func (p *Proxy) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	network := w.LocalAddr().Network()
+	resp, err := p.answer(req, network)
+	if err != nil {
+		resp = new(dns.Msg)
+		resp.SetRcode(req, dns.RcodeServerFailure)
+	}
+	w.WriteMsg(resp)
+}
+
+func (p *Proxy) answer(req *dns.Msg, network string) (*dns.Msg, error) {
+	if len(req.Question) == 0 {
+		return p.forward(req, network)
+	}
+
+	r := p.match(req.Question[0].Name)
+	if r == nil {
+		return p.forward(req, network)
+	}
+
+	if resp := r.respond(req, network == "udp"); resp != nil {
+		if r.action == fixedAction {
+			p.followCNAME(resp, req.Question[0].Qtype, network)
+		}
+		return resp, nil
+	}
+
+	if r.delay > 0 {
+		time.Sleep(r.delay)
+	}
+	return p.forward(req, network)
+}
+
+func (p *Proxy) match(name string) *rule {
+	p.RLock()
+	defer p.RUnlock()
+
+	for _, r := range p.rules {
+		if r.match(name) {
+			return r
+		}
+	}
+	return nil
+}
+
+// followCNAME resolves the target of the last CNAME record in the answers, with
+// the fixed records or the upstream nameservers, because the clients of a stub
+// resolver don't follow the CNAME records themselves.
+func (p *Proxy) followCNAME(resp *dns.Msg, qtype uint16, network string) {
+	if qtype == dns.TypeCNAME {
+		return
+	}
+
+	for depth := 0; depth < maxCNAMEDepth && len(resp.Answer) > 0; depth++ {
+		cname, ok := resp.Answer[len(resp.Answer)-1].(*dns.CNAME)
+		if !ok {
+			return
+		}
+
+		if r := p.match(cname.Target); r != nil && r.action == fixedAction {
+			if answers, ok := r.lookup(cname.Target, qtype); ok {
+				resp.Answer = append(resp.Answer, answers...)
+				continue
+			}
+		}
+
+		req := new(dns.Msg)
+		req.SetQuestion(cname.Target, qtype)
+		upstream, err := p.forward(req, network)
+		if err != nil {
+			return
+		}
+		resp.Answer = append(resp.Answer, upstream.Answer...)
+		return
+	}
+}
+
+// forward sends the request to the upstream nameservers in order, and returns
+// the first answer
+func (p *Proxy) forward(req *dns.Msg, network string) (*dns.Msg, error) {
+	client := &dns.Client{
+		Net: network,
+		Dialer: &net.Dialer{
+			Timeout: 2 * time.Second,
+			Control: p.control,
+		},
+	}
+
+	var lastErr error
+	for _, upstream := range p.upstreams {
+		resp, _, err := client.Exchange(req, upstream)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+	}
+	return nil, errors.Wrap(lastErr, "forward request")
+}
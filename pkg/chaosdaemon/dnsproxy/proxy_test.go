@@ -0,0 +1,173 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnsproxy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// startUpstream starts a nameserver answering 192.0.2.1 for every A request
+func startUpstream(t *testing.T) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := &dns.Server{PacketConn: conn, Handler: dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		if req.Question[0].Qtype == dns.TypeA {
+			resp.Answer = append(resp.Answer, aRecord(req.Question[0].Name, net.ParseIP("192.0.2.1").To4()))
+		}
+		w.WriteMsg(resp)
+	})}
+	go server.ActivateAndServe()
+	t.Cleanup(func() { server.Shutdown() })
+	return conn.LocalAddr().String()
+}
+
+// startProxy starts the responder with the rules, and returns the address of it
+func startProxy(t *testing.T, rules []tproxyconfig.DNSRule) string {
+	proxy, err := New([]string{startUpstream(t)}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := proxy.SetRules(rules); err != nil {
+		t.Fatal(err)
+	}
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go proxy.ServeUDP(conn)
+	t.Cleanup(func() { conn.Close() })
+	return conn.LocalAddr().String()
+}
+
+func query(t *testing.T, addr string, name string, qtype uint16) *dns.Msg {
+	req := new(dns.Msg)
+	req.SetQuestion(dns.Fqdn(name), qtype)
+	resp, _, err := new(dns.Client).Exchange(req, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func answers(resp *dns.Msg) []string {
+	var values []string
+	for _, rr := range resp.Answer {
+		switch record := rr.(type) {
+		case *dns.A:
+			values = append(values, record.A.String())
+		case *dns.AAAA:
+			values = append(values, record.AAAA.String())
+		case *dns.CNAME:
+			values = append(values, record.Target)
+		}
+	}
+	return values
+}
+
+func TestForward(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{Action: errorAction, Patterns: []string{"*.example.com"}}})
+
+	resp := query(t, addr, "chaos-mesh.org", dns.TypeA)
+	g.Expect(resp.Rcode).Should(Equal(dns.RcodeSuccess))
+	g.Expect(answers(resp)).Should(Equal([]string{"192.0.2.1"}))
+
+	resp = query(t, addr, "api.example.com", dns.TypeA)
+	g.Expect(resp.Rcode).Should(Equal(dns.RcodeServerFailure))
+}
+
+func TestErrorAction(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{Action: errorAction, Rcode: "NXDOMAIN"}})
+	resp := query(t, addr, "api.example.com", dns.TypeA)
+	g.Expect(resp.Rcode).Should(Equal(dns.RcodeNameError))
+}
+
+func TestTruncate(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{Action: errorAction, Truncate: true}})
+	resp := query(t, addr, "api.example.com", dns.TypeA)
+	g.Expect(resp.Truncated).Should(BeTrue())
+	g.Expect(resp.Answer).Should(BeEmpty())
+}
+
+func TestRandomAction(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{Action: randomAction}})
+	resp := query(t, addr, "api.example.com", dns.TypeA)
+	g.Expect(resp.Answer).Should(HaveLen(1))
+	g.Expect(answers(resp)).ShouldNot(Equal([]string{"192.0.2.1"}))
+}
+
+func TestDelayAction(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{Action: delayAction, Delay: "200ms"}})
+	start := time.Now()
+	resp := query(t, addr, "api.example.com", dns.TypeA)
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+	g.Expect(answers(resp)).Should(Equal([]string{"192.0.2.1"}))
+}
+
+func TestFixedAction(t *testing.T) {
+	g := NewWithT(t)
+
+	addr := startProxy(t, []tproxyconfig.DNSRule{{
+		Action: fixedAction,
+		Records: map[string][]string{
+			"api.example.com":    {"10.0.0.1", "fd00::1"},
+			"db.example.com":     {"sinkhole.local"},
+			"sinkhole.local":     {"10.0.0.2"},
+			"mirror.example.com": {"chaos-mesh.org"},
+		},
+	}})
+
+	g.Expect(answers(query(t, addr, "API.example.com", dns.TypeA))).Should(Equal([]string{"10.0.0.1"}))
+	g.Expect(answers(query(t, addr, "api.example.com", dns.TypeAAAA))).Should(Equal([]string{"fd00::1"}))
+	g.Expect(answers(query(t, addr, "db.example.com", dns.TypeA))).Should(Equal([]string{"sinkhole.local.", "10.0.0.2"}))
+	g.Expect(answers(query(t, addr, "mirror.example.com", dns.TypeA))).Should(Equal([]string{"chaos-mesh.org.", "192.0.2.1"}))
+	// the names without records are forwarded
+	g.Expect(answers(query(t, addr, "www.example.com", dns.TypeA))).Should(Equal([]string{"192.0.2.1"}))
+}
+
+func TestSetRules(t *testing.T) {
+	g := NewWithT(t)
+
+	proxy, err := New([]string{"127.0.0.1:53"}, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(proxy.SetRules([]tproxyconfig.DNSRule{{Action: "unknown"}})).ShouldNot(Succeed())
+	g.Expect(proxy.SetRules([]tproxyconfig.DNSRule{{Action: delayAction, Delay: "1"}})).ShouldNot(Succeed())
+	g.Expect(proxy.SetRules([]tproxyconfig.DNSRule{{Action: errorAction, Rcode: "UNKNOWN"}})).ShouldNot(Succeed())
+
+	_, err = New(nil, nil)
+	g.Expect(err).Should(HaveOccurred())
+}
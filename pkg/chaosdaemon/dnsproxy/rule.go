@@ -0,0 +1,203 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dnsproxy
+
+import (
+	"crypto/rand"
+	"net"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	errorAction  = "error"
+	randomAction = "random"
+	delayAction  = "delay"
+	fixedAction  = "fixed"
+
+	// recordTTL is the ttl of the records answered by the responder
+	recordTTL = 10
+)
+
+// rule is the compiled tproxyconfig.DNSRule
+type rule struct {
+	patterns []string
+	action   string
+	delay    time.Duration
+	rcode    int
+	truncate bool
+	records  map[string][]string
+}
+
+func compileRule(in tproxyconfig.DNSRule) (*rule, error) {
+	r := &rule{
+		action:   in.Action,
+		rcode:    dns.RcodeServerFailure,
+		truncate: in.Truncate,
+		records:  make(map[string][]string, len(in.Records)),
+	}
+
+	for _, pattern := range in.Patterns {
+		pattern = normalizeName(pattern)
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid pattern %s", pattern)
+		}
+		r.patterns = append(r.patterns, pattern)
+	}
+
+	switch in.Action {
+	case errorAction:
+		if len(in.Rcode) > 0 {
+			rcode, ok := dns.StringToRcode[strings.ToUpper(in.Rcode)]
+			if !ok {
+				return nil, errors.Errorf("unknown rcode %s", in.Rcode)
+			}
+			r.rcode = rcode
+		}
+	case randomAction:
+	case delayAction:
+		delay, err := time.ParseDuration(in.Delay)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse delay %s", in.Delay)
+		}
+		r.delay = delay
+	case fixedAction:
+		for name, values := range in.Records {
+			r.records[normalizeName(name)] = values
+		}
+	default:
+		return nil, errors.Errorf("unknown action %s", in.Action)
+	}
+
+	return r, nil
+}
+
+// normalizeName lowers the domain name and removes the trailing dot
+func normalizeName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
+
+func (r *rule) match(name string) bool {
+	if len(r.patterns) == 0 {
+		return true
+	}
+
+	name = normalizeName(name)
+	for _, pattern := range r.patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// respond returns the answer of the request, or nil if the request should be
+// forwarded to the upstream nameservers.
+func (r *rule) respond(req *dns.Msg, udp bool) *dns.Msg {
+	if r.truncate && udp {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Truncated = true
+		return resp
+	}
+
+	question := req.Question[0]
+	switch r.action {
+	case errorAction:
+		resp := new(dns.Msg)
+		resp.SetRcode(req, r.rcode)
+		return resp
+	case randomAction:
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		switch question.Qtype {
+		case dns.TypeA:
+			resp.Answer = append(resp.Answer, aRecord(question.Name, randomIP(net.IPv4len)))
+		case dns.TypeAAAA:
+			resp.Answer = append(resp.Answer, aaaaRecord(question.Name, randomIP(net.IPv6len)))
+		default:
+			resp.SetRcode(req, dns.RcodeServerFailure)
+		}
+		return resp
+	case fixedAction:
+		answers, ok := r.lookup(question.Name, question.Qtype)
+		if !ok {
+			return nil
+		}
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Authoritative = true
+		resp.Answer = answers
+		return resp
+	}
+
+	return nil
+}
+
+// lookup returns the fixed records of the name, and whether the name has records
+func (r *rule) lookup(name string, qtype uint16) ([]dns.RR, bool) {
+	values, ok := r.records[normalizeName(name)]
+	if !ok {
+		return nil, false
+	}
+
+	var answers []dns.RR
+	for _, value := range values {
+		ip := net.ParseIP(value)
+		switch {
+		case ip == nil:
+			answers = append(answers, &dns.CNAME{
+				Hdr:    dns.RR_Header{Name: name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: recordTTL},
+				Target: dns.Fqdn(value),
+			})
+		case ip.To4() != nil && qtype == dns.TypeA:
+			answers = append(answers, aRecord(name, ip.To4()))
+		case ip.To4() == nil && qtype == dns.TypeAAAA:
+			answers = append(answers, aaaaRecord(name, ip))
+		}
+	}
+	return answers, true
+}
+
+func aRecord(name string, ip net.IP) dns.RR {
+	return &dns.A{
+		Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: recordTTL},
+		A:   ip,
+	}
+}
+
+func aaaaRecord(name string, ip net.IP) dns.RR {
+	return &dns.AAAA{
+		Hdr:  dns.RR_Header{Name: name, Rrtype: dns.TypeAAAA, Class: dns.ClassINET, Ttl: recordTTL},
+		AAAA: ip,
+	}
+}
+
+func randomIP(length int) net.IP {
+	ip := make(net.IP, length)
+	rand.Read(ip)
+	if length == net.IPv6len {
+		// use the unique local addresses, which are not routed
+		ip[0] = 0xfd
+	}
+	return ip
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/flap"
+)
+
+// flapping represents a goroutine toggling the rules of a request
+type flapping struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// isFlapDown returns whether the rule with the flap should be applied at now
+func isFlapDown(f *pb.Flap, now time.Time) bool {
+	if f == nil {
+		return true
+	}
+
+	_, down, _ := flap.Phase(time.Unix(0, f.StartTime), time.Duration(f.UpDuration), time.Duration(f.DownDuration), time.Duration(f.Jitter), now)
+	return down
+}
+
+// startFlapping calls apply whenever any of the flaps switches between up and down phase,
+// until stopFlapping is called with the same key
+func (s *DaemonServer) startFlapping(log logr.Logger, key string, flaps []*pb.Flap, apply func(ctx context.Context) error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := &flapping{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	s.flappings.Store(key, f)
+
+	go func() {
+		defer close(f.done)
+
+		for {
+			now := time.Now()
+			var next time.Time
+			for _, item := range flaps {
+				_, _, switchAt := flap.Phase(time.Unix(0, item.StartTime), time.Duration(item.UpDuration), time.Duration(item.DownDuration), time.Duration(item.Jitter), now)
+				if !switchAt.IsZero() && (next.IsZero() || switchAt.Before(next)) {
+					next = switchAt
+				}
+			}
+			if next.IsZero() {
+				<-ctx.Done()
+				return
+			}
+
+			timer := time.NewTimer(time.Until(next))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			if err := apply(ctx); err != nil && ctx.Err() == nil {
+				log.Error(err, "fail to toggle the flapping rules", "key", key)
+			}
+		}
+	}()
+}
+
+// stopFlapping stops the flapping goroutine and waits for it to exit,
+// so it won't overwrite the rules set after it
+func (s *DaemonServer) stopFlapping(key string) {
+	if f, ok := s.flappings.LoadAndDelete(key); ok {
+		f.(*flapping).cancel()
+		<-f.(*flapping).done
+	}
+}
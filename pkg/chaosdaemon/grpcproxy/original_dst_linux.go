@@ -0,0 +1,75 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"unsafe"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// ip6tSoOriginalDst is IP6T_SO_ORIGINAL_DST, which is not defined in x/sys/unix
+const ip6tSoOriginalDst = 80
+
+// OriginalDestination returns the destination address of a connection before it's
+// redirected to the proxy by the REDIRECT target of iptables or ip6tables.
+func OriginalDestination(conn net.Conn) (string, error) {
+	tcpConn, ok := conn.(*net.TCPConn)
+	if !ok {
+		return "", errors.Errorf("unexpected connection type %T", conn)
+	}
+	raw, err := tcpConn.SyscallConn()
+	if err != nil {
+		return "", err
+	}
+	// the IPv4 connections accepted by a dual-stack listener have IPv4 local addresses
+	ipv6 := tcpConn.LocalAddr().(*net.TCPAddr).IP.To4() == nil
+
+	var addr string
+	var sockErr error
+	err = raw.Control(func(fd uintptr) {
+		if ipv6 {
+			// the sockaddr_in6 is returned in the buffer of ip6_mtuinfo, which is large enough
+			info, err := unix.GetsockoptIPv6MTUInfo(int(fd), unix.SOL_IPV6, ip6tSoOriginalDst)
+			if err != nil {
+				sockErr = errors.Wrap(err, "get original destination")
+				return
+			}
+			port := (*[2]byte)(unsafe.Pointer(&info.Addr.Port))
+			addr = net.JoinHostPort(net.IP(info.Addr.Addr[:]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(port[:]))))
+			return
+		}
+
+		// the sockaddr_in is returned in the buffer of ipv6_mreq, which is large enough
+		mreq, err := unix.GetsockoptIPv6Mreq(int(fd), unix.SOL_IP, unix.SO_ORIGINAL_DST)
+		if err != nil {
+			sockErr = errors.Wrap(err, "get original destination")
+			return
+		}
+		raw := mreq.Multiaddr
+		ip := net.IPv4(raw[4], raw[5], raw[6], raw[7])
+		port := binary.BigEndian.Uint16(raw[2:4])
+		addr = net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+	})
+	if err != nil {
+		return "", err
+	}
+	return addr, sockErr
+}
@@ -0,0 +1,334 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http2"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	// the status code of gRPC when the upstream server is unreachable
+	codeUnavailable = 14
+
+	// the length of the prefix of every gRPC message, 1 byte of compressed flag and 4 bytes of length
+	messagePrefixLength = 5
+
+	// the first byte of a TLS connection, which is the content type of handshake record
+	tlsRecordHandshake = 0x16
+)
+
+// Proxy is a gRPC aware proxy of the plaintext HTTP/2 (h2c) connections. It injects
+// faults into the calls selected by the rules, and forwards the calls to the upstream
+// server of the connection. The TLS connections can't be inspected, so they are
+// forwarded as they are without any fault.
+type Proxy struct {
+	// target returns the address of the upstream server of an accepted connection
+	target func(conn net.Conn) (string, error)
+
+	server *http2.Server
+
+	sync.RWMutex
+	rules []rule
+}
+
+type rule struct {
+	tproxyconfig.GrpcRule
+
+	delay time.Duration
+}
+
+// New creates a proxy, the target returns the address of the upstream server of
+// an accepted connection, which is usually OriginalDestination.
+func New(target func(conn net.Conn) (string, error)) *Proxy {
+	return &Proxy{
+		target: target,
+		server: &http2.Server{},
+	}
+}
+
+// SetRules replaces the rules of proxy, it's applied to the new calls.
+func (p *Proxy) SetRules(rules []tproxyconfig.GrpcRule) error {
+	parsed := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		item := rule{GrpcRule: r}
+		if r.Actions.Delay != nil {
+			delay, err := time.ParseDuration(*r.Actions.Delay)
+			if err != nil {
+				return errors.Wrapf(err, "parse delay %s", *r.Actions.Delay)
+			}
+			item.delay = delay
+		}
+		parsed = append(parsed, item)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.rules = parsed
+	return nil
+}
+
+// ServeConfig serves the config on in and out with tproxyconfig.Serve, a `PUT /` request
+// with the json of tproxyconfig.GrpcConfig replaces the rules of proxy.
+func (p *Proxy) ServeConfig(in io.Reader, out io.Writer) error {
+	return tproxyconfig.Serve(in, out, tproxyconfig.PutRules(p.SetRules))
+}
+
+// Serve accepts the connections on the listener, and serves them until the listener is closed.
+func (p *Proxy) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go p.serveConn(conn)
+	}
+}
+
+func (p *Proxy) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	target, err := p.target(conn)
+	if err != nil {
+		return
+	}
+
+	reader := bufio.NewReader(conn)
+	first, err := reader.Peek(1)
+	if err != nil {
+		return
+	}
+	conn = &peekedConn{Conn: conn, reader: reader}
+	if first[0] == tlsRecordHandshake {
+		passthrough(conn, target)
+		return
+	}
+
+	transport := &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, addr)
+		},
+	}
+	defer transport.CloseIdleConnections()
+
+	p.server.ServeConn(conn, &http2.ServeConnOpts{
+		Handler: &forwarder{
+			proxy:     p,
+			target:    target,
+			transport: transport,
+		},
+	})
+}
+
+// passthrough copies the bytes between the connection and the upstream server, until
+// one of them is closed
+func passthrough(conn net.Conn, target string) {
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(upstream, conn)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(conn, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// peekedConn reads the bytes peeked while sniffing the protocol before the rest of connection
+type peekedConn struct {
+	net.Conn
+
+	reader *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// match returns the first rule selecting the call, or nil
+func (p *Proxy) match(r *http.Request) *rule {
+	service, method := splitMethod(r.URL.Path)
+
+	p.RLock()
+	defer p.RUnlock()
+	for i := range p.rules {
+		selector := p.rules[i].Selector
+		if selector.Service != nil && *selector.Service != service {
+			continue
+		}
+		if selector.Method != nil && *selector.Method != method {
+			continue
+		}
+		matched := true
+		for key, value := range selector.Metadata {
+			if r.Header.Get(key) != value {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return &p.rules[i]
+		}
+	}
+	return nil
+}
+
+// splitMethod splits the path of gRPC call `/<service>/<method>` into the service and method
+func splitMethod(path string) (string, string) {
+	path = strings.TrimPrefix(path, "/")
+	i := strings.LastIndex(path, "/")
+	if i < 0 {
+		return "", path
+	}
+	return path[:i], path[i+1:]
+}
+
+type forwarder struct {
+	proxy     *Proxy
+	target    string
+	transport *http2.Transport
+}
+
+func (f *forwarder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	abortAfter := -1
+
+	if rule := f.proxy.match(r); rule != nil {
+		if rule.delay > 0 {
+			select {
+			case <-time.After(rule.delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+		if rule.Actions.Status != nil {
+			writeStatus(w, rule.Actions.Status.Code, rule.Actions.Status.Message)
+			return
+		}
+		if rule.Actions.AbortAfterMessages != nil {
+			abortAfter = int(*rule.Actions.AbortAfterMessages)
+		}
+	}
+
+	f.forward(w, r, abortAfter)
+}
+
+// forward forwards the call to the upstream server, and resets the stream after
+// abortAfter response messages if it's not negative.
+func (f *forwarder) forward(w http.ResponseWriter, r *http.Request, abortAfter int) {
+	out := r.Clone(r.Context())
+	out.URL.Scheme = "http"
+	out.URL.Host = f.target
+	out.RequestURI = ""
+
+	resp, err := f.transport.RoundTrip(out)
+	if err != nil {
+		writeStatus(w, codeUnavailable, fmt.Sprintf("grpc proxy: %s", err))
+		return
+	}
+	defer resp.Body.Close()
+
+	for key, values := range resp.Header {
+		w.Header()[key] = values
+	}
+	// the headers are flushed with the first message, to keep the trailers-only
+	// responses as they are
+	w.WriteHeader(resp.StatusCode)
+
+	if !strings.HasPrefix(resp.Header.Get("Content-Type"), "application/grpc") {
+		// not a gRPC response, forward it as it is
+		io.Copy(w, resp.Body)
+		return
+	}
+
+	flusher, _ := w.(http.Flusher)
+	prefix := make([]byte, messagePrefixLength)
+	for count := 0; ; count++ {
+		if abortAfter >= 0 && count >= abortAfter {
+			// resets the stream, and the client receives an error in the middle of call
+			panic(http.ErrAbortHandler)
+		}
+
+		if _, err := io.ReadFull(resp.Body, prefix); err != nil {
+			if err == io.EOF {
+				break
+			}
+			panic(http.ErrAbortHandler)
+		}
+		length := binary.BigEndian.Uint32(prefix[1:])
+		if _, err := w.Write(prefix); err != nil {
+			return
+		}
+		if _, err := io.CopyN(w, resp.Body, int64(length)); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	for key, values := range resp.Trailer {
+		w.Header()[http.TrailerPrefix+key] = values
+	}
+}
+
+// writeStatus responds the call with a trailers-only response of gRPC status
+func writeStatus(w http.ResponseWriter, code int32, message string) {
+	header := w.Header()
+	header.Set("Content-Type", "application/grpc")
+	header.Set("Grpc-Status", strconv.Itoa(int(code)))
+	if len(message) > 0 {
+		header.Set("Grpc-Message", encodeMessage(message))
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// encodeMessage percent-encodes the message as the grpc-message header requires
+func encodeMessage(message string) string {
+	var builder strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
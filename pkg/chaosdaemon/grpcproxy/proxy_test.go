@@ -0,0 +1,244 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package grpcproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const healthService = "grpc.health.v1.Health"
+
+// startProxy starts a local gRPC server with the health service, and a proxy in front of it
+func startProxy(t *testing.T) (*Proxy, healthpb.HealthClient) {
+	g := NewWithT(t)
+
+	serverListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go server.Serve(serverListener)
+	t.Cleanup(server.Stop)
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	proxy := New(func(net.Conn) (string, error) {
+		return serverListener.Addr().String(), nil
+	})
+	go proxy.Serve(proxyListener)
+	t.Cleanup(func() { proxyListener.Close() })
+
+	conn, err := grpc.NewClient(proxyListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(func() { conn.Close() })
+
+	return proxy, healthpb.NewHealthClient(conn)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func TestProxyForward(t *testing.T) {
+	g := NewWithT(t)
+	_, client := startProxy(t)
+
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.Status).Should(Equal(healthpb.HealthCheckResponse_SERVING))
+
+	// the errors of upstream server are forwarded
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+	g.Expect(status.Code(err)).Should(Equal(codes.NotFound))
+}
+
+func TestProxyStatus(t *testing.T) {
+	g := NewWithT(t)
+	proxy, client := startProxy(t)
+
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Selector: tproxyconfig.GrpcSelector{
+			Service: stringPtr(healthService),
+			Method:  stringPtr("Check"),
+		},
+		Actions: tproxyconfig.GrpcActions{
+			Status: &tproxyconfig.GrpcStatus{Code: int32(codes.Unavailable), Message: "injected 100%"},
+		},
+	}})).Should(Succeed())
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+	g.Expect(status.Convert(err).Message()).Should(Equal("injected 100%"))
+
+	// the other methods are not selected
+	_, err = client.List(context.Background(), &healthpb.HealthListRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+}
+
+func TestProxyMetadata(t *testing.T) {
+	g := NewWithT(t)
+	proxy, client := startProxy(t)
+
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Selector: tproxyconfig.GrpcSelector{
+			Metadata: map[string]string{"x-chaos": "true"},
+		},
+		Actions: tproxyconfig.GrpcActions{
+			Status: &tproxyconfig.GrpcStatus{Code: int32(codes.PermissionDenied)},
+		},
+	}})).Should(Succeed())
+
+	_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-chaos", "true")
+	_, err = client.Check(ctx, &healthpb.HealthCheckRequest{})
+	g.Expect(status.Code(err)).Should(Equal(codes.PermissionDenied))
+}
+
+func TestProxyDelay(t *testing.T) {
+	g := NewWithT(t)
+	proxy, client := startProxy(t)
+
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Selector: tproxyconfig.GrpcSelector{
+			Service: stringPtr(healthService),
+		},
+		Actions: tproxyconfig.GrpcActions{
+			Delay: stringPtr("200ms"),
+		},
+	}})).Should(Succeed())
+
+	start := time.Now()
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.Status).Should(Equal(healthpb.HealthCheckResponse_SERVING))
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+}
+
+func TestProxyAbort(t *testing.T) {
+	g := NewWithT(t)
+	proxy, client := startProxy(t)
+
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Selector: tproxyconfig.GrpcSelector{
+			Method: stringPtr("Watch"),
+		},
+		Actions: tproxyconfig.GrpcActions{
+			AbortAfterMessages: int32Ptr(1),
+		},
+	}})).Should(Succeed())
+
+	stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	resp, err := stream.Recv()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.Status).Should(Equal(healthpb.HealthCheckResponse_SERVING))
+	_, err = stream.Recv()
+	g.Expect(status.Code(err)).Should(Equal(codes.Internal))
+
+	// unary calls are aborted before the response message
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Actions: tproxyconfig.GrpcActions{
+			AbortAfterMessages: int32Ptr(0),
+		},
+	}})).Should(Succeed())
+	_, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	g.Expect(status.Code(err)).Should(Equal(codes.Internal))
+}
+
+func TestServeConfig(t *testing.T) {
+	g := NewWithT(t)
+	proxy := New(nil)
+
+	in := &bytes.Buffer{}
+	for _, body := range []string{
+		`{"rules":[{"selector":{"service":"helloworld.Greeter"},"actions":{"delay":"1s"}}]}`,
+		`{"rules":[{"selector":{},"actions":{"delay":"1"}}]}`,
+	} {
+		req, err := http.NewRequest(http.MethodPut, "/", bytes.NewBufferString(body))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(req.Write(in)).Should(Succeed())
+	}
+
+	out := &bytes.Buffer{}
+	g.Expect(proxy.ServeConfig(in, out)).Should(Succeed())
+
+	reader := bufio.NewReader(out)
+	resp, err := http.ReadResponse(reader, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+	io.Copy(io.Discard, resp.Body)
+
+	resp, err = http.ReadResponse(reader, nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusBadRequest))
+
+	// the invalid config doesn't replace the rules
+	g.Expect(proxy.rules).Should(HaveLen(1))
+	g.Expect(proxy.rules[0].delay).Should(Equal(time.Second))
+}
+
+func TestProxyTLSPassthrough(t *testing.T) {
+	g := NewWithT(t)
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("tls"))
+	}))
+	t.Cleanup(upstream.Close)
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	proxy := New(func(net.Conn) (string, error) {
+		return upstream.Listener.Addr().String(), nil
+	})
+	g.Expect(proxy.SetRules([]tproxyconfig.GrpcRule{{
+		Actions: tproxyconfig.GrpcActions{Status: &tproxyconfig.GrpcStatus{Code: int32(codes.Unavailable)}},
+	}})).Should(Succeed())
+	go proxy.Serve(proxyListener)
+	t.Cleanup(func() { proxyListener.Close() })
+
+	// the TLS connections are forwarded as they are, without the faults
+	client := upstream.Client()
+	resp, err := client.Get("https://" + proxyListener.Addr().String())
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(body)).Should(Equal("tls"))
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/dnsproxy"
+)
+
+var dnsProxyUpstreams []string
+
+var DNSProxyCmd = &cobra.Command{
+	Use:   "dns-proxy",
+	Short: "answer the DNS requests of the network namespace with chaos",
+	Long: `Redirect the udp and tcp DNS requests sent from the network namespace into a
+DNS responder through iptables and ip6tables, answer the requests selected by the
+rules, and forward the others to the upstream nameservers. The config is read and
+the results are written in the same way as grpc-proxy. The redirect rules are removed
+when the process is terminated, or by clean-redirects if the process is killed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(dnsProxyUpstreams) == 0 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		if err := runDNSProxy(dnsProxyUpstreams); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	DNSProxyCmd.Flags().StringSliceVar(&dnsProxyUpstreams, "upstream", nil, "the address of upstream nameservers, such as 10.96.0.10:53")
+}
+
+// dnsFamily is the networks of responder and the command of redirect rules for an IP family
+type dnsFamily struct {
+	udp      string
+	tcp      string
+	iptables func(args ...string) error
+}
+
+func runDNSProxy(upstreams []string) error {
+	proxy, err := dnsproxy.New(upstreams, dnsproxy.MarkUpstream)
+	if err != nil {
+		return err
+	}
+
+	families := []dnsFamily{{udp: "udp4", tcp: "tcp4", iptables: iptables}}
+	if ipv6Supported() {
+		families = append(families, dnsFamily{udp: "udp6", tcp: "tcp6", iptables: ip6tables})
+	}
+
+	var redirects []redirect
+	defer func() {
+		for _, redirect := range redirects {
+			if err := redirect.remove(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+	}()
+
+	errCh := make(chan error, 2*len(families)+1)
+	for _, family := range families {
+		packetConn, err := net.ListenPacket(family.udp, ":0")
+		if err != nil {
+			return errors.Wrapf(err, "listen %s", family.udp)
+		}
+		defer packetConn.Close()
+
+		listener, err := net.Listen(family.tcp, ":0")
+		if err != nil {
+			return errors.Wrapf(err, "listen %s", family.tcp)
+		}
+		defer listener.Close()
+
+		for _, rule := range [][]string{
+			dnsRedirectRule("udp", packetConn.LocalAddr().(*net.UDPAddr).Port),
+			dnsRedirectRule("tcp", listener.Addr().(*net.TCPAddr).Port),
+		} {
+			redirect := redirect{iptables: family.iptables, rule: rule}
+			if err := redirect.add(); err != nil {
+				return err
+			}
+			redirects = append(redirects, redirect)
+		}
+
+		go func(network string) {
+			errCh <- errors.Wrapf(proxy.ServeUDP(packetConn), "serve %s", network)
+		}(family.udp)
+		go func(network string) {
+			errCh <- errors.Wrapf(proxy.ServeTCP(listener), "serve %s", network)
+		}(family.tcp)
+	}
+	go func() {
+		// the responder exits when chaos daemon closes the stdin
+		errCh <- proxy.ServeConfig(os.Stdin, os.Stdout)
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+
+	select {
+	case <-signals:
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+// dnsRedirectRule redirects the DNS requests of the protocol to the port of responder,
+// the requests sent to the upstream nameservers by the responder are not redirected
+func dnsRedirectRule(protocol string, port int) []string {
+	return withRedirectComment([]string{"OUTPUT", "-t", "nat", "-p", protocol, "--dport", "53",
+		"-m", "mark", "!", "--mark", strconv.Itoa(dnsproxy.UpstreamMark), "-j", "REDIRECT", "--to-ports", strconv.Itoa(port)})
+}
@@ -0,0 +1,57 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/grpcproxy"
+)
+
+var grpcProxyPort int
+
+var GrpcProxyCmd = &cobra.Command{
+	Use:   "grpc-proxy",
+	Short: "inject faults into the gRPC calls to the port",
+	Long: `Redirect the tcp connections to the port into a gRPC proxy through iptables
+and ip6tables, and inject faults into the selected calls. The config is read as http
+requests from stdin, and the results are written as http responses to stdout, in the
+same way as tproxy. The redirect rules are removed when the process is terminated, or
+by clean-redirects if the process is killed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if grpcProxyPort <= 0 || grpcProxyPort > 65535 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		if err := runGrpcProxy(grpcProxyPort); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	GrpcProxyCmd.Flags().IntVar(&grpcProxyPort, "port", 0, "the port which the target gRPC server listens on")
+}
+
+func runGrpcProxy(port int) error {
+	proxy := grpcproxy.New(grpcproxy.OriginalDestination)
+	return serveRedirected(port, proxy.Serve, proxy.ServeConfig)
+}
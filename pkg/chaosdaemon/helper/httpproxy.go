@@ -0,0 +1,169 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/dnsproxy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/grpcproxy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/httpproxy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+var HttpProxyCmd = &cobra.Command{
+	Use:   "http-proxy",
+	Short: "inject faults into the http requests and responses",
+	Long: `Redirect the inbound tcp connections to the proxy ports, and the outbound tcp
+connections to the egress targets of config into an HTTP proxy through iptables and
+ip6tables, and inject faults into the selected requests and responses. The config is
+read and the results are written in the same way as grpc-proxy, and the stats of rules
+are returned for a "GET /stats" request. The redirect rules are updated with every
+config, and removed when the process is terminated, or by clean-redirects if the
+process is killed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := runHttpProxy(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func runHttpProxy() error {
+	// the listeners are dual-stack, which accept the connections redirected by both
+	// iptables and ip6tables
+	inbound, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return errors.Wrap(err, "listen")
+	}
+	defer inbound.Close()
+
+	egress, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return errors.Wrap(err, "listen egress")
+	}
+	defer egress.Close()
+
+	redirects := &httpRedirects{
+		inboundPort: inbound.Addr().(*net.TCPAddr).Port,
+		egressPort:  egress.Addr().(*net.TCPAddr).Port,
+		families:    redirectFamilies(),
+		redirects:   make(map[string]redirect),
+	}
+	defer redirects.clear()
+
+	// the connections to the upstream servers are marked in the same way as dns-proxy,
+	// and excluded from the redirection of outbound connections
+	proxy := httpproxy.New(grpcproxy.OriginalDestination, dnsproxy.MarkUpstream, redirects.apply)
+	serve := func(l net.Listener) error {
+		errCh := make(chan error, 2)
+		go func() {
+			errCh <- proxy.Serve(l)
+		}()
+		go func() {
+			errCh <- proxy.ServeEgress(egress)
+		}()
+		return <-errCh
+	}
+	return serveProxy(inbound, serve, proxy.ServeConfig)
+}
+
+// httpRedirects keeps the iptables and ip6tables rules which redirect the inbound
+// connections to the proxy ports and the outbound connections to the egress targets of
+// config into the proxy
+type httpRedirects struct {
+	inboundPort int
+	egressPort  int
+	families    []redirectFamily
+
+	// redirects is the applied rules by their families and specifications
+	redirects map[string]redirect
+}
+
+func (r *httpRedirects) apply(config *tproxyconfig.Config) error {
+	expected := make(map[string]redirect)
+	for _, family := range r.families {
+		add := func(rule []string) {
+			rule = withRedirectComment(rule)
+			expected[fmt.Sprint(family.ipv6, rule)] = redirect{iptables: family.iptables, rule: rule}
+		}
+
+		for _, port := range config.ProxyPorts {
+			add([]string{"PREROUTING", "-t", "nat", "-p", "tcp", "--dport", strconv.Itoa(int(port)),
+				"-j", "REDIRECT", "--to-ports", strconv.Itoa(r.inboundPort)})
+		}
+		for _, target := range config.EgressTargets {
+			destinations := [][]string{nil}
+			if len(target.Cidrs) > 0 {
+				// the cidrs of the other family are redirected by the other command, and the
+				// target isn't redirected in this family if it has no cidrs of it
+				destinations = nil
+				for _, cidr := range target.Cidrs {
+					if isIPv6Cidr(cidr) == family.ipv6 {
+						destinations = append(destinations, []string{"-d", cidr})
+					}
+				}
+			}
+			for _, destination := range destinations {
+				rule := []string{"OUTPUT", "-t", "nat", "-p", "tcp"}
+				rule = append(rule, destination...)
+				add(append(rule, "--dport", strconv.Itoa(int(target.Port)), "-m", "mark", "!", "--mark", strconv.Itoa(dnsproxy.UpstreamMark),
+					"-j", "REDIRECT", "--to-ports", strconv.Itoa(r.egressPort)))
+			}
+		}
+	}
+
+	for key, redirect := range r.redirects {
+		if _, ok := expected[key]; ok {
+			continue
+		}
+		if err := redirect.remove(); err != nil {
+			return err
+		}
+		delete(r.redirects, key)
+	}
+	for key, redirect := range expected {
+		if _, ok := r.redirects[key]; ok {
+			continue
+		}
+		if err := redirect.add(); err != nil {
+			return err
+		}
+		r.redirects[key] = redirect
+	}
+	return nil
+}
+
+func (r *httpRedirects) clear() {
+	for key, redirect := range r.redirects {
+		if err := redirect.remove(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+		delete(r.redirects, key)
+	}
+}
+
+// isIPv6Cidr reports whether the cidr or the address is of IPv6
+func isIPv6Cidr(cidr string) bool {
+	return strings.Contains(cidr, ":")
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/grpcproxy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/protocolproxy"
+)
+
+var (
+	protocolProxyProtocol string
+	protocolProxyPort     int
+)
+
+var ProtocolProxyCmd = &cobra.Command{
+	Use:   "protocol-proxy",
+	Short: "inject faults into the commands of a wire protocol to the port",
+	Long: `Redirect the tcp connections to the port into a proxy of the wire protocol
+(redis, mysql or kafka) through iptables and ip6tables, and inject faults into the
+selected commands. The config is read and the results are written in the same way as
+grpc-proxy. The redirect rules are removed when the process is terminated.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if protocolProxyPort <= 0 || protocolProxyPort > 65535 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		proxy, err := protocolproxy.New(protocolProxyProtocol, grpcproxy.OriginalDestination)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		if err := serveRedirected(protocolProxyPort, proxy.Serve, proxy.ServeConfig); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	ProtocolProxyCmd.Flags().StringVar(&protocolProxyProtocol, "protocol", "", "the wire protocol of the target server, redis, mysql or kafka")
+	ProtocolProxyCmd.Flags().IntVar(&protocolProxyPort, "port", 0, "the port which the target server listens on")
+}
@@ -0,0 +1,215 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// redirectComment is the comment of iptables rules which redirect the traffic into the proxies,
+// the rules left by a killed proxy could be found and removed with it
+const redirectComment = "chaos-mesh-redirect"
+
+var CleanRedirectsCmd = &cobra.Command{
+	Use:   "clean-redirects",
+	Short: "remove the iptables and ip6tables rules which redirect the traffic into the proxies",
+	Long: `Remove the iptables rules which redirect the traffic into the proxies of http-proxy,
+grpc-proxy, protocol-proxy and dns-proxy. The rules are removed by the proxies when they are
+terminated, but are left in the network namespace if they are killed, e.g. with the
+restarting chaos daemon.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := cleanRedirects(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+// withRedirectComment inserts the comment match before the target of the iptables rule
+func withRedirectComment(rule []string) []string {
+	comment := []string{"-m", "comment", "--comment", redirectComment}
+	for i, arg := range rule {
+		if arg == "-j" {
+			return append(append(append([]string{}, rule[:i]...), comment...), rule[i:]...)
+		}
+	}
+	return append(rule, comment...)
+}
+
+// serveRedirected redirects the tcp connections to the port into a listener served by
+// serve, and serves the config on stdin and stdout with serveConfig, until the process
+// is terminated or stdin is closed.
+func serveRedirected(port int, serve func(net.Listener) error, serveConfig func(io.Reader, io.Writer) error) error {
+	// the listener is dual-stack, which accepts the connections redirected by both
+	// iptables and ip6tables
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return errors.Wrap(err, "listen")
+	}
+	defer listener.Close()
+
+	proxyPort := listener.Addr().(*net.TCPAddr).Port
+	var redirects []redirect
+	defer func() {
+		for _, redirect := range redirects {
+			if err := redirect.remove(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+			}
+		}
+	}()
+	for _, family := range redirectFamilies() {
+		redirect := redirect{iptables: family.iptables, rule: withRedirectComment([]string{"PREROUTING", "-t", "nat", "-p", "tcp",
+			"--dport", strconv.Itoa(port), "-j", "REDIRECT", "--to-ports", strconv.Itoa(proxyPort)})}
+		if err := redirect.add(); err != nil {
+			return err
+		}
+		redirects = append(redirects, redirect)
+	}
+
+	return serveProxy(listener, serve, serveConfig)
+}
+
+// serveProxy serves the listener with serve, and the config on stdin and stdout with
+// serveConfig, until the process is terminated or stdin is closed.
+func serveProxy(listener net.Listener, serve func(net.Listener) error, serveConfig func(io.Reader, io.Writer) error) error {
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- errors.Wrap(serve(listener), "serve proxy")
+	}()
+	go func() {
+		// the proxy exits when chaos daemon closes the stdin
+		errCh <- serveConfig(os.Stdin, os.Stdout)
+	}()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+
+	select {
+	case <-signals:
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
+
+func iptables(args ...string) error {
+	return runIptables("iptables", args...)
+}
+
+func ip6tables(args ...string) error {
+	return runIptables("ip6tables", args...)
+}
+
+func runIptables(command string, args ...string) error {
+	cmd := exec.Command(command, append([]string{"-w"}, args...)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "%s %v: %s", command, args, string(out))
+	}
+	return nil
+}
+
+// ipv6Supported checks whether IPv6 is enabled in the network namespace
+func ipv6Supported() bool {
+	_, err := os.Stat("/proc/net/if_inet6")
+	return err == nil
+}
+
+// redirectFamily is the command of redirect rules for an IP family
+type redirectFamily struct {
+	ipv6     bool
+	iptables func(args ...string) error
+}
+
+// redirectFamilies returns the IP families of the network namespace, IPv6 is only
+// included if it's enabled
+func redirectFamilies() []redirectFamily {
+	families := []redirectFamily{{iptables: iptables}}
+	if ipv6Supported() {
+		families = append(families, redirectFamily{ipv6: true, iptables: ip6tables})
+	}
+	return families
+}
+
+// redirect is a rule which redirects the traffic into a proxy
+type redirect struct {
+	iptables func(args ...string) error
+	rule     []string
+}
+
+func (r redirect) add() error {
+	return r.iptables(append([]string{"-A"}, r.rule...)...)
+}
+
+func (r redirect) remove() error {
+	return r.iptables(append([]string{"-D"}, r.rule...)...)
+}
+
+func cleanRedirects() error {
+	if err := cleanRedirectsOf("iptables"); err != nil {
+		return err
+	}
+	if ipv6Supported() {
+		return cleanRedirectsOf("ip6tables")
+	}
+	return nil
+}
+
+func cleanRedirectsOf(command string) error {
+	out, err := exec.Command(command, "-w", "-t", "nat", "-S").CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "list %s rules: %s", command, string(out))
+	}
+
+	for _, rule := range redirectRules(string(out)) {
+		if err := runIptables(command, append([]string{"-t", "nat", "-D"}, rule...)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// redirectRules returns the chain and the specification of redirect rules in the output of
+// iptables -S, which could be passed to iptables -D to remove the rules
+func redirectRules(rules string) [][]string {
+	var redirects [][]string
+	for _, line := range strings.Split(rules, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "-A" {
+			continue
+		}
+		for i := 0; i+1 < len(fields); i++ {
+			// the comment is quoted by some versions of iptables, while the quotes
+			// aren't a part of comment without a shell
+			if fields[i] == "--comment" && strings.Trim(fields[i+1], `"`) == redirectComment {
+				fields[i+1] = redirectComment
+				redirects = append(redirects, fields[1:])
+				break
+			}
+		}
+	}
+	return redirects
+}
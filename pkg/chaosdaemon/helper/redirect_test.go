@@ -0,0 +1,99 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+func TestRedirectRules(t *testing.T) {
+	g := NewWithT(t)
+
+	rules := `-P PREROUTING ACCEPT
+-P OUTPUT ACCEPT
+-A PREROUTING -p tcp -m tcp --dport 50051 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40123
+-A PREROUTING -p tcp -m tcp --dport 8080 -j REDIRECT --to-ports 9090
+-A OUTPUT -p udp -m udp --dport 53 -m mark ! --mark 0x1 -m comment --comment "chaos-mesh-redirect" -j REDIRECT --to-ports 40124
+`
+	g.Expect(redirectRules(rules)).To(Equal([][]string{
+		{"PREROUTING", "-p", "tcp", "-m", "tcp", "--dport", "50051", "-m", "comment", "--comment", "chaos-mesh-redirect", "-j", "REDIRECT", "--to-ports", "40123"},
+		{"OUTPUT", "-p", "udp", "-m", "udp", "--dport", "53", "-m", "mark", "!", "--mark", "0x1", "-m", "comment", "--comment", "chaos-mesh-redirect", "-j", "REDIRECT", "--to-ports", "40124"},
+	}))
+	g.Expect(redirectRules("-P PREROUTING ACCEPT\n")).To(BeEmpty())
+}
+
+func TestWithRedirectComment(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(withRedirectComment([]string{"PREROUTING", "-t", "nat", "-p", "tcp", "--dport", "80", "-j", "REDIRECT", "--to-ports", "8080"})).
+		To(Equal([]string{"PREROUTING", "-t", "nat", "-p", "tcp", "--dport", "80", "-m", "comment", "--comment", "chaos-mesh-redirect", "-j", "REDIRECT", "--to-ports", "8080"}))
+}
+
+func TestDNSRedirectRule(t *testing.T) {
+	g := NewWithT(t)
+
+	// the rules of dns-proxy could be removed by clean-redirects
+	g.Expect(dnsRedirectRule("udp", 40124)).
+		To(Equal([]string{"OUTPUT", "-t", "nat", "-p", "udp", "--dport", "53", "-m", "mark", "!", "--mark", "382181", "-m", "comment", "--comment", "chaos-mesh-redirect", "-j", "REDIRECT", "--to-ports", "40124"}))
+}
+
+func TestHttpRedirects(t *testing.T) {
+	g := NewWithT(t)
+
+	var ipv4, ipv6 []string
+	record := func(rules *[]string) func(args ...string) error {
+		return func(args ...string) error {
+			*rules = append(*rules, strings.Join(args, " "))
+			return nil
+		}
+	}
+	redirects := &httpRedirects{
+		inboundPort: 40001,
+		egressPort:  40002,
+		families:    []redirectFamily{{iptables: record(&ipv4)}, {ipv6: true, iptables: record(&ipv6)}},
+		redirects:   make(map[string]redirect),
+	}
+
+	g.Expect(redirects.apply(&tproxyconfig.Config{
+		ProxyPorts: []uint32{80},
+		EgressTargets: []tproxyconfig.EgressTarget{
+			{Port: 443, Cidrs: []string{"10.0.0.0/8", "fd00::/64"}},
+			{Port: 8080, Cidrs: []string{"192.168.0.1/32"}},
+		},
+	})).Should(Succeed())
+	// the inbound connections of both families are redirected, and the egress targets
+	// are redirected by the family of their cidrs
+	g.Expect(ipv4).Should(ConsistOf(
+		"-A PREROUTING -t nat -p tcp --dport 80 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40001",
+		"-A OUTPUT -t nat -p tcp -d 10.0.0.0/8 --dport 443 -m mark ! --mark 382181 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40002",
+		"-A OUTPUT -t nat -p tcp -d 192.168.0.1/32 --dport 8080 -m mark ! --mark 382181 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40002",
+	))
+	g.Expect(ipv6).Should(ConsistOf(
+		"-A PREROUTING -t nat -p tcp --dport 80 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40001",
+		"-A OUTPUT -t nat -p tcp -d fd00::/64 --dport 443 -m mark ! --mark 382181 -m comment --comment chaos-mesh-redirect -j REDIRECT --to-ports 40002",
+	))
+
+	ipv4, ipv6 = nil, nil
+	redirects.clear()
+	g.Expect(ipv4).Should(HaveLen(3))
+	g.Expect(ipv6).Should(HaveLen(2))
+	g.Expect(redirects.redirects).Should(BeEmpty())
+}
@@ -31,12 +31,10 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+	"github.com/chaos-mesh/chaos-mesh/pkg/metrics"
 )
 
-const (
-	tproxyBin = "/usr/local/bin/tproxy"
-	pathEnv   = "PATH"
-)
+const pathEnv = "PATH"
 
 type stdioTransport struct {
 	uid    string
@@ -82,7 +80,7 @@ func (s *DaemonServer) ApplyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 
 	if _, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid); !ok {
 		if in.InstanceUid != "" {
-			// chaos daemon may restart, create another tproxy instance
+			// chaos daemon may restart, create another http proxy instance
 			if err := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); err != nil {
 				// ignore this error
 				log.Error(err, "kill background process", "uid", in.InstanceUid)
@@ -95,10 +93,13 @@ func (s *DaemonServer) ApplyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 		}
 	}
 
+	// the counters of rules are reset by the new config
+	metrics.DefaultChaosDaemonMetricsCollector.ObserveHttpChaosTraffic(in.ContainerId, nil)
+
 	resp, err := s.applyHttpChaos(ctx, in)
 	if err != nil {
 		if killError := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); killError != nil {
-			log.Error(killError, "kill tproxy", "uid", in.InstanceUid)
+			log.Error(killError, "kill http proxy", "uid", in.InstanceUid)
 		}
 		return nil, errors.Wrap(err, "apply config")
 	}
@@ -131,6 +132,12 @@ func (s *DaemonServer) applyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 		ProxyPorts: in.ProxyPorts,
 		Rules:      rules,
 	}
+	for _, target := range in.EgressTargets {
+		httpChaosSpec.EgressTargets = append(httpChaosSpec.EgressTargets, tproxyconfig.EgressTarget{
+			Port:  target.Port,
+			Cidrs: target.Cidrs,
+		})
+	}
 
 	if len(in.Tls) != 0 {
 		httpChaosSpec.TLS = new(tproxyconfig.TLSConfig)
@@ -173,14 +180,82 @@ func (s *DaemonServer) applyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 	}, nil
 }
 
+func (s *DaemonServer) GetHttpChaosStats(ctx context.Context, in *pb.HttpChaosStatsRequest) (*pb.HttpChaosStatsResponse, error) {
+	if in.InstanceUid == "" {
+		if uid, ok := s.backgroundProcessManager.GetUID(bpm.ProcessPair{Pid: int(in.Instance), CreateTime: in.StartTime}); ok {
+			in.InstanceUid = uid
+		}
+	}
+
+	pipes, ok := s.backgroundProcessManager.GetPipes(in.InstanceUid)
+	if !ok {
+		return nil, errors.Errorf("fail to get process(%s)", in.InstanceUid)
+	}
+
+	transport := &stdioTransport{
+		uid:    in.InstanceUid,
+		locker: s.tproxyLocker,
+		pipes:  pipes,
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/stats", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create http request")
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("http proxy responds %d: %s", resp.StatusCode, string(body))
+	}
+
+	var stats tproxyconfig.Stats
+	if err := json.Unmarshal(body, &stats); err != nil {
+		return nil, errors.Wrap(err, "unmarshal stats")
+	}
+
+	res := &pb.HttpChaosStatsResponse{}
+	var observations []tproxyconfig.Observation
+	for _, rule := range stats.Rules {
+		ruleStats := &pb.HttpChaosRuleStats{
+			Matched: rule.Matched,
+			Faulted: rule.Faulted,
+		}
+		for _, observation := range rule.Observations {
+			ruleStats.Observations = append(ruleStats.Observations, &pb.HttpObservation{
+				Path:           observation.Path,
+				Method:         observation.Method,
+				Code:           observation.Code,
+				Count:          observation.Count,
+				LatencySum:     observation.LatencySum,
+				LatencyBuckets: observation.LatencyBuckets,
+			})
+		}
+		observations = append(observations, rule.Observations...)
+		res.Rules = append(res.Rules, ruleStats)
+	}
+
+	if in.ContainerId != "" {
+		metrics.DefaultChaosDaemonMetricsCollector.ObserveHttpChaosTraffic(in.ContainerId, tproxyconfig.MergeObservations(observations))
+	}
+	return res, nil
+}
+
 func (s *DaemonServer) createHttpChaos(ctx context.Context, in *pb.ApplyHttpChaosRequest) error {
 	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
 	if err != nil {
 		return errors.Wrapf(err, "get PID of container(%s)", in.ContainerId)
 	}
-	processBuilder := bpm.DefaultProcessBuilder(tproxyBin, "-i", "-vv").
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, "http-proxy").
 		EnableLocalMnt().
-		SetIdentifier(fmt.Sprintf("tproxy-%s", in.ContainerId)).
+		SetIdentifier(fmt.Sprintf("http-proxy-%s", in.ContainerId)).
 		SetEnv(pathEnv, os.Getenv(pathEnv))
 
 	if in.EnterNS {
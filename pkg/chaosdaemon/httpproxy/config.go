@@ -0,0 +1,54 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// ServeConfig serves the config on in and out with tproxyconfig.Serve. A `PUT /`
+// request with the json of tproxyconfig.Config replaces the config of proxy, and
+// a `GET /stats` request returns the json of tproxyconfig.Stats.
+func (p *Proxy) ServeConfig(in io.Reader, out io.Writer) error {
+	return tproxyconfig.Serve(in, out, p.handleConfig)
+}
+
+func (p *Proxy) handleConfig(req *http.Request) (int, string) {
+	switch {
+	case req.Method == http.MethodPut && req.URL.Path == "/":
+		var config tproxyconfig.Config
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return http.StatusBadRequest, fmt.Sprintf("decode config: %s", err)
+		}
+		if err := p.SetConfig(config); err != nil {
+			return http.StatusBadRequest, err.Error()
+		}
+		return http.StatusOK, ""
+	case req.Method == http.MethodGet && req.URL.Path == "/stats":
+		stats, err := json.Marshal(p.Stats())
+		if err != nil {
+			return http.StatusInternalServerError, fmt.Sprintf("encode stats: %s", err)
+		}
+		return http.StatusOK, string(stats)
+	default:
+		return http.StatusNotFound, fmt.Sprintf("%s %s is not supported", req.Method, req.URL.Path)
+	}
+}
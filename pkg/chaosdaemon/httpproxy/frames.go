@@ -0,0 +1,530 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	crand "crypto/rand"
+	"encoding/binary"
+	"io"
+	"math/rand"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	// maxFramePayload is the maximum payload of a WebSocket frame parsed by the proxy
+	maxFramePayload = 16 * 1024 * 1024
+
+	wsOpClose = 0x8
+	wsRsv1    = 0x40
+)
+
+// errDropped represents the upgraded connection or the event stream is dropped by
+// the frame actions
+var errDropped = errors.New("dropped by http chaos")
+
+// frameActions is the compiled tproxyconfig.PodHttpChaosFrameActions
+type frameActions struct {
+	// dropAfterFrames is zero if the connection isn't dropped after some frames
+	dropAfterFrames int64
+	// dropAfter is zero if the connection isn't dropped after some time
+	dropAfter time.Duration
+	delay     time.Duration
+	replace   []byte
+	corrupt   int
+	// closeCode is zero if no close frame is sent on dropping
+	closeCode int32
+}
+
+func compileFrames(in *tproxyconfig.PodHttpChaosFrameActions) (*frameActions, error) {
+	f := &frameActions{replace: in.Replace}
+	if in.DropAfterFrames != nil {
+		f.dropAfterFrames = *in.DropAfterFrames
+	}
+	if in.DropAfter != nil {
+		dropAfter, err := time.ParseDuration(*in.DropAfter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse drop after %s", *in.DropAfter)
+		}
+		f.dropAfter = dropAfter
+	}
+	if in.Delay != nil {
+		delay, err := time.ParseDuration(*in.Delay)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse delay %s", *in.Delay)
+		}
+		f.delay = delay
+	}
+	if in.Corrupt != nil {
+		f.corrupt = *in.Corrupt
+	}
+	if in.CloseCode != nil {
+		f.closeCode = *in.CloseCode
+	}
+	return f, nil
+}
+
+// apply wraps the connection upgraded to WebSocket, or the body of server-sent events.
+// The frames sent by the client are injected if fromClient, otherwise the frames sent
+// by the server are injected. The server-sent events have no frames from the client.
+func (f *frameActions) apply(resp *http.Response, fromClient bool) {
+	ctx := resp.Request.Context()
+	if isWebSocket(resp) {
+		if backend, ok := resp.Body.(io.ReadWriteCloser); ok {
+			resp.Body = newWebSocketConn(ctx, backend, f, fromClient)
+		}
+		return
+	}
+	if !fromClient && isEventStream(resp) {
+		resp.Body = newEventBody(ctx, resp.Body, f)
+	}
+}
+
+// transform returns the payload replaced or corrupted by the actions
+func (f *frameActions) transform(payload []byte) []byte {
+	if f.replace != nil {
+		return f.replace
+	}
+	if f.corrupt > 0 && len(payload) > 0 && rand.Intn(100) < f.corrupt {
+		corrupted := append([]byte(nil), payload...)
+		corrupted[rand.Intn(len(corrupted))] ^= 1 << rand.Intn(8)
+		return corrupted
+	}
+	return payload
+}
+
+// dropAt returns whether the connection should be dropped after the count of frames
+func (f *frameActions) dropAt(count int64) bool {
+	return f.dropAfterFrames > 0 && count >= f.dropAfterFrames
+}
+
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func isWebSocket(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusSwitchingProtocols && strings.EqualFold(resp.Header.Get("Upgrade"), "websocket")
+}
+
+func isEventStream(resp *http.Response) bool {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return err == nil && mediaType == "text/event-stream"
+}
+
+// wsFrame is a WebSocket frame with the unmasked payload, see RFC 6455 section 5.2
+type wsFrame struct {
+	// header is the first byte of frame, including FIN, RSV1-3 and opcode
+	header  byte
+	payload []byte
+}
+
+func (f *wsFrame) control() bool {
+	return f.header&0x08 != 0
+}
+
+func closeFrame(code int32) *wsFrame {
+	return &wsFrame{header: 0x80 | wsOpClose, payload: binary.BigEndian.AppendUint16(nil, uint16(code))}
+}
+
+// parseFrame parses the first frame of b, it returns a zero length if the frame is incomplete
+func parseFrame(b []byte) (*wsFrame, int, error) {
+	if len(b) < 2 {
+		return nil, 0, nil
+	}
+	masked := b[1]&0x80 != 0
+	length := uint64(b[1] & 0x7f)
+	offset := 2
+	switch length {
+	case 126:
+		if len(b) < 4 {
+			return nil, 0, nil
+		}
+		length = uint64(binary.BigEndian.Uint16(b[2:]))
+		offset = 4
+	case 127:
+		if len(b) < 10 {
+			return nil, 0, nil
+		}
+		length = binary.BigEndian.Uint64(b[2:])
+		offset = 10
+	}
+	if length > maxFramePayload {
+		return nil, 0, errors.Errorf("frame payload of %d bytes is too large", length)
+	}
+
+	var key []byte
+	if masked {
+		if len(b) < offset+4 {
+			return nil, 0, nil
+		}
+		key = b[offset : offset+4]
+		offset += 4
+	}
+	end := offset + int(length)
+	if len(b) < end {
+		return nil, 0, nil
+	}
+
+	payload := append([]byte(nil), b[offset:end]...)
+	if masked {
+		maskBytes(payload, key)
+	}
+	return &wsFrame{header: b[0], payload: payload}, end, nil
+}
+
+// encode returns the frame in the wire format, the frames sent to the server must be masked
+func (f *wsFrame) encode(masked bool) []byte {
+	b := []byte{f.header, 0}
+	switch length := len(f.payload); {
+	case length < 126:
+		b[1] = byte(length)
+	case length <= 0xffff:
+		b[1] = 126
+		b = binary.BigEndian.AppendUint16(b, uint16(length))
+	default:
+		b[1] = 127
+		b = binary.BigEndian.AppendUint64(b, uint64(length))
+	}
+	if !masked {
+		return append(b, f.payload...)
+	}
+
+	b[1] |= 0x80
+	key := make([]byte, 4)
+	crand.Read(key)
+	b = append(b, key...)
+	start := len(b)
+	b = append(b, f.payload...)
+	maskBytes(b[start:], key)
+	return b
+}
+
+func maskBytes(b, key []byte) {
+	for i := range b {
+		b[i] ^= key[i%4]
+	}
+}
+
+// webSocketConn is the upgraded connection to the server. The frames read from it
+// are sent to the client, and the frames written to it are sent by the client. Only
+// the complete frames are forwarded, so that the close frame can be sent on dropping.
+type webSocketConn struct {
+	backend io.ReadWriteCloser
+	ctx     context.Context
+	actions *frameActions
+	// fromClient represents the frames written by the client are injected, otherwise
+	// the frames read from the server are injected
+	fromClient bool
+	frames     int64
+
+	readBuf  []byte
+	pending  []byte
+	writeBuf []byte
+
+	// writeLock keeps the frames written to the server complete
+	writeLock sync.Mutex
+	dropOnce  sync.Once
+	dropped   atomic.Bool
+	closeSent bool
+	timer     *time.Timer
+}
+
+func newWebSocketConn(ctx context.Context, backend io.ReadWriteCloser, actions *frameActions, fromClient bool) *webSocketConn {
+	c := &webSocketConn{
+		backend:    backend,
+		ctx:        ctx,
+		actions:    actions,
+		fromClient: fromClient,
+	}
+	if actions.dropAfter > 0 {
+		c.timer = time.AfterFunc(actions.dropAfter, c.drop)
+	}
+	return c
+}
+
+// drop sends the close frame to the server and closes the connection to it, the close
+// frame is sent to the client by Read after that
+func (c *webSocketConn) drop() {
+	c.dropOnce.Do(func() {
+		c.writeLock.Lock()
+		c.dropped.Store(true)
+		if c.actions.closeCode != 0 {
+			c.backend.Write(closeFrame(c.actions.closeCode).encode(true))
+		}
+		c.writeLock.Unlock()
+		c.backend.Close()
+	})
+}
+
+func (c *webSocketConn) inject(frame *wsFrame) (*wsFrame, bool, error) {
+	if err := wait(c.ctx, c.actions.delay); err != nil {
+		return nil, false, err
+	}
+	header := frame.header
+	if c.actions.replace != nil {
+		// the replaced payload isn't compressed
+		header &^= wsRsv1
+	}
+	c.frames++
+	return &wsFrame{header: header, payload: c.actions.transform(frame.payload)}, c.actions.dropAt(c.frames), nil
+}
+
+func (c *webSocketConn) readFrame() (*wsFrame, error) {
+	buf := make([]byte, 32*1024)
+	for {
+		frame, n, err := parseFrame(c.readBuf)
+		if err != nil {
+			return nil, err
+		}
+		if n > 0 {
+			c.readBuf = c.readBuf[n:]
+			return frame, nil
+		}
+		m, err := c.backend.Read(buf)
+		c.readBuf = append(c.readBuf, buf[:m]...)
+		if err != nil && m == 0 {
+			return nil, err
+		}
+	}
+}
+
+func (c *webSocketConn) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		if c.dropped.Load() {
+			if c.closeSent || c.actions.closeCode == 0 {
+				return 0, errDropped
+			}
+			c.closeSent = true
+			c.pending = closeFrame(c.actions.closeCode).encode(false)
+			break
+		}
+
+		frame, err := c.readFrame()
+		if err != nil {
+			if c.dropped.Load() {
+				continue
+			}
+			return 0, err
+		}
+		drop := false
+		if !c.fromClient && !frame.control() {
+			if frame, drop, err = c.inject(frame); err != nil {
+				return 0, err
+			}
+		}
+		c.pending = frame.encode(false)
+		if drop {
+			c.drop()
+		}
+	}
+
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *webSocketConn) Write(p []byte) (int, error) {
+	c.writeBuf = append(c.writeBuf, p...)
+	for {
+		frame, n, err := parseFrame(c.writeBuf)
+		if err != nil {
+			return 0, err
+		}
+		if n == 0 {
+			return len(p), nil
+		}
+		c.writeBuf = c.writeBuf[n:]
+
+		drop := false
+		if c.fromClient && !frame.control() {
+			if frame, drop, err = c.inject(frame); err != nil {
+				return 0, err
+			}
+		}
+		if err := c.writeFrame(frame); err != nil {
+			return 0, err
+		}
+		if drop {
+			c.drop()
+		}
+	}
+}
+
+// writeFrame sends the frame to the server, the frames are discarded after dropping
+func (c *webSocketConn) writeFrame(frame *wsFrame) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if c.dropped.Load() {
+		return nil
+	}
+	_, err := c.backend.Write(frame.encode(true))
+	return err
+}
+
+func (c *webSocketConn) Close() error {
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	return c.backend.Close()
+}
+
+// eventBody is the body of server-sent events, every event with data is a frame and
+// its data is injected. The response is aborted on dropping.
+type eventBody struct {
+	io.ReadCloser
+
+	reader  *bufio.Reader
+	ctx     context.Context
+	actions *frameActions
+	frames  int64
+
+	pending []byte
+	// err is returned after the pending bytes are read
+	err     error
+	dropped atomic.Bool
+	timer   *time.Timer
+}
+
+func newEventBody(ctx context.Context, body io.ReadCloser, actions *frameActions) *eventBody {
+	b := &eventBody{
+		ReadCloser: body,
+		reader:     bufio.NewReader(body),
+		ctx:        ctx,
+		actions:    actions,
+	}
+	if actions.dropAfter > 0 {
+		b.timer = time.AfterFunc(actions.dropAfter, b.drop)
+	}
+	return b
+}
+
+func (b *eventBody) drop() {
+	b.dropped.Store(true)
+	b.ReadCloser.Close()
+}
+
+func (b *eventBody) Read(p []byte) (int, error) {
+	for len(b.pending) == 0 {
+		if b.dropped.Load() {
+			return 0, errDropped
+		}
+		if b.err != nil {
+			return 0, b.err
+		}
+
+		lines, err := b.readEvent()
+		if err != nil {
+			if b.dropped.Load() {
+				return 0, errDropped
+			}
+			b.err = err
+		}
+		if len(lines) > 0 {
+			event, drop, injectErr := b.inject(lines)
+			if injectErr != nil {
+				return 0, injectErr
+			}
+			b.pending = event
+			if drop {
+				b.drop()
+			}
+		}
+	}
+
+	n := copy(p, b.pending)
+	b.pending = b.pending[n:]
+	return n, nil
+}
+
+// readEvent reads the lines of an event, including the blank line at the end
+func (b *eventBody) readEvent() ([][]byte, error) {
+	var lines [][]byte
+	for {
+		line, err := b.reader.ReadBytes('\n')
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+		if err != nil {
+			return lines, err
+		}
+		if len(bytes.TrimRight(line, "\r\n")) == 0 {
+			return lines, nil
+		}
+	}
+}
+
+// inject changes the data of the event, the other fields are kept before the data
+func (b *eventBody) inject(lines [][]byte) ([]byte, bool, error) {
+	var fields, data [][]byte
+	var end []byte
+	for _, line := range lines {
+		content := bytes.TrimRight(line, "\r\n")
+		switch {
+		case len(content) == 0:
+			end = line
+		case bytes.Equal(content, []byte("data")):
+			data = append(data, nil)
+		case bytes.HasPrefix(content, []byte("data:")):
+			data = append(data, bytes.TrimPrefix(content[len("data:"):], []byte(" ")))
+		default:
+			fields = append(fields, line)
+		}
+	}
+	if len(data) == 0 {
+		return bytes.Join(lines, nil), false, nil
+	}
+
+	if err := wait(b.ctx, b.actions.delay); err != nil {
+		return nil, false, err
+	}
+	b.frames++
+
+	event := bytes.Join(fields, nil)
+	for _, value := range bytes.Split(b.actions.transform(bytes.Join(data, []byte("\n"))), []byte("\n")) {
+		event = append(event, "data: "...)
+		event = append(event, value...)
+		event = append(event, '\n')
+	}
+	return append(event, end...), b.actions.dropAt(b.frames), nil
+}
+
+func (b *eventBody) Close() error {
+	if b.timer != nil {
+		b.timer.Stop()
+	}
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,49 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestParseFrame(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, length := range []int{0, 125, 126, 0xffff, 0x10000} {
+		frame := &wsFrame{header: 0x82, payload: bytes.Repeat([]byte("x"), length)}
+		for _, masked := range []bool{false, true} {
+			encoded := frame.encode(masked)
+
+			// the incomplete frames are left for more bytes
+			parsed, n, err := parseFrame(encoded[:len(encoded)-1])
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(n).Should(Equal(0))
+			g.Expect(parsed).Should(BeNil())
+
+			parsed, n, err = parseFrame(append(encoded, 0x81))
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(n).Should(Equal(len(encoded)))
+			g.Expect(parsed.header).Should(Equal(frame.header))
+			g.Expect(parsed.payload).Should(HaveLen(length))
+		}
+	}
+
+	_, _, err := parseFrame([]byte{0x82, 127, 0, 0, 0, 0, 0x10, 0, 0, 0})
+	g.Expect(err).Should(HaveOccurred())
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"sync"
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// maxObservations is the maximum number of groups of requests observed by a rule
+const maxObservations = 100
+
+type observationKey struct {
+	path   string
+	method string
+	code   int32
+}
+
+// observer counts the requests by path, method and status code for the observe action
+type observer struct {
+	sync.Mutex
+
+	groups map[observationKey]*tproxyconfig.Observation
+	// order is the keys of groups in the order of their first requests
+	order []observationKey
+}
+
+func newObserver() *observer {
+	return &observer{groups: make(map[observationKey]*tproxyconfig.Observation)}
+}
+
+func (o *observer) observe(path, method string, code int, latency time.Duration) {
+	o.Lock()
+	defer o.Unlock()
+
+	key := observationKey{path: path, method: method, code: int32(code)}
+	group, ok := o.groups[key]
+	if !ok {
+		if len(o.groups) >= maxObservations {
+			return
+		}
+		group = &tproxyconfig.Observation{
+			Path:           path,
+			Method:         method,
+			Code:           int32(code),
+			LatencyBuckets: make([]uint64, len(tproxyconfig.ObservationLatencyBuckets)),
+		}
+		o.groups[key] = group
+		o.order = append(o.order, key)
+	}
+
+	seconds := latency.Seconds()
+	group.Count++
+	group.LatencySum += seconds
+	for i, bound := range tproxyconfig.ObservationLatencyBuckets {
+		if seconds <= bound {
+			group.LatencyBuckets[i]++
+		}
+	}
+}
+
+// observations returns a copy of the groups in the order of their first requests
+func (o *observer) observations() []tproxyconfig.Observation {
+	o.Lock()
+	defer o.Unlock()
+
+	observations := make([]tproxyconfig.Observation, 0, len(o.order))
+	for _, key := range o.order {
+		observation := *o.groups[key]
+		observation.LatencyBuckets = append([]uint64(nil), observation.LatencyBuckets...)
+		observations = append(observations, observation)
+	}
+	return observations
+}
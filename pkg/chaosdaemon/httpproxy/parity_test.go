@@ -0,0 +1,162 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// putConfig sends the config to the proxy in the same way as chaos daemon sent it to
+// tproxy, and returns the status code of response
+func putConfig(g *WithT, proxy *Proxy, config string) int {
+	var in bytes.Buffer
+	put, err := http.NewRequest(http.MethodPut, "/", strings.NewReader(config))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(put.Write(&in)).Should(Succeed())
+
+	var out bytes.Buffer
+	g.Expect(proxy.ServeConfig(&in, &out)).Should(Succeed())
+	resp, err := http.ReadResponse(bufio.NewReader(&out), put)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+// TestTproxyParity checks the proxy behaves as tproxy with the configs in the json
+// which chaos daemon sent to tproxy
+func TestTproxyParity(t *testing.T) {
+	cases := []struct {
+		name   string
+		rules  string
+		verify func(g *WithT, proxyURL string)
+	}{{
+		name:  "abort resets the connection without a response",
+		rules: `[{"target":"Request","selector":{"path":"/api/*","method":"GET"},"actions":{"abort":true}}]`,
+		verify: func(g *WithT, proxyURL string) {
+			_, err := http.Get(proxyURL + "/api/foo")
+			g.Expect(err).Should(HaveOccurred())
+			g.Expect(get(g, proxyURL+"/other").StatusCode).Should(Equal(http.StatusOK))
+		},
+	}, {
+		name:  "delay of request and response are summed",
+		rules: `[{"target":"Request","selector":{},"actions":{"delay":"100ms"}},{"target":"Response","selector":{"code":200},"actions":{"delay":"100ms"}}]`,
+		verify: func(g *WithT, proxyURL string) {
+			start := time.Now()
+			g.Expect(get(g, proxyURL+"/").StatusCode).Should(Equal(http.StatusOK))
+			g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+		},
+	}, {
+		name: "replace with the wrapped contents of body",
+		rules: `[{"target":"Request","selector":{"request_headers":{"X-Echo":"v1"}},"actions":{"replace":{"path":"/replaced","method":"PUT",` +
+			`"queries":{"foo":"unknown"},"headers":{"X-Echo":"replaced"},"body":{"contents":{"type":"TEXT","value":"request"}}}}},` +
+			`{"target":"Response","selector":{"response_headers":{"X-Echo":"replaced"}},"actions":{"replace":{"code":503}}}]`,
+		verify: func(g *WithT, proxyURL string) {
+			req, err := http.NewRequest(http.MethodPost, proxyURL+"/api?foo=bar", strings.NewReader("hello"))
+			g.Expect(err).ShouldNot(HaveOccurred())
+			req.Header.Set("X-Echo", "v1")
+			resp, err := http.DefaultClient.Do(req)
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(resp.StatusCode).Should(Equal(http.StatusServiceUnavailable))
+			g.Expect(resp.Header.Get("X-Method")).Should(Equal(http.MethodPut))
+			g.Expect(resp.Header.Get("X-Path")).Should(Equal("/replaced"))
+			g.Expect(resp.Header.Get("X-Query")).Should(Equal("foo=unknown"))
+			g.Expect(readBody(g, resp)).Should(Equal("request"))
+		},
+	}, {
+		name: "patch with the wrapped contents of body",
+		rules: `[{"target":"Request","selector":{},"actions":{"patch":{"queries":[["foo","unknown"]],"headers":[["X-Echo","patched"]],` +
+			`"body":{"contents":{"type":"JSON","value":"{\"foo\":\"patched\"}"}}}}},` +
+			`{"target":"Response","selector":{},"actions":{"patch":{"headers":[["Set-Cookie","chaos=true"]],` +
+			`"body":{"contents":{"type":"JSON","value":"{\"bar\":null}"}}}}}]`,
+		verify: func(g *WithT, proxyURL string) {
+			resp, err := http.Post(proxyURL+"/?foo=bar", "application/json", strings.NewReader(`{"foo":"bar","bar":1}`))
+			g.Expect(err).ShouldNot(HaveOccurred())
+			g.Expect(resp.Header.Get("X-Query")).Should(Equal("foo=bar&foo=unknown"))
+			g.Expect(resp.Header.Get("X-Echo")).Should(Equal("patched"))
+			g.Expect(resp.Header.Get("Set-Cookie")).Should(Equal("chaos=true"))
+			g.Expect(readBody(g, resp)).Should(MatchJSON(`{"foo":"patched"}`))
+		},
+	}, {
+		name: "all the selected rules are applied in order",
+		rules: `[{"target":"Response","selector":{},"actions":{"replace":{"code":500,"headers":{"X-First":"true"}}}},` +
+			`{"target":"Response","selector":{"code":200},"actions":{"replace":{"code":503}}}]`,
+		verify: func(g *WithT, proxyURL string) {
+			// the response is selected by the code before any rule is applied
+			resp := get(g, proxyURL+"/")
+			g.Expect(resp.StatusCode).Should(Equal(http.StatusServiceUnavailable))
+			g.Expect(resp.Header.Get("X-First")).Should(Equal("true"))
+		},
+	}}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			g := NewWithT(t)
+			proxy, proxyURL := startProxy(t, echoServer(t))
+
+			g.Expect(putConfig(g, proxy, `{"proxy_ports":[80],"rules":`+c.rules+`}`)).Should(Equal(http.StatusOK))
+			c.verify(g, proxyURL)
+		})
+	}
+}
+
+// TestTproxyParityConfig checks the bodies of rules are kept in the json sent to the
+// proxy by chaos daemon, which is marshaled from the config
+func TestTproxyParityConfig(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	var rules []tproxyconfig.PodHttpChaosBaseRule
+	// the rules of PodHttpChaos have the body of replace in base64, and the bare contents of patch
+	g.Expect(json.Unmarshal([]byte(`[{"target":"Response","selector":{"path":"/replace"},"actions":{"replace":{"body":"cmVwbGFjZWQ="}}},`+
+		`{"target":"Response","selector":{"path":"/patch"},"actions":{"patch":{"body":{"type":"JSON","value":"{\"bar\":null}"}}}}]`), &rules)).Should(Succeed())
+	config, err := json.Marshal(tproxyconfig.Config{Rules: rules})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	g.Expect(putConfig(g, proxy, string(config))).Should(Equal(http.StatusOK))
+	g.Expect(readBody(g, get(g, proxyURL+"/replace"))).Should(Equal("replaced"))
+	resp, err := http.Post(proxyURL+"/patch", "application/json", strings.NewReader(`{"foo":"bar","bar":1}`))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(readBody(g, resp)).Should(MatchJSON(`{"foo":"bar"}`))
+
+	// the invalid config is rejected, and the applied one is kept
+	g.Expect(putConfig(g, proxy, `{"rules":[{"target":"Unknown","selector":{},"actions":{"abort":true}}]}`)).Should(Equal(http.StatusBadRequest))
+	g.Expect(readBody(g, get(g, proxyURL+"/replace"))).Should(Equal("replaced"))
+}
+
+func TestRateLimitBurst(t *testing.T) {
+	g := NewWithT(t)
+
+	rateLimit := int32(1)
+	rule, err := compileRule(tproxyconfig.PodHttpChaosBaseRule{
+		Target:    targetRequest,
+		Actions:   tproxyconfig.PodHttpChaosActions{Abort: boolPtr(true)},
+		RateLimit: &rateLimit,
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rule.limiter.Burst()).Should(Equal(1))
+	g.Expect(rule.inject()).Should(BeTrue())
+	g.Expect(rule.inject()).Should(BeFalse())
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	exactPattern = "Exact"
+	globPattern  = "Glob"
+	regexPattern = "Regex"
+
+	// maxMatchedBody is the maximum size of request body to be matched, the
+	// larger bodies are forwarded without being selected
+	maxMatchedBody = 1 << 20
+)
+
+// pattern is the compiled tproxyconfig.PodHttpChaosPattern
+type pattern struct {
+	kind   string
+	value  string
+	regexp *regexp.Regexp
+}
+
+func compilePattern(in tproxyconfig.PodHttpChaosPattern) (*pattern, error) {
+	p := &pattern{kind: in.Type, value: in.Value}
+	switch in.Type {
+	case "":
+		p.kind = exactPattern
+	case exactPattern:
+	case globPattern:
+		if _, err := path.Match(in.Value, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid glob pattern %s", in.Value)
+		}
+	case regexPattern:
+		compiled, err := regexp.Compile(in.Value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid regex pattern %s", in.Value)
+		}
+		p.regexp = compiled
+	default:
+		return nil, errors.Errorf("unknown pattern type %s", in.Type)
+	}
+	return p, nil
+}
+
+func compilePatterns(in map[string]tproxyconfig.PodHttpChaosPattern) (map[string]*pattern, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	patterns := make(map[string]*pattern, len(in))
+	for key, value := range in {
+		compiled, err := compilePattern(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "compile pattern of %s", key)
+		}
+		patterns[key] = compiled
+	}
+	return patterns, nil
+}
+
+func (p *pattern) match(value string) bool {
+	switch p.kind {
+	case globPattern:
+		matched, _ := path.Match(p.value, value)
+		return matched
+	case regexPattern:
+		return p.regexp.MatchString(value)
+	default:
+		return p.value == value
+	}
+}
+
+// matchAny returns whether any of the values matches the pattern
+func (p *pattern) matchAny(values []string) bool {
+	for _, value := range values {
+		if p.match(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// bodySelector is the compiled tproxyconfig.PodHttpChaosBodySelector
+type bodySelector struct {
+	// jsonPath is the object keys (string) and array indexes (int) to locate the
+	// value in body, the whole body is matched if it's nil
+	jsonPath []interface{}
+	pattern  *pattern
+}
+
+// jsonPathRegexp matches the steps of json path, such as `.items` and `[0]`
+var jsonPathRegexp = regexp.MustCompile(`\.([A-Za-z0-9_-]+)|\[([0-9]+)\]`)
+
+func compileBodySelector(in tproxyconfig.PodHttpChaosBodySelector) (*bodySelector, error) {
+	compiled, err := compilePattern(in.Pattern)
+	if err != nil {
+		return nil, err
+	}
+	selector := &bodySelector{pattern: compiled}
+	if len(in.JSONPath) == 0 {
+		return selector, nil
+	}
+
+	if !strings.HasPrefix(in.JSONPath, "$") {
+		return nil, errors.Errorf("json path %s should start with $", in.JSONPath)
+	}
+	rest := in.JSONPath[1:]
+	selector.jsonPath = []interface{}{}
+	for len(rest) > 0 {
+		loc := jsonPathRegexp.FindStringSubmatchIndex(rest)
+		if loc == nil || loc[0] != 0 {
+			return nil, errors.Errorf("invalid json path %s", in.JSONPath)
+		}
+		if loc[2] >= 0 {
+			selector.jsonPath = append(selector.jsonPath, rest[loc[2]:loc[3]])
+		} else {
+			index, err := strconv.Atoi(rest[loc[4]:loc[5]])
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid json path %s", in.JSONPath)
+			}
+			selector.jsonPath = append(selector.jsonPath, index)
+		}
+		rest = rest[loc[1]:]
+	}
+	return selector, nil
+}
+
+// match returns whether the value located by json path in body matches the pattern,
+// the strings are matched without quotes while the other values are matched in json
+func (s *bodySelector) match(body []byte) bool {
+	if s.jsonPath == nil {
+		return s.pattern.match(string(body))
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return false
+	}
+
+	for _, step := range s.jsonPath {
+		switch step := step.(type) {
+		case string:
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return false
+			}
+			if value, ok = object[step]; !ok {
+				return false
+			}
+		case int:
+			array, ok := value.([]interface{})
+			if !ok || step >= len(array) {
+				return false
+			}
+			value = array[step]
+		}
+	}
+
+	if str, ok := value.(string); ok {
+		return s.pattern.match(str)
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return s.pattern.match(string(encoded))
+}
+
+// peekBody reads the request body to be matched, and keeps the body to be forwarded
+// as it is. It returns nil if the body is larger than maxMatchedBody.
+func peekBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return []byte{}, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxMatchedBody+1))
+	if err != nil {
+		return nil, errors.Wrap(err, "read request body")
+	}
+	if len(body) > maxMatchedBody {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+		return nil, nil
+	}
+
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
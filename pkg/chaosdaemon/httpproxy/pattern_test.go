@@ -0,0 +1,83 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+func TestPattern(t *testing.T) {
+	g := NewWithT(t)
+
+	cases := []struct {
+		pattern tproxyconfig.PodHttpChaosPattern
+		value   string
+		matched bool
+	}{
+		{tproxyconfig.PodHttpChaosPattern{Value: "/api"}, "/api", true},
+		{tproxyconfig.PodHttpChaosPattern{Type: exactPattern, Value: "/api"}, "/api/", false},
+		{tproxyconfig.PodHttpChaosPattern{Type: globPattern, Value: "/api/*/orders"}, "/api/v1/orders", true},
+		// the wildcard of glob doesn't match the separator
+		{tproxyconfig.PodHttpChaosPattern{Type: globPattern, Value: "/api/*"}, "/api/v1/orders", false},
+		{tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: "^/api/v[12]/"}, "/api/v2/orders", true},
+		{tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: "^/api/v[12]/"}, "/api/v3/orders", false},
+	}
+	for _, c := range cases {
+		p, err := compilePattern(c.pattern)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(p.match(c.value)).Should(Equal(c.matched), "%v %s", c.pattern, c.value)
+	}
+
+	_, err := compilePattern(tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: "("})
+	g.Expect(err).Should(HaveOccurred())
+	_, err = compilePattern(tproxyconfig.PodHttpChaosPattern{Type: "Prefix", Value: "/"})
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestBodySelector(t *testing.T) {
+	g := NewWithT(t)
+
+	body := []byte(`{"operationName":"GetOrder","items":[{"tenant":"foo","count":2}],"paid":true}`)
+	cases := []struct {
+		selector tproxyconfig.PodHttpChaosBodySelector
+		matched  bool
+	}{
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.operationName", Pattern: tproxyconfig.PodHttpChaosPattern{Value: "GetOrder"}}, true},
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.items[0].tenant", Pattern: tproxyconfig.PodHttpChaosPattern{Value: "foo"}}, true},
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.items[1].tenant", Pattern: tproxyconfig.PodHttpChaosPattern{Value: "foo"}}, false},
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.items[0].count", Pattern: tproxyconfig.PodHttpChaosPattern{Value: "2"}}, true},
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.paid", Pattern: tproxyconfig.PodHttpChaosPattern{Value: "true"}}, true},
+		{tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.missing", Pattern: tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: ".*"}}, false},
+		{tproxyconfig.PodHttpChaosBodySelector{Pattern: tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: `"tenant":"foo"`}}, true},
+	}
+	for _, c := range cases {
+		selector, err := compileBodySelector(c.selector)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(selector.match(body)).Should(Equal(c.matched), "%v", c.selector)
+	}
+
+	// the json path is matched on json bodies only
+	selector, err := compileBodySelector(tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$", Pattern: tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: ".*"}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(selector.match([]byte("plain text"))).Should(BeFalse())
+
+	_, err = compileBodySelector(tproxyconfig.PodHttpChaosBodySelector{JSONPath: "$.items[a]"})
+	g.Expect(err).Should(HaveOccurred())
+}
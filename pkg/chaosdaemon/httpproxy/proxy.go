@@ -0,0 +1,470 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	// the first byte of a TLS connection, which is the content type of handshake record
+	tlsRecordHandshake = 0x16
+
+	handshakeTimeout = 10 * time.Second
+)
+
+// errAbort represents the response is aborted by a rule
+var errAbort = errors.New("aborted by http chaos")
+
+// Proxy is a transparent HTTP/1.1 proxy of the connections redirected by iptables. It
+// injects faults into the requests and responses selected by the rules, and forwards
+// the requests to the original destination of the connection.
+type Proxy struct {
+	// target returns the address of the upstream server of an accepted connection
+	target func(conn net.Conn) (string, error)
+
+	// control is called on the sockets connecting the upstream servers
+	control func(network, address string, c syscall.RawConn) error
+
+	// redirect updates the redirect rules of iptables with a new config, it's called
+	// before the config is applied
+	redirect func(config *tproxyconfig.Config) error
+
+	sync.RWMutex
+	config *config
+}
+
+// config is the compiled tproxyconfig.Config
+type config struct {
+	rules []*rule
+	// matchBody represents some rules select the requests by body
+	matchBody bool
+
+	// serverTLS terminates the TLS connections if it's not nil
+	serverTLS *tls.Config
+	// clientTLS is used to connect the upstream server of TLS connections
+	clientTLS *tls.Config
+}
+
+// New creates a proxy, the target returns the address of the upstream server of
+// an accepted connection, which is usually grpcproxy.OriginalDestination. The control
+// is called on the sockets connecting the upstream servers before connecting, which
+// is usually dnsproxy.MarkUpstream to exclude them from the redirection of outbound
+// connections. The control and redirect could be nil if the connections aren't
+// redirected by the proxy itself.
+func New(target func(conn net.Conn) (string, error), control func(network, address string, c syscall.RawConn) error, redirect func(config *tproxyconfig.Config) error) *Proxy {
+	return &Proxy{
+		target:   target,
+		control:  control,
+		redirect: redirect,
+		config:   &config{},
+	}
+}
+
+// SetConfig replaces the config of proxy, it's applied to the new requests and
+// the counters of rules are reset.
+func (p *Proxy) SetConfig(in tproxyconfig.Config) error {
+	parsed := &config{}
+	for i, r := range in.Rules {
+		compiled, err := compileRule(r)
+		if err != nil {
+			return errors.Wrapf(err, "compile rule %d", i)
+		}
+		parsed.rules = append(parsed.rules, compiled)
+		parsed.matchBody = parsed.matchBody || compiled.body != nil
+	}
+
+	if in.TLS != nil {
+		var err error
+		parsed.serverTLS, parsed.clientTLS, err = compileTLS(in.TLS)
+		if err != nil {
+			return err
+		}
+	}
+
+	if p.redirect != nil {
+		if err := p.redirect(&in); err != nil {
+			return errors.Wrap(err, "redirect")
+		}
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.config = parsed
+	return nil
+}
+
+// Stats returns the counters of rules since the config is applied.
+func (p *Proxy) Stats() tproxyconfig.Stats {
+	config := p.current()
+
+	stats := tproxyconfig.Stats{Rules: make([]tproxyconfig.RuleStats, 0, len(config.rules))}
+	for _, r := range config.rules {
+		ruleStats := tproxyconfig.RuleStats{
+			Matched: r.matched.Load(),
+			Faulted: r.faulted.Load(),
+		}
+		if r.observer != nil {
+			ruleStats.Observations = r.observer.observations()
+		}
+		stats.Rules = append(stats.Rules, ruleStats)
+	}
+	return stats
+}
+
+func (p *Proxy) current() *config {
+	p.RLock()
+	defer p.RUnlock()
+	return p.config
+}
+
+// Serve accepts the inbound connections to the pod on the listener, and serves them
+// until the listener is closed.
+func (p *Proxy) Serve(l net.Listener) error {
+	return p.serve(l, false)
+}
+
+// ServeEgress accepts the outbound connections of the pod on the listener, which are
+// selected by the egress rules only, and serves them until the listener is closed.
+func (p *Proxy) ServeEgress(l net.Listener) error {
+	return p.serve(l, true)
+}
+
+func (p *Proxy) serve(l net.Listener, egress bool) error {
+	conns := &connListener{
+		addr:   l.Addr(),
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+	defer conns.Close()
+
+	server := &http.Server{
+		Handler: p,
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			return context.WithValue(ctx, connKey{}, c.(*conn))
+		},
+		ConnState: func(c net.Conn, state http.ConnState) {
+			if state == http.StateClosed || state == http.StateHijacked {
+				c.(*conn).transport.CloseIdleConnections()
+			}
+		},
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+	go server.Serve(conns)
+
+	for {
+		c, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			prepared, err := p.prepare(c, egress)
+			if err != nil {
+				c.Close()
+				return
+			}
+			if prepared != nil {
+				conns.push(prepared)
+			}
+		}()
+	}
+}
+
+// prepare finds the upstream server of the connection, and terminates TLS if the
+// connection starts with a TLS handshake while the certificate is configured. The
+// TLS connections are tunneled to the upstream server as they are if there is no
+// certificate, and nil is returned after the tunnel is closed.
+func (p *Proxy) prepare(raw net.Conn, egress bool) (*conn, error) {
+	target, err := p.target(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := bufio.NewReader(raw)
+	first, err := reader.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	peeked := &peekedConn{Conn: raw, reader: reader}
+
+	c := &conn{
+		Conn:   peeked,
+		target: target,
+		egress: egress,
+		scheme: "http",
+		transport: &http.Transport{
+			DialContext: p.dialer().DialContext,
+			// the bodies are forwarded as they are
+			DisableCompression: true,
+		},
+	}
+	if first[0] != tlsRecordHandshake {
+		return c, nil
+	}
+
+	config := p.current()
+	if config.serverTLS == nil {
+		p.tunnel(peeked, target)
+		return nil, nil
+	}
+
+	tlsConn := tls.Server(peeked, config.serverTLS)
+	ctx, cancel := context.WithTimeout(context.Background(), handshakeTimeout)
+	defer cancel()
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		return nil, errors.Wrap(err, "tls handshake")
+	}
+
+	clientTLS := config.clientTLS.Clone()
+	clientTLS.ServerName = tlsConn.ConnectionState().ServerName
+	c.Conn = tlsConn
+	c.scheme = "https"
+	c.transport.TLSClientConfig = clientTLS
+	return c, nil
+}
+
+// tunnel forwards the connection to the upstream server as it is, until either
+// side is closed
+func (p *Proxy) tunnel(c net.Conn, target string) {
+	defer c.Close()
+
+	upstream, err := p.dialer().Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(upstream, c)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(c, upstream)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+func (p *Proxy) dialer() *net.Dialer {
+	return &net.Dialer{Control: p.control}
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c := r.Context().Value(connKey{}).(*conn)
+	config := p.current()
+
+	// the requests are observed as they are received
+	start := time.Now()
+	path, method := r.URL.Path, r.Method
+	var observers []*observer
+	observe := func(code int, latency time.Duration) {
+		for _, o := range observers {
+			o.observe(path, method, code, latency)
+		}
+	}
+
+	var body []byte
+	if config.matchBody {
+		var err error
+		if body, err = peekBody(r); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+	}
+
+	// all the rules select the request as it's received
+	var requestRules, responseRules []*rule
+	for _, rule := range config.rules {
+		if !rule.selectRequest(c, r, body) {
+			continue
+		}
+		if rule.target == targetResponse {
+			responseRules = append(responseRules, rule)
+		} else {
+			requestRules = append(requestRules, rule)
+		}
+	}
+
+	// the frames sent by the client are injected after the connection is upgraded
+	var clientFrames []*frameActions
+	for _, rule := range requestRules {
+		rule.matched.Add(1)
+		if !rule.inject() {
+			continue
+		}
+		if rule.observer != nil {
+			observers = append(observers, rule.observer)
+		}
+		if rule.frames != nil {
+			clientFrames = append(clientFrames, rule.frames)
+		}
+		if err := rule.applyRequest(r); err != nil {
+			// resets the connection without a response
+			panic(http.ErrAbortHandler)
+		}
+	}
+
+	var proxy *httputil.ReverseProxy
+	proxy = &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			// the host header is kept, and the request is sent to the original destination
+			pr.Out.URL.Scheme = c.scheme
+			pr.Out.URL.Host = c.target
+		},
+		Transport: c.transport,
+		ModifyResponse: func(resp *http.Response) error {
+			// all the rules select the response as it's received
+			var injected []*rule
+			for _, rule := range responseRules {
+				if !rule.selectResponse(resp) {
+					continue
+				}
+				rule.matched.Add(1)
+				if !rule.inject() {
+					continue
+				}
+				if rule.observer != nil {
+					observers = append(observers, rule.observer)
+				}
+				injected = append(injected, rule)
+			}
+			// the latency is measured before the response actions, but the code is the replaced one
+			latency := time.Since(start)
+			for _, rule := range injected {
+				if err := rule.applyResponse(resp); err != nil {
+					return err
+				}
+				if rule.stream != nil {
+					// the body is flushed on every write to be sent at the rate
+					proxy.FlushInterval = -1
+				}
+			}
+			for _, frames := range clientFrames {
+				frames.apply(resp, true)
+			}
+			observe(resp.StatusCode, latency)
+			observers = nil
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			if errors.Is(err, errAbort) {
+				panic(http.ErrAbortHandler)
+			}
+			// the upstream server is unreachable, or the response actions fail
+			observe(http.StatusBadGateway, time.Since(start))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+		ErrorLog: log.New(io.Discard, "", 0),
+	}
+	proxy.ServeHTTP(w, r)
+}
+
+type connKey struct{}
+
+// conn is an accepted connection with its upstream server
+type conn struct {
+	net.Conn
+
+	target string
+	// egress represents it's an outbound connection of the pod
+	egress    bool
+	scheme    string
+	transport *http.Transport
+}
+
+// ip returns the ip of upstream server
+func (c *conn) ip() net.IP {
+	host, _, err := net.SplitHostPort(c.target)
+	if err != nil {
+		return nil
+	}
+	return net.ParseIP(host)
+}
+
+// port returns the port of upstream server, or 0 if it's unknown
+func (c *conn) port() int32 {
+	_, port, err := net.SplitHostPort(c.target)
+	if err != nil {
+		return 0
+	}
+	value, err := strconv.Atoi(port)
+	if err != nil {
+		return 0
+	}
+	return int32(value)
+}
+
+// peekedConn reads the bytes peeked while sniffing the protocol before the rest of connection
+type peekedConn struct {
+	net.Conn
+
+	reader *bufio.Reader
+}
+
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// connListener hands the prepared connections over to the http server
+type connListener struct {
+	addr   net.Addr
+	conns  chan net.Conn
+	closed chan struct{}
+	once   sync.Once
+}
+
+func (l *connListener) push(c net.Conn) {
+	select {
+	case l.conns <- c:
+	case <-l.closed:
+		c.Close()
+	}
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.once.Do(func() {
+		close(l.closed)
+	})
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.addr
+}
@@ -0,0 +1,676 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// startProxy starts a proxy in front of the upstream server, and returns the url of proxy
+func startProxy(t *testing.T, upstream *httptest.Server) (*Proxy, string) {
+	g := NewWithT(t)
+
+	upstreamURL, err := url.Parse(upstream.URL)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	proxy := New(func(net.Conn) (string, error) {
+		return upstreamURL.Host, nil
+	}, nil, nil)
+	go proxy.Serve(proxyListener)
+	t.Cleanup(func() { proxyListener.Close() })
+
+	return proxy, "http://" + proxyListener.Addr().String()
+}
+
+// echoServer responds the method, path, queries and the header X-Echo of request
+// in the headers, and the request body in the body
+func echoServer(t *testing.T) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Path", r.URL.Path)
+		w.Header().Set("X-Query", r.URL.RawQuery)
+		w.Header().Set("X-Echo", r.Header.Get("X-Echo"))
+		io.Copy(w, r.Body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func get(g *WithT, url string) *http.Response {
+	resp, err := http.Get(url)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return resp
+}
+
+func readBody(g *WithT, resp *http.Response) string {
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	return string(body)
+}
+
+func TestProxyForward(t *testing.T) {
+	g := NewWithT(t)
+	_, proxyURL := startProxy(t, echoServer(t))
+
+	resp, err := http.Post(proxyURL+"/api?foo=bar", "text/plain", strings.NewReader("hello"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(resp.Header.Get("X-Method")).Should(Equal(http.MethodPost))
+	g.Expect(resp.Header.Get("X-Path")).Should(Equal("/api"))
+	g.Expect(resp.Header.Get("X-Query")).Should(Equal("foo=bar"))
+	g.Expect(readBody(g, resp)).Should(Equal("hello"))
+}
+
+func TestProxyAbort(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetRequest,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/api/*"), Method: stringPtr("GET")},
+		Actions:  tproxyconfig.PodHttpChaosActions{Abort: boolPtr(true)},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{RequestHeaders: map[string]string{"X-Echo": "abort"}},
+		Actions:  tproxyconfig.PodHttpChaosActions{Abort: boolPtr(true)},
+	}}})).Should(Succeed())
+
+	_, err := http.Get(proxyURL + "/api/foo")
+	g.Expect(err).Should(HaveOccurred())
+
+	// the other paths and methods are not selected
+	g.Expect(get(g, proxyURL+"/api/foo/bar").StatusCode).Should(Equal(http.StatusOK))
+	resp, err := http.Post(proxyURL+"/api/foo", "text/plain", nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+
+	req, err := http.NewRequest(http.MethodGet, proxyURL+"/", nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	req.Header.Set("X-Echo", "abort")
+	_, err = http.DefaultClient.Do(req)
+	g.Expect(err).Should(HaveOccurred())
+}
+
+func TestProxyDelay(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Code: int32Ptr(http.StatusOK)},
+		Actions:  tproxyconfig.PodHttpChaosActions{Delay: stringPtr("200ms")},
+	}}})).Should(Succeed())
+
+	start := time.Now()
+	g.Expect(get(g, proxyURL+"/").StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+}
+
+func TestProxyReplace(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target: targetRequest,
+		Actions: tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{
+			Path:    stringPtr("/replaced"),
+			Method:  stringPtr(http.MethodPut),
+			Queries: map[string]string{"foo": "unknown"},
+			Headers: map[string]string{"X-Echo": "replaced"},
+			Body:    &tproxyconfig.PodHttpChaosReplaceBody{Contents: tproxyconfig.PodHttpChaosBodyReplaceContent{Type: "TEXT", Value: "request"}},
+		}},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{ResponseHeaders: map[string]string{"X-Echo": "replaced"}},
+		Actions: tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{
+			Code:    int32Ptr(http.StatusServiceUnavailable),
+			Headers: map[string]string{"X-Path": "/response"},
+			Body:    &tproxyconfig.PodHttpChaosReplaceBody{Contents: tproxyconfig.PodHttpChaosBodyReplaceContent{Type: "TEXT", Value: "response"}},
+		}},
+	}}})).Should(Succeed())
+
+	resp, err := http.Post(proxyURL+"/api?foo=bar", "text/plain", strings.NewReader("hello"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusServiceUnavailable))
+	g.Expect(resp.Header.Get("X-Method")).Should(Equal(http.MethodPut))
+	g.Expect(resp.Header.Get("X-Path")).Should(Equal("/response"))
+	g.Expect(resp.Header.Get("X-Query")).Should(Equal("foo=unknown"))
+	g.Expect(readBody(g, resp)).Should(Equal("response"))
+}
+
+func TestProxyPatch(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target: targetRequest,
+		Actions: tproxyconfig.PodHttpChaosActions{Patch: &tproxyconfig.PodHttpChaosPatchActions{
+			Queries: [][]string{{"foo", "unknown"}},
+			Headers: [][]string{{"X-Echo", "patched"}},
+			Body:    &tproxyconfig.PodHttpChaosPatchBody{Contents: tproxyconfig.PodHttpChaosBodyPatchContent{Type: "JSON", Value: `{"foo":"patched"}`}},
+		}},
+	}, {
+		Target: targetResponse,
+		Actions: tproxyconfig.PodHttpChaosActions{Patch: &tproxyconfig.PodHttpChaosPatchActions{
+			Headers: [][]string{{"Set-Cookie", "chaos=true"}},
+			Body:    &tproxyconfig.PodHttpChaosPatchBody{Contents: tproxyconfig.PodHttpChaosBodyPatchContent{Type: "JSON", Value: `{"bar":null}`}},
+		}},
+	}}})).Should(Succeed())
+
+	resp, err := http.Post(proxyURL+"/?foo=bar", "application/json", strings.NewReader(`{"foo":"bar","bar":1}`))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.Header.Get("X-Query")).Should(Equal("foo=bar&foo=unknown"))
+	g.Expect(resp.Header.Get("X-Echo")).Should(Equal("patched"))
+	g.Expect(resp.Header.Get("Set-Cookie")).Should(Equal("chaos=true"))
+	g.Expect(readBody(g, resp)).Should(MatchJSON(`{"foo":"patched"}`))
+}
+
+func TestProxyPatterns(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target: targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{
+			PathPattern:           &tproxyconfig.PodHttpChaosPattern{Type: regexPattern, Value: "^/graphql"},
+			Queries:               map[string]tproxyconfig.PodHttpChaosPattern{"tenant": {Type: globPattern, Value: "foo-*"}},
+			RequestHeaderPatterns: map[string]tproxyconfig.PodHttpChaosPattern{"X-Echo": {Type: regexPattern, Value: "^v[12]$"}},
+			RequestBody: &tproxyconfig.PodHttpChaosBodySelector{
+				JSONPath: "$.operationName",
+				Pattern:  tproxyconfig.PodHttpChaosPattern{Value: "GetOrder"},
+			},
+		},
+		Actions: tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{
+			Code: int32Ptr(http.StatusInternalServerError),
+		}},
+	}}})).Should(Succeed())
+
+	post := func(path, echo, body string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, proxyURL+path, strings.NewReader(body))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		req.Header.Set("X-Echo", echo)
+		resp, err := http.DefaultClient.Do(req)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		return resp
+	}
+
+	resp := post("/graphql?tenant=foo-1", "v1", `{"operationName":"GetOrder"}`)
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusInternalServerError))
+	// the body selected by the rule is still forwarded
+	g.Expect(readBody(g, resp)).Should(Equal(`{"operationName":"GetOrder"}`))
+
+	g.Expect(post("/graphql?tenant=bar-1", "v1", `{"operationName":"GetOrder"}`).StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(post("/graphql?tenant=foo-1", "v3", `{"operationName":"GetOrder"}`).StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(post("/graphql?tenant=foo-1", "v2", `{"operationName":"ListOrders"}`).StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(post("/rest?tenant=foo-1", "v2", `{"operationName":"GetOrder"}`).StatusCode).Should(Equal(http.StatusOK))
+}
+
+func TestProxyStream(t *testing.T) {
+	g := NewWithT(t)
+
+	body := strings.Repeat("x", 1000)
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(upstream.Close)
+	proxy, proxyURL := startProxy(t, upstream)
+
+	int64Ptr := func(i int64) *int64 {
+		return &i
+	}
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/slow")},
+		Actions: tproxyconfig.PodHttpChaosActions{Stream: &tproxyconfig.PodHttpChaosStreamActions{
+			Rate: int64Ptr(2000),
+			Ttfb: stringPtr("200ms"),
+		}},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/truncated")},
+		Actions: tproxyconfig.PodHttpChaosActions{Stream: &tproxyconfig.PodHttpChaosStreamActions{
+			Truncate: int64Ptr(100),
+		}},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/whole")},
+		Actions: tproxyconfig.PodHttpChaosActions{Stream: &tproxyconfig.PodHttpChaosStreamActions{
+			Truncate: int64Ptr(1000),
+		}},
+	}}})).Should(Succeed())
+
+	start := time.Now()
+	resp := get(g, proxyURL+"/slow")
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+	g.Expect(readBody(g, resp)).Should(Equal(body))
+	// 1000 bytes are sent at 2000 bytes per second after the first byte
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 650*time.Millisecond))
+
+	resp = get(g, proxyURL+"/truncated")
+	received, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	g.Expect(err).Should(HaveOccurred())
+	g.Expect(received).Should(HaveLen(100))
+
+	// the body isn't cut if it ends with the truncated bytes
+	g.Expect(readBody(g, get(g, proxyURL+"/whole"))).Should(Equal(body))
+}
+
+// webSocketServer echoes the messages of WebSocket
+func webSocketServer(t *testing.T) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			messageType, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(messageType, message); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestProxyWebSocket(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, webSocketServer(t))
+	wsURL := "ws" + strings.TrimPrefix(proxyURL, "http")
+
+	int64Ptr := func(i int64) *int64 {
+		return &i
+	}
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/replaced")},
+		Actions: tproxyconfig.PodHttpChaosActions{Frames: &tproxyconfig.PodHttpChaosFrameActions{
+			Replace:         []byte("replaced"),
+			DropAfterFrames: int64Ptr(2),
+			CloseCode:       int32Ptr(websocket.CloseInternalServerErr),
+		}},
+	}, {
+		Target:   targetRequest,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/corrupted")},
+		Actions: tproxyconfig.PodHttpChaosActions{Frames: &tproxyconfig.PodHttpChaosFrameActions{
+			Corrupt:   intPtr(100),
+			Delay:     stringPtr("50ms"),
+			DropAfter: stringPtr("500ms"),
+			CloseCode: int32Ptr(websocket.CloseGoingAway),
+		}},
+	}}})).Should(Succeed())
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL+"/replaced", nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Close()
+	for i := 0; i < 2; i++ {
+		g.Expect(conn.WriteMessage(websocket.TextMessage, []byte("hello"))).Should(Succeed())
+		_, message, err := conn.ReadMessage()
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(string(message)).Should(Equal("replaced"))
+	}
+	_, _, err = conn.ReadMessage()
+	g.Expect(websocket.IsCloseError(err, websocket.CloseInternalServerErr)).Should(BeTrue(), "%v", err)
+
+	conn, _, err = websocket.DefaultDialer.Dial(wsURL+"/corrupted", nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Close()
+	start := time.Now()
+	g.Expect(conn.WriteMessage(websocket.BinaryMessage, []byte("hello"))).Should(Succeed())
+	_, message, err := conn.ReadMessage()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 50*time.Millisecond))
+	g.Expect(message).Should(HaveLen(5))
+	g.Expect(string(message)).ShouldNot(Equal("hello"))
+	_, _, err = conn.ReadMessage()
+	g.Expect(websocket.IsCloseError(err, websocket.CloseGoingAway)).Should(BeTrue(), "%v", err)
+
+	stats := proxy.Stats()
+	g.Expect(stats.Rules[0].Faulted).Should(Equal(int64(1)))
+	g.Expect(stats.Rules[1].Faulted).Should(Equal(int64(1)))
+}
+
+func TestProxyEventStream(t *testing.T) {
+	g := NewWithT(t)
+
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for i := 0; i < 3; i++ {
+			fmt.Fprintf(w, ": comment\nevent: count\ndata: %d\n\n", i)
+			w.(http.Flusher).Flush()
+		}
+	}))
+	t.Cleanup(upstream.Close)
+	proxy, proxyURL := startProxy(t, upstream)
+
+	int64Ptr := func(i int64) *int64 {
+		return &i
+	}
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/dropped")},
+		Actions: tproxyconfig.PodHttpChaosActions{Frames: &tproxyconfig.PodHttpChaosFrameActions{
+			Replace:         []byte("first\nsecond"),
+			DropAfterFrames: int64Ptr(2),
+		}},
+	}}})).Should(Succeed())
+
+	resp := get(g, proxyURL+"/dropped")
+	received, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	g.Expect(err).Should(HaveOccurred())
+	event := ": comment\nevent: count\ndata: first\ndata: second\n\n"
+	g.Expect(string(received)).Should(Equal(event + event))
+
+	// the events of other paths are kept
+	g.Expect(readBody(g, get(g, proxyURL+"/kept"))).Should(Equal(
+		": comment\nevent: count\ndata: 0\n\n: comment\nevent: count\ndata: 1\n\n: comment\nevent: count\ndata: 2\n\n"))
+}
+
+func TestProxyPercentAndRateLimit(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	replaceCode := tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{
+		Code: int32Ptr(http.StatusInternalServerError),
+	}}
+	rateLimit := int32(1)
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/never")},
+		Actions:  replaceCode,
+		Percent:  intPtr(0),
+	}, {
+		Target:    targetResponse,
+		Selector:  tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/limited")},
+		Actions:   replaceCode,
+		RateLimit: &rateLimit,
+	}}})).Should(Succeed())
+
+	faulted := 0
+	for i := 0; i < 5; i++ {
+		g.Expect(get(g, proxyURL+"/never").StatusCode).Should(Equal(http.StatusOK))
+		if get(g, proxyURL+"/limited").StatusCode == http.StatusInternalServerError {
+			faulted++
+		}
+	}
+	g.Expect(faulted).Should(BeNumerically(">=", 1))
+	g.Expect(faulted).Should(BeNumerically("<", 5))
+
+	stats := proxy.Stats()
+	g.Expect(stats.Rules).Should(HaveLen(2))
+	g.Expect(stats.Rules[0]).Should(Equal(tproxyconfig.RuleStats{Matched: 5, Faulted: 0}))
+	g.Expect(stats.Rules[1]).Should(Equal(tproxyconfig.RuleStats{Matched: 5, Faulted: int64(faulted)}))
+}
+
+func TestProxyObserve(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:   targetRequest,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/observed/*")},
+		Actions:  tproxyconfig.PodHttpChaosActions{Observe: boolPtr(true)},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/observed/failed")},
+		Actions: tproxyconfig.PodHttpChaosActions{
+			Replace: &tproxyconfig.PodHttpChaosReplaceActions{Code: int32Ptr(http.StatusInternalServerError)},
+		},
+	}}})).Should(Succeed())
+
+	for i := 0; i < 3; i++ {
+		resp := get(g, proxyURL+"/observed/ok")
+		g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+		g.Expect(resp.Header.Get("X-Path")).Should(Equal("/observed/ok"))
+	}
+	g.Expect(get(g, proxyURL+"/observed/failed").StatusCode).Should(Equal(http.StatusInternalServerError))
+	g.Expect(get(g, proxyURL+"/other").StatusCode).Should(Equal(http.StatusOK))
+
+	stats := proxy.Stats()
+	g.Expect(stats.Rules[0].Matched).Should(Equal(int64(4)))
+	observations := stats.Rules[0].Observations
+	g.Expect(observations).Should(HaveLen(2))
+	g.Expect(observations[0].Path).Should(Equal("/observed/ok"))
+	g.Expect(observations[0].Method).Should(Equal(http.MethodGet))
+	g.Expect(observations[0].Code).Should(Equal(int32(http.StatusOK)))
+	g.Expect(observations[0].Count).Should(Equal(int64(3)))
+	// the buckets are cumulative, and all the requests are faster than the last bound
+	last := len(tproxyconfig.ObservationLatencyBuckets) - 1
+	g.Expect(observations[0].LatencyBuckets[last]).Should(Equal(uint64(3)))
+	for i := 1; i <= last; i++ {
+		g.Expect(observations[0].LatencyBuckets[i]).Should(BeNumerically(">=", observations[0].LatencyBuckets[i-1]))
+	}
+	g.Expect(observations[1].Path).Should(Equal("/observed/failed"))
+	g.Expect(observations[1].Code).Should(Equal(int32(http.StatusInternalServerError)))
+	g.Expect(observations[1].Count).Should(Equal(int64(1)))
+}
+
+func TestProxyTLS(t *testing.T) {
+	g := NewWithT(t)
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s", r.Host, r.URL.Path)
+	}))
+	t.Cleanup(upstream.Close)
+	proxy, proxyURL := startProxy(t, upstream)
+
+	cert, key := generateCert(g, "example.com")
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{
+		Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+			Target:  targetRequest,
+			Actions: tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{Path: stringPtr("/replaced")}},
+		}},
+		TLS: &tproxyconfig.TLSConfig{
+			CertFile: tproxyconfig.TLSConfigItem{Type: tlsContents, Value: cert},
+			KeyFile:  tproxyconfig.TLSConfigItem{Type: tlsContents, Value: key},
+		},
+	})).Should(Succeed())
+
+	pool := x509.NewCertPool()
+	g.Expect(pool.AppendCertsFromPEM(cert)).Should(BeTrue())
+	client := &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{RootCAs: pool, ServerName: "example.com"},
+	}}
+	resp, err := client.Get(strings.Replace(proxyURL, "http://", "https://", 1) + "/")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(readBody(g, resp)).Should(MatchRegexp(`^127\.0\.0\.1:\d+ /replaced$`))
+
+	// the plaintext connections are forwarded in plaintext
+	resp = get(g, proxyURL+"/")
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusBadRequest))
+	resp.Body.Close()
+}
+
+func TestProxyTunnel(t *testing.T) {
+	g := NewWithT(t)
+
+	upstream := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "tunneled")
+	}))
+	t.Cleanup(upstream.Close)
+	proxy, proxyURL := startProxy(t, upstream)
+
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:  targetRequest,
+		Actions: tproxyconfig.PodHttpChaosActions{Abort: boolPtr(true)},
+	}}})).Should(Succeed())
+
+	// the tls connections are forwarded as they are without the certificate
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+	resp, err := client.Get(strings.Replace(proxyURL, "http://", "https://", 1) + "/")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(readBody(g, resp)).Should(Equal("tunneled"))
+}
+
+func TestProxyEgress(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	egressListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	go proxy.ServeEgress(egressListener)
+	t.Cleanup(func() { egressListener.Close() })
+	egressURL := "http://" + egressListener.Addr().String()
+
+	replaceCode := func(code int32) tproxyconfig.PodHttpChaosActions {
+		return tproxyconfig.PodHttpChaosActions{Replace: &tproxyconfig.PodHttpChaosReplaceActions{Code: int32Ptr(code)}}
+	}
+	g.Expect(proxy.SetConfig(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:  targetResponse,
+		Actions: replaceCode(http.StatusServiceUnavailable),
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/domain")},
+		Actions:  replaceCode(http.StatusInternalServerError),
+		Egress:   &tproxyconfig.PodHttpChaosEgress{Targets: []string{"Example.com"}},
+	}, {
+		Target:   targetResponse,
+		Selector: tproxyconfig.PodHttpChaosSelector{Path: stringPtr("/ip")},
+		Actions:  replaceCode(http.StatusTeapot),
+		Egress:   &tproxyconfig.PodHttpChaosEgress{Targets: []string{"127.0.0.0/8"}},
+	}}})).Should(Succeed())
+
+	withHost := func(url, host string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		req.Host = host
+		resp, err := http.DefaultClient.Do(req)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		resp.Body.Close()
+		return resp
+	}
+
+	// the inbound rules don't select the outbound requests, and vice versa
+	g.Expect(withHost(proxyURL+"/domain", "example.com").StatusCode).Should(Equal(http.StatusServiceUnavailable))
+	g.Expect(withHost(egressURL+"/", "example.com").StatusCode).Should(Equal(http.StatusOK))
+
+	g.Expect(withHost(egressURL+"/domain", "example.com:8080").StatusCode).Should(Equal(http.StatusInternalServerError))
+	g.Expect(withHost(egressURL+"/domain", "example.org").StatusCode).Should(Equal(http.StatusOK))
+	g.Expect(withHost(egressURL+"/ip", "example.org").StatusCode).Should(Equal(http.StatusTeapot))
+}
+
+func TestProxyServeConfig(t *testing.T) {
+	g := NewWithT(t)
+	proxy, proxyURL := startProxy(t, echoServer(t))
+
+	config, err := json.Marshal(tproxyconfig.Config{Rules: []tproxyconfig.PodHttpChaosBaseRule{{
+		Target:  targetRequest,
+		Actions: tproxyconfig.PodHttpChaosActions{Delay: stringPtr("1ms")},
+	}}})
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	var in bytes.Buffer
+	put, err := http.NewRequest(http.MethodPut, "/", bytes.NewReader(config))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(put.Write(&in)).Should(Succeed())
+
+	var out bytes.Buffer
+	g.Expect(proxy.ServeConfig(&in, &out)).Should(Succeed())
+	resp, err := http.ReadResponse(bufio.NewReader(&out), put)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+
+	get(g, proxyURL+"/").Body.Close()
+
+	statsReq, err := http.NewRequest(http.MethodGet, "/stats", nil)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	in.Reset()
+	out.Reset()
+	g.Expect(statsReq.Write(&in)).Should(Succeed())
+	g.Expect(proxy.ServeConfig(&in, &out)).Should(Succeed())
+	resp, err = http.ReadResponse(bufio.NewReader(&out), statsReq)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+
+	var stats tproxyconfig.Stats
+	g.Expect(json.NewDecoder(resp.Body).Decode(&stats)).Should(Succeed())
+	g.Expect(stats.Rules).Should(Equal([]tproxyconfig.RuleStats{{Matched: 1, Faulted: 1}}))
+}
+
+// generateCert generates a self-signed certificate of the host in pem
+func generateCert(g *WithT, host string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: host},
+		DNSNames:              []string{host},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	g.Expect(err).ShouldNot(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
@@ -0,0 +1,455 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	targetRequest  tproxyconfig.PodHttpChaosTarget = "Request"
+	targetResponse tproxyconfig.PodHttpChaosTarget = "Response"
+
+	// tlsContents is the only type of tls items, whose value is the contents of file
+	tlsContents = "Contents"
+)
+
+// rule is the compiled tproxyconfig.PodHttpChaosBaseRule
+type rule struct {
+	target   tproxyconfig.PodHttpChaosTarget
+	selector tproxyconfig.PodHttpChaosSelector
+	actions  tproxyconfig.PodHttpChaosActions
+
+	// egress selects the destinations of outbound requests, the rule selects the
+	// inbound requests only if it's nil
+	egress *egressSelector
+
+	pathPattern    *pattern
+	queries        map[string]*pattern
+	headerPatterns map[string]*pattern
+	body           *bodySelector
+
+	delay  time.Duration
+	stream *streamActions
+	frames *frameActions
+	// observer counts the requests if it's an observe action
+	observer *observer
+	percent  int
+	limiter  *rate.Limiter
+
+	matched atomic.Int64
+	faulted atomic.Int64
+}
+
+func compileRule(in tproxyconfig.PodHttpChaosBaseRule) (*rule, error) {
+	r := &rule{
+		target:   in.Target,
+		selector: in.Selector,
+		actions:  in.Actions,
+		percent:  100,
+	}
+
+	if in.Target != targetRequest && in.Target != targetResponse {
+		return nil, errors.Errorf("unknown target %s", in.Target)
+	}
+	if in.Selector.Path != nil {
+		if _, err := path.Match(*in.Selector.Path, ""); err != nil {
+			return nil, errors.Wrapf(err, "invalid path %s", *in.Selector.Path)
+		}
+	}
+	if in.Egress != nil {
+		r.egress = compileEgress(in.Egress)
+	}
+	if in.Selector.PathPattern != nil {
+		compiled, err := compilePattern(*in.Selector.PathPattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "compile path pattern")
+		}
+		r.pathPattern = compiled
+	}
+	var err error
+	if r.queries, err = compilePatterns(in.Selector.Queries); err != nil {
+		return nil, errors.Wrap(err, "compile queries")
+	}
+	if r.headerPatterns, err = compilePatterns(in.Selector.RequestHeaderPatterns); err != nil {
+		return nil, errors.Wrap(err, "compile request header patterns")
+	}
+	if in.Selector.RequestBody != nil {
+		if r.body, err = compileBodySelector(*in.Selector.RequestBody); err != nil {
+			return nil, errors.Wrap(err, "compile request body selector")
+		}
+	}
+
+	if in.Actions.Delay != nil {
+		delay, err := time.ParseDuration(*in.Actions.Delay)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse delay %s", *in.Actions.Delay)
+		}
+		r.delay = delay
+	}
+	if in.Actions.Stream != nil {
+		if r.stream, err = compileStream(in.Actions.Stream); err != nil {
+			return nil, errors.Wrap(err, "compile stream actions")
+		}
+	}
+	if in.Actions.Frames != nil {
+		if r.frames, err = compileFrames(in.Actions.Frames); err != nil {
+			return nil, errors.Wrap(err, "compile frame actions")
+		}
+	}
+	if in.Actions.Observe != nil && *in.Actions.Observe {
+		r.observer = newObserver()
+	}
+	if in.Percent != nil {
+		r.percent = *in.Percent
+	}
+	if in.RateLimit != nil && *in.RateLimit > 0 {
+		// the burst is the requests of a second, and at least one request could be
+		// injected, or the limiter rejects all of them
+		burst := int(*in.RateLimit)
+		if burst < 1 {
+			burst = 1
+		}
+		r.limiter = rate.NewLimiter(rate.Limit(*in.RateLimit), burst)
+	}
+	return r, nil
+}
+
+// compileTLS returns the config to terminate the TLS connections, and the config to
+// connect the upstream servers. The upstream servers are verified only if the CA is
+// provided, as the proxy is transparent to the clients.
+func compileTLS(in *tproxyconfig.TLSConfig) (*tls.Config, *tls.Config, error) {
+	if in.CertFile.Type != tlsContents || in.KeyFile.Type != tlsContents {
+		return nil, nil, errors.Errorf("unsupported type %s/%s of cert and key", in.CertFile.Type, in.KeyFile.Type)
+	}
+	cert, err := tls.X509KeyPair(in.CertFile.Value, in.KeyFile.Value)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "load cert and key")
+	}
+	server := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"http/1.1"},
+	}
+
+	client := &tls.Config{InsecureSkipVerify: true}
+	if in.CAFile != nil {
+		if in.CAFile.Type != tlsContents {
+			return nil, nil, errors.Errorf("unsupported type %s of ca", in.CAFile.Type)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(in.CAFile.Value) {
+			return nil, nil, errors.New("no certificate is found in ca")
+		}
+		client = &tls.Config{RootCAs: pool}
+	}
+	return server, client, nil
+}
+
+// selectRequest returns whether the request on the connection is selected, the body
+// is nil if it's too large to be matched. The code and response headers are selected
+// by selectResponse.
+func (r *rule) selectRequest(c *conn, req *http.Request, body []byte) bool {
+	if (r.egress != nil) != c.egress {
+		return false
+	}
+	if r.egress != nil && !r.egress.match(c.ip(), req.Host) {
+		return false
+	}
+
+	selector := r.selector
+	if selector.Port != nil && *selector.Port != c.port() {
+		return false
+	}
+	if selector.Path != nil {
+		if matched, _ := path.Match(*selector.Path, req.URL.Path); !matched {
+			return false
+		}
+	}
+	if r.pathPattern != nil && !r.pathPattern.match(req.URL.Path) {
+		return false
+	}
+	if selector.Method != nil && !strings.EqualFold(*selector.Method, req.Method) {
+		return false
+	}
+	if !matchHeaders(selector.RequestHeaders, req.Header) {
+		return false
+	}
+
+	if len(r.queries) > 0 {
+		query := req.URL.Query()
+		for key, p := range r.queries {
+			if !p.matchAny(query[key]) {
+				return false
+			}
+		}
+	}
+	for key, p := range r.headerPatterns {
+		if !p.matchAny(req.Header.Values(key)) {
+			return false
+		}
+	}
+	if r.body != nil && (body == nil || !r.body.match(body)) {
+		return false
+	}
+	return true
+}
+
+func (r *rule) selectResponse(resp *http.Response) bool {
+	if r.selector.Code != nil && *r.selector.Code != int32(resp.StatusCode) {
+		return false
+	}
+	return matchHeaders(r.selector.ResponseHeaders, resp.Header)
+}
+
+// egressSelector is the compiled tproxyconfig.PodHttpChaosEgress
+type egressSelector struct {
+	nets []*net.IPNet
+	// hosts is the domain names in lower case
+	hosts []string
+}
+
+// compileEgress parses the targets into the IPs or CIDRs, and the domain names
+func compileEgress(in *tproxyconfig.PodHttpChaosEgress) *egressSelector {
+	selector := &egressSelector{}
+	for _, target := range in.Targets {
+		if ip := net.ParseIP(target); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			selector.nets = append(selector.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, ipNet, err := net.ParseCIDR(target); err == nil {
+			selector.nets = append(selector.nets, ipNet)
+			continue
+		}
+		selector.hosts = append(selector.hosts, strings.ToLower(strings.TrimSuffix(target, ".")))
+	}
+	return selector
+}
+
+// match returns whether the destination ip or the host of request is one of the
+// targets, all the destinations are selected if there are no targets
+func (s *egressSelector) match(ip net.IP, host string) bool {
+	if len(s.nets) == 0 && len(s.hosts) == 0 {
+		return true
+	}
+	for _, ipNet := range s.nets {
+		if ip != nil && ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	for _, target := range s.hosts {
+		if target == host {
+			return true
+		}
+	}
+	return false
+}
+
+func matchHeaders(expected map[string]string, header http.Header) bool {
+	for key, value := range expected {
+		if header.Get(key) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// inject returns whether the actions are applied to a matched target, with the
+// percentage and the rate limit of rule
+func (r *rule) inject() bool {
+	if r.percent < 100 && rand.Intn(100) >= r.percent {
+		return false
+	}
+	if r.limiter != nil && !r.limiter.Allow() {
+		return false
+	}
+	r.faulted.Add(1)
+	return true
+}
+
+// applyRequest applies the actions to the request, it returns an error if the
+// request is aborted
+func (r *rule) applyRequest(req *http.Request) error {
+	actions := r.actions
+	if err := r.sleep(req); err != nil {
+		return err
+	}
+	if actions.Abort != nil && *actions.Abort {
+		return errAbort
+	}
+
+	if replace := actions.Replace; replace != nil {
+		if replace.Path != nil {
+			req.URL.Path = *replace.Path
+			req.URL.RawPath = ""
+		}
+		if replace.Method != nil {
+			req.Method = *replace.Method
+		}
+		if len(replace.Queries) > 0 {
+			query := req.URL.Query()
+			for key, value := range replace.Queries {
+				query.Set(key, value)
+			}
+			req.URL.RawQuery = query.Encode()
+		}
+		for key, value := range replace.Headers {
+			setHeader(req, key, value)
+		}
+		if replace.Body != nil {
+			setRequestBody(req, []byte(replace.Body.Contents.Value))
+		}
+	}
+
+	if patch := actions.Patch; patch != nil {
+		if len(patch.Queries) > 0 {
+			query := req.URL.Query()
+			for _, pair := range patch.Queries {
+				if len(pair) == 2 {
+					query.Add(pair[0], pair[1])
+				}
+			}
+			req.URL.RawQuery = query.Encode()
+		}
+		for _, pair := range patch.Headers {
+			if len(pair) == 2 {
+				req.Header.Add(pair[0], pair[1])
+			}
+		}
+		if patch.Body != nil && req.Body != nil {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return errors.Wrap(err, "read request body")
+			}
+			setRequestBody(req, patchBody(body, patch.Body))
+		}
+	}
+	return nil
+}
+
+// applyResponse applies the actions to the response, it returns errAbort if the
+// response is aborted
+func (r *rule) applyResponse(resp *http.Response) error {
+	actions := r.actions
+	if err := r.sleep(resp.Request); err != nil {
+		return err
+	}
+	if actions.Abort != nil && *actions.Abort {
+		return errAbort
+	}
+
+	if replace := actions.Replace; replace != nil {
+		if replace.Code != nil {
+			resp.StatusCode = int(*replace.Code)
+			resp.Status = ""
+		}
+		for key, value := range replace.Headers {
+			resp.Header.Set(key, value)
+		}
+		if replace.Body != nil {
+			resp.Body.Close()
+			resp.Header.Del("Content-Encoding")
+			setResponseBody(resp, []byte(replace.Body.Contents.Value))
+		}
+	}
+
+	if patch := actions.Patch; patch != nil {
+		for _, pair := range patch.Headers {
+			if len(pair) == 2 {
+				resp.Header.Add(pair[0], pair[1])
+			}
+		}
+		if patch.Body != nil {
+			body, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return errors.Wrap(err, "read response body")
+			}
+			setResponseBody(resp, patchBody(body, patch.Body))
+		}
+	}
+
+	if r.frames != nil {
+		r.frames.apply(resp, false)
+	}
+	if r.stream != nil {
+		return r.stream.apply(resp)
+	}
+	return nil
+}
+
+func (r *rule) sleep(req *http.Request) error {
+	return wait(req.Context(), r.delay)
+}
+
+// patchBody applies the json merge patch to the body, the body is kept if it
+// isn't a json document, e.g. it's compressed
+func patchBody(body []byte, patch *tproxyconfig.PodHttpChaosPatchBody) []byte {
+	patched, err := jsonpatch.MergePatch(body, []byte(patch.Contents.Value))
+	if err != nil {
+		return body
+	}
+	return patched
+}
+
+func setHeader(req *http.Request, key, value string) {
+	if http.CanonicalHeaderKey(key) == "Host" {
+		req.Host = value
+		return
+	}
+	req.Header.Set(key, value)
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.TransferEncoding = nil
+	req.Header.Set("Content-Length", strconv.Itoa(len(body)))
+}
+
+func setResponseBody(resp *http.Response, body []byte) {
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.TransferEncoding = nil
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+}
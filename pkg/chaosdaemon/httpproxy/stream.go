@@ -0,0 +1,136 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package httpproxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// maxStreamBurst is the maximum bytes of body sent at once at the limited rate
+const maxStreamBurst = 16 * 1024
+
+// errTruncated represents the response body is cut by the truncate action, the
+// connection is aborted by the proxy with it
+var errTruncated = errors.New("truncated by http chaos")
+
+// streamActions is the compiled tproxyconfig.PodHttpChaosStreamActions
+type streamActions struct {
+	rate int64
+	ttfb time.Duration
+	// truncate is negative if the body isn't truncated
+	truncate int64
+}
+
+func compileStream(in *tproxyconfig.PodHttpChaosStreamActions) (*streamActions, error) {
+	s := &streamActions{truncate: -1}
+	if in.Rate != nil {
+		if *in.Rate <= 0 {
+			return nil, errors.Errorf("invalid rate %d", *in.Rate)
+		}
+		s.rate = *in.Rate
+	}
+	if in.Ttfb != nil {
+		ttfb, err := time.ParseDuration(*in.Ttfb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse ttfb %s", *in.Ttfb)
+		}
+		s.ttfb = ttfb
+	}
+	if in.Truncate != nil {
+		s.truncate = *in.Truncate
+	}
+	return s, nil
+}
+
+// apply delays the first byte of response, and wraps the body to be cut after the
+// truncated bytes and sent at the rate. The body should be flushed on every write to
+// keep the rate.
+func (s *streamActions) apply(resp *http.Response) error {
+	ctx := resp.Request.Context()
+	if err := wait(ctx, s.ttfb); err != nil {
+		return err
+	}
+
+	if s.truncate >= 0 {
+		resp.Body = &truncatedBody{ReadCloser: resp.Body, remaining: s.truncate}
+	}
+	if s.rate > 0 {
+		burst := s.rate
+		if burst > maxStreamBurst {
+			burst = maxStreamBurst
+		}
+		limiter := rate.NewLimiter(rate.Limit(s.rate), int(burst))
+		// the first burst isn't sent immediately
+		limiter.AllowN(time.Now(), int(burst))
+		resp.Body = &throttledBody{ReadCloser: resp.Body, ctx: ctx, limiter: limiter}
+	}
+	return nil
+}
+
+// throttledBody reads the body at the rate of limiter
+type throttledBody struct {
+	io.ReadCloser
+
+	ctx     context.Context
+	limiter *rate.Limiter
+}
+
+func (b *throttledBody) Read(p []byte) (int, error) {
+	if len(p) > b.limiter.Burst() {
+		p = p[:b.limiter.Burst()]
+	}
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		if waitErr := b.limiter.WaitN(b.ctx, n); waitErr != nil {
+			return 0, waitErr
+		}
+	}
+	return n, err
+}
+
+// truncatedBody returns errTruncated after the remaining bytes are read, unless
+// the body ends at the same time
+type truncatedBody struct {
+	io.ReadCloser
+
+	remaining int64
+}
+
+func (b *truncatedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		var probe [1]byte
+		n, err := io.ReadFull(b.ReadCloser, probe[:])
+		if n == 0 && errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		return 0, errTruncated
+	}
+
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	return n, err
+}
@@ -36,13 +36,26 @@ const (
 )
 
 func (s *DaemonServer) FlushIPSets(ctx context.Context, req *pb.IPSetsRequest) (*empty.Empty, error) {
+	rules := s.lockNetworkRules(req.ContainerId)
+	defer s.unlockNetworkRules(req.ContainerId, rules)
+
+	rules.ipsets = nil
+	if err := s.flushIPSets(ctx, req); err != nil {
+		return nil, err
+	}
+	rules.ipsets = req
+
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) flushIPSets(ctx context.Context, req *pb.IPSetsRequest) error {
 	log := s.getLoggerFromContext(ctx)
 	log.Info("flush ipset", "request", req)
 
 	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
 	if err != nil {
 		log.Error(err, "error while getting PID")
-		return nil, err
+		return err
 	}
 
 	for _, ipset := range req.Ipsets {
@@ -59,11 +72,11 @@ func (s *DaemonServer) FlushIPSets(ctx context.Context, req *pb.IPSetsRequest) (
 		err := flushIPSet(ctx, log, req.EnterNS, pid, ipset)
 		s.IPSetLocker.Unlock(ipset.Name)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return &empty.Empty{}, nil
+	return nil
 }
 
 func flushIPSet(ctx context.Context, log logr.Logger, enterNS bool, pid uint32, set *pb.IPSet) error {
@@ -90,7 +103,7 @@ func flushIPSet(ctx context.Context, log logr.Logger, enterNS bool, pid uint32,
 
 	// IP sets can't be deleted if there are iptables rules referencing them.
 	// Therefore, we create new sets and swap them.
-	if err := createIPSet(ctx, log, enterNS, pid, tmpName, ipSetType); err != nil {
+	if err := createIPSet(ctx, log, enterNS, pid, tmpName, ipSetType, v1alpha1.IPFamily(set.Family)); err != nil {
 		return err
 	}
 
@@ -107,13 +120,19 @@ func flushIPSet(ctx context.Context, log logr.Logger, enterNS bool, pid uint32,
 	return err
 }
 
-func createIPSet(ctx context.Context, log logr.Logger, enterNS bool, pid uint32, name string, ipSetType v1alpha1.IPSetType) error {
+func createIPSet(ctx context.Context, log logr.Logger, enterNS bool, pid uint32, name string, ipSetType v1alpha1.IPSetType, family v1alpha1.IPFamily) error {
 	// ipset name cannot be longer than 31 bytes
 	if len(name) > 31 {
 		name = name[:31]
 	}
 
-	processBuilder := bpm.DefaultProcessBuilder("ipset", "create", name, string(ipSetType)).SetContext(ctx)
+	args := []string{"create", name, string(ipSetType)}
+	// list:set doesn't have a family, and it could contain sets of both families
+	if family == v1alpha1.IPv6Family && ipSetType != v1alpha1.SetIPSet {
+		args = append(args, "family", string(family))
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder("ipset", args...).SetContext(ctx)
 	if enterNS {
 		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
 	}
@@ -52,7 +52,16 @@ var _ = Describe("ipset server", func() {
 				Expect(args[6]).To(Equal("hash:net"))
 				return exec.Command("echo", "mock command")
 			})()
-			err := createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet)
+			err := createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet, v1alpha1.IPv4Family)
+			Expect(err).To(BeNil())
+		})
+
+		It("should create ipv6 set", func() {
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(args[3:]).To(Equal([]string{"ipset", "create", "name", "hash:net", "family", "inet6"}))
+				return exec.Command("echo", "mock command")
+			})()
+			err := createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet, v1alpha1.IPv6Family)
 			Expect(err).To(BeNil())
 		})
 
@@ -71,7 +80,7 @@ exit 1
 			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
 				return exec.Command("/tmp/mockfail.sh", ipsetExistErr)
 			})()
-			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet)
+			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet, v1alpha1.IPv4Family)
 			Expect(err).To(BeNil())
 		})
 
@@ -86,7 +95,7 @@ exit 1
 			defer mock.With("MockProcessBuild", func(context.Context, string, ...string) *exec.Cmd {
 				return exec.Command("/tmp/mockfail.sh", "fail msg")
 			})()
-			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet)
+			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet, v1alpha1.IPv4Family)
 			Expect(err).ToNot(BeNil())
 		})
 
@@ -101,7 +110,7 @@ exit 1
 			defer mock.With("MockProcessBuild", func(context.Context, string, ...string) *exec.Cmd {
 				return exec.Command("/tmp/mockfail.sh", ipsetExistErr)
 			})()
-			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet)
+			err = createIPSet(context.TODO(), logger, true, 1, "name", v1alpha1.NetIPSet, v1alpha1.IPv4Family)
 			Expect(err).ToNot(BeNil())
 		})
 	})
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
@@ -29,41 +30,81 @@ import (
 )
 
 const (
-	iptablesCmd = "iptables"
+	iptablesCmd  = "iptables"
+	ip6tablesCmd = "ip6tables"
 
 	iptablesChainAlreadyExistErr = "iptables: Chain already exists."
 )
 
 func (s *DaemonServer) SetIptablesChains(ctx context.Context, req *pb.IptablesChainsRequest) (*empty.Empty, error) {
+	rules := s.lockNetworkRules(req.ContainerId)
+	defer s.unlockNetworkRules(req.ContainerId, rules)
+
+	rules.iptables = nil
+	if err := s.setIptablesChains(ctx, req); err != nil {
+		return nil, err
+	}
+	rules.iptables = req
+
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) setIptablesChains(ctx context.Context, req *pb.IptablesChainsRequest) error {
 	log := s.getLoggerFromContext(ctx)
 	log.Info("Set iptables chains", "request", req)
 
 	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
 	if err != nil {
 		log.Error(err, "error while getting PID")
-		return nil, err
+		return err
 	}
 
-	iptables := buildIptablesClient(ctx, req.EnterNS, pid)
-	err = iptables.initializeEnv()
-	if err != nil {
-		log.Error(err, "error while initializing iptables")
-		return nil, err
+	// the flapping of previous request is replaced by this request
+	s.stopFlapping(iptablesFlappingKey(req.ContainerId))
+
+	for _, iptables := range buildIptablesClients(ctx, req.EnterNS, pid, req.EnableIpv6) {
+		err = iptables.initializeEnv()
+		if err != nil {
+			log.Error(err, "error while initializing iptables", "command", iptables.command)
+			return err
+		}
+
+		err = iptables.setIptablesChains(req.Chains)
+		if err != nil {
+			log.Error(err, "error while setting iptables chains", "command", iptables.command)
+			return err
+		}
 	}
 
-	err = iptables.setIptablesChains(req.Chains)
-	if err != nil {
-		log.Error(err, "error while setting iptables chains")
-		return nil, err
+	var flaps []*pb.Flap
+	for _, chain := range req.Chains {
+		if chain.Flap != nil {
+			flaps = append(flaps, chain.Flap)
+		}
+	}
+	if len(flaps) > 0 {
+		s.startFlapping(log, iptablesFlappingKey(req.ContainerId), flaps, func(ctx context.Context) error {
+			for _, iptables := range buildIptablesClients(ctx, req.EnterNS, pid, req.EnableIpv6) {
+				if err := iptables.setIptablesChains(req.Chains); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
 	}
 
-	return &empty.Empty{}, nil
+	return nil
+}
+
+func iptablesFlappingKey(containerID string) string {
+	return "iptables/" + containerID
 }
 
 type iptablesClient struct {
 	ctx     context.Context
 	enterNS bool
 	pid     uint32
+	command string
 }
 
 type iptablesChain struct {
@@ -76,9 +117,30 @@ func buildIptablesClient(ctx context.Context, enterNS bool, pid uint32) iptables
 		ctx,
 		enterNS,
 		pid,
+		iptablesCmd,
+	}
+}
+
+func buildIp6tablesClient(ctx context.Context, enterNS bool, pid uint32) iptablesClient {
+	return iptablesClient{
+		ctx,
+		enterNS,
+		pid,
+		ip6tablesCmd,
 	}
 }
 
+// buildIptablesClients returns the iptables client, and the ip6tables client
+// if IPv6 is enabled, so the rules will be applied on both address families.
+func buildIptablesClients(ctx context.Context, enterNS bool, pid uint32, enableIPv6 bool) []iptablesClient {
+	clients := []iptablesClient{buildIptablesClient(ctx, enterNS, pid)}
+	if enableIPv6 {
+		clients = append(clients, buildIp6tablesClient(ctx, enterNS, pid))
+	}
+
+	return clients
+}
+
 func (iptables *iptablesClient) setIptablesChains(chains []*pb.Chain) error {
 	for _, chain := range chains {
 		err := iptables.setIptablesChain(chain)
@@ -109,7 +171,12 @@ func (iptables *iptablesClient) setIptablesChain(chain *pb.Chain) error {
 
 	protocolAndPort := ""
 	if len(chain.Protocol) > 0 {
-		protocolAndPort += fmt.Sprintf("--protocol %s", chain.Protocol)
+		protocol := chain.Protocol
+		if protocol == "icmp" && iptables.command == ip6tablesCmd {
+			// ip6tables only recognizes the ICMPv6 protocol
+			protocol = "ipv6-icmp"
+		}
+		protocolAndPort += fmt.Sprintf("--protocol %s", protocol)
 
 		if len(chain.SourcePorts) > 0 {
 			if strings.Contains(chain.SourcePorts, ",") {
@@ -132,15 +199,34 @@ func (iptables *iptablesClient) setIptablesChain(chain *pb.Chain) error {
 		}
 	}
 
+	if chain.Probability > 0 && chain.Probability < 1 {
+		protocolAndPort = strings.TrimSpace(fmt.Sprintf("%s -m statistic --mode random --probability %g", protocolAndPort, chain.Probability))
+	}
+
+	target := chain.Target
+	if len(chain.RejectWith) > 0 {
+		rejectWith := chain.RejectWith
+		if rejectWith == "icmp-port-unreachable" && iptables.command == ip6tablesCmd {
+			// ip6tables only recognizes the ICMPv6 types
+			rejectWith = "icmp6-port-unreachable"
+		}
+		target = fmt.Sprintf("%s --reject-with %s", target, rejectWith)
+	}
+
 	rules := []string{}
 
 	if len(chain.Ipsets) == 0 {
-		rules = append(rules, strings.TrimSpace(fmt.Sprintf("-A %s %s %s -j %s -w 5 %s", chain.Name, interfaceMatcher, chain.Device, chain.Target, protocolAndPort)))
+		rules = append(rules, strings.TrimSpace(fmt.Sprintf("-A %s %s %s -j %s -w 5 %s", chain.Name, interfaceMatcher, chain.Device, target, protocolAndPort)))
 	}
 
 	for _, ipset := range chain.Ipsets {
 		rules = append(rules, strings.TrimSpace(fmt.Sprintf("-A %s %s %s -m set --match-set %s %s -j %s -w 5 %s",
-			chain.Name, interfaceMatcher, chain.Device, ipset, matchPart, chain.Target, protocolAndPort)))
+			chain.Name, interfaceMatcher, chain.Device, ipset, matchPart, target, protocolAndPort)))
+	}
+
+	if !isFlapDown(chain.Flap, time.Now()) {
+		// the chain is kept empty in the up phase of flapping
+		rules = []string{}
 	}
 	err := iptables.createNewChain(&iptablesChain{
 		Name:  chain.Name,
@@ -187,7 +273,7 @@ func (iptables *iptablesClient) initializeEnv() error {
 
 // createNewChain will cover existing chain
 func (iptables *iptablesClient) createNewChain(chain *iptablesChain) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-N", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptables.command, "-w", "-N", chain.Name).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -224,7 +310,7 @@ func (iptables *iptablesClient) deleteAndWriteRules(chain *iptablesChain) error
 }
 
 func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-S", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptables.command, "-w", "-S", chain.Name).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -240,7 +326,7 @@ func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) er
 	}
 
 	// TODO: lock on every container but not on chaos-daemon's `/run/xtables.lock`
-	processBuilder = bpm.DefaultProcessBuilder(iptablesCmd, strings.Split("-w "+rule, " ")...).SetContext(iptables.ctx)
+	processBuilder = bpm.DefaultProcessBuilder(iptables.command, strings.Split("-w "+rule, " ")...).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -254,7 +340,7 @@ func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) er
 }
 
 func (iptables *iptablesClient) flushIptablesChain(chain *iptablesChain) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-F", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptables.command, "-w", "-F", chain.Name).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
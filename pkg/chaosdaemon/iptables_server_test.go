@@ -19,6 +19,7 @@ import (
 	"context"
 	"os"
 	"os/exec"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -61,6 +62,94 @@ var _ = Describe("iptables server", func() {
 			Expect(err).To(BeNil())
 		})
 
+		It("should set chains on ip6tables", func() {
+			defer mock.With("pid", 9527)()
+			commands := map[string]int{}
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(cmd).To(Equal("/usr/local/bin/nsexec"))
+				commands[args[3]]++
+				return exec.Command("echo", "-n")
+			})()
+			_, err := s.SetIptablesChains(context.TODO(), &pb.IptablesChainsRequest{
+				Chains: []*pb.Chain{{
+					Name:      "TEST",
+					Direction: pb.Chain_INPUT,
+					Ipsets:    []string{},
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+				EnableIpv6:  true,
+			})
+			Expect(err).To(BeNil())
+			Expect(commands[ip6tablesCmd]).To(Equal(commands[iptablesCmd]))
+			Expect(commands[ip6tablesCmd]).ToNot(BeZero())
+		})
+
+		It("should set protocol and ports", func() {
+			defer mock.With("pid", 9527)()
+			rules := []string{}
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(cmd).To(Equal("/usr/local/bin/nsexec"))
+				rules = append(rules, strings.Join(args[3:], " "))
+				return exec.Command("echo", "-n")
+			})()
+			_, err := s.SetIptablesChains(context.TODO(), &pb.IptablesChainsRequest{
+				Chains: []*pb.Chain{{
+					Name:             "TEST",
+					Direction:        pb.Chain_OUTPUT,
+					Ipsets:           []string{"test"},
+					Target:           "DROP",
+					Protocol:         "tcp",
+					DestinationPorts: "80,443",
+				}, {
+					Name:      "TEST-ICMP",
+					Direction: pb.Chain_OUTPUT,
+					Target:    "DROP",
+					Protocol:  "icmp",
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+				EnableIpv6:  true,
+			})
+			Expect(err).To(BeNil())
+			Expect(rules).To(ContainElement("iptables -w -A TEST -o eth0 -m set --match-set test dst,dst -j DROP -w 5 --protocol tcp -m multiport --destination-ports 80,443"))
+			Expect(rules).To(ContainElement("iptables -w -A TEST-ICMP -o eth0 -j DROP -w 5 --protocol icmp"))
+			Expect(rules).To(ContainElement("ip6tables -w -A TEST-ICMP -o eth0 -j DROP -w 5 --protocol ipv6-icmp"))
+		})
+
+		It("should set reject and probability", func() {
+			defer mock.With("pid", 9527)()
+			rules := []string{}
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				Expect(cmd).To(Equal("/usr/local/bin/nsexec"))
+				rules = append(rules, strings.Join(args[3:], " "))
+				return exec.Command("echo", "-n")
+			})()
+			_, err := s.SetIptablesChains(context.TODO(), &pb.IptablesChainsRequest{
+				Chains: []*pb.Chain{{
+					Name:        "TEST-RESET",
+					Direction:   pb.Chain_INPUT,
+					Ipsets:      []string{"test"},
+					Target:      "REJECT",
+					RejectWith:  "tcp-reset",
+					Protocol:    "tcp",
+					Probability: 0.5,
+				}, {
+					Name:       "TEST-REJECT",
+					Direction:  pb.Chain_OUTPUT,
+					Target:     "REJECT",
+					RejectWith: "icmp-port-unreachable",
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+				EnableIpv6:  true,
+			})
+			Expect(err).To(BeNil())
+			Expect(rules).To(ContainElement("iptables -w -A TEST-RESET -i eth0 -m set --match-set test src,dst -j REJECT --reject-with tcp-reset -w 5 --protocol tcp -m statistic --mode random --probability 0.5"))
+			Expect(rules).To(ContainElement("iptables -w -A TEST-REJECT -o eth0 -j REJECT --reject-with icmp-port-unreachable -w 5"))
+			Expect(rules).To(ContainElement("ip6tables -w -A TEST-REJECT -o eth0 -j REJECT --reject-with icmp6-port-unreachable -w 5"))
+		})
+
 		It("should fail on get pid", func() {
 			const errorStr = "mock error on Task()"
 			defer mock.With("TaskError", errors.New(errorStr))()
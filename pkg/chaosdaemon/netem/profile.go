@@ -0,0 +1,111 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netem
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	chaosdaemon "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// EvaluateProfile calculates the point of profile after elapsed since the start time.
+// It returns the index of current step (or the index of cycle for sine profile), the
+// latency, loss and rate at this point, and whether the profile has passed the last step.
+// A sine profile never finishes.
+func EvaluateProfile(profile *chaosdaemon.NetemProfile, elapsed time.Duration) (int, *chaosdaemon.NetemProfileStep, bool) {
+	steps := profile.GetSteps()
+	if len(steps) == 0 {
+		return 0, &chaosdaemon.NetemProfileStep{Offset: int64(elapsed)}, true
+	}
+
+	if profile.GetType() == chaosdaemon.NetemProfile_SINE {
+		if len(steps) < 2 || profile.GetPeriod() <= 0 {
+			return 0, interpolateStep(steps[0], steps[0], 0, elapsed), false
+		}
+
+		period := time.Duration(profile.GetPeriod())
+		cycle := int(elapsed / period)
+		phase := float64(elapsed%period) / float64(period)
+		// starts from the lowest point, and reaches the highest point at the middle of period
+		frac := (1 - math.Cos(2*math.Pi*phase)) / 2
+		return cycle, interpolateStep(steps[0], steps[1], frac, elapsed), false
+	}
+
+	current := 0
+	for i, step := range steps {
+		if time.Duration(step.GetOffset()) <= elapsed {
+			current = i
+		}
+	}
+
+	last := len(steps) - 1
+	if current == last && time.Duration(steps[last].GetOffset()) <= elapsed {
+		return last, interpolateStep(steps[last], steps[last], 0, elapsed), true
+	}
+
+	from := steps[current]
+	if profile.GetType() != chaosdaemon.NetemProfile_RAMP || time.Duration(from.GetOffset()) > elapsed {
+		return current, interpolateStep(from, from, 0, elapsed), false
+	}
+
+	to := steps[current+1]
+	frac := float64(elapsed-time.Duration(from.GetOffset())) / float64(to.GetOffset()-from.GetOffset())
+	return current, interpolateStep(from, to, frac, elapsed), false
+}
+
+func interpolateStep(from, to *chaosdaemon.NetemProfileStep, frac float64, elapsed time.Duration) *chaosdaemon.NetemProfileStep {
+	return &chaosdaemon.NetemProfileStep{
+		Offset:  int64(elapsed),
+		Latency: from.GetLatency() + int64(float64(to.GetLatency()-from.GetLatency())*frac),
+		Loss:    from.GetLoss() + float32(float64(to.GetLoss()-from.GetLoss())*frac),
+		Rate:    uint64(float64(from.GetRate()) + (float64(to.GetRate())-float64(from.GetRate()))*frac),
+	}
+}
+
+// ApplyProfileStep returns a copy of the netem with the latency, loss and rate replaced
+// by the point of profile. A field is only replaced when any step of the profile sets it.
+func ApplyProfileStep(base *chaosdaemon.Netem, profile *chaosdaemon.NetemProfile, point *chaosdaemon.NetemProfileStep) *chaosdaemon.Netem {
+	netem := &chaosdaemon.Netem{}
+	if base != nil {
+		netem = proto.Clone(base).(*chaosdaemon.Netem)
+	}
+
+	var hasLatency, hasLoss, hasRate bool
+	for _, step := range profile.GetSteps() {
+		hasLatency = hasLatency || step.GetLatency() > 0
+		hasLoss = hasLoss || step.GetLoss() > 0
+		hasRate = hasRate || step.GetRate() > 0
+	}
+
+	if hasLatency {
+		netem.Time = fmt.Sprintf("%dus", time.Duration(point.GetLatency()).Microseconds())
+	}
+	if hasLoss {
+		netem.Loss = point.GetLoss()
+	}
+	if hasRate {
+		netem.Rate = ""
+		if point.GetRate() > 0 {
+			netem.Rate = fmt.Sprintf("%dbps", point.GetRate())
+		}
+	}
+
+	return netem
+}
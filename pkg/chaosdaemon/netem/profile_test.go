@@ -0,0 +1,80 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netem
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+
+	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestEvaluateProfile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	steps := []*chaosdaemonpb.NetemProfileStep{
+		{Offset: int64(10 * time.Second), Latency: int64(100 * time.Millisecond), Loss: 10},
+		{Offset: int64(20 * time.Second), Latency: int64(200 * time.Millisecond), Loss: 30},
+		{Offset: int64(40 * time.Second), Latency: int64(0), Loss: 50},
+	}
+
+	cases := []struct {
+		name     string
+		profile  *chaosdaemonpb.NetemProfile
+		elapsed  time.Duration
+		step     int
+		latency  time.Duration
+		loss     float32
+		finished bool
+	}{
+		{"ramp before the first step", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_RAMP, Steps: steps}, 5 * time.Second, 0, 100 * time.Millisecond, 10, false},
+		{"ramp between steps", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_RAMP, Steps: steps}, 15 * time.Second, 0, 150 * time.Millisecond, 20, false},
+		{"ramp descending", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_RAMP, Steps: steps}, 30 * time.Second, 1, 100 * time.Millisecond, 40, false},
+		{"ramp after the last step", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_RAMP, Steps: steps}, time.Minute, 2, 0, 50, true},
+		{"step holds the value", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_STEP, Steps: steps}, 15 * time.Second, 0, 100 * time.Millisecond, 10, false},
+		{"step at the offset", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_STEP, Steps: steps}, 20 * time.Second, 1, 200 * time.Millisecond, 30, false},
+		{"sine at the start", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_SINE, Steps: steps[:2], Period: int64(time.Minute)}, 0, 0, 100 * time.Millisecond, 10, false},
+		{"sine at the peak", &chaosdaemonpb.NetemProfile{Type: chaosdaemonpb.NetemProfile_SINE, Steps: steps[:2], Period: int64(time.Minute)}, 90 * time.Second, 1, 200 * time.Millisecond, 30, false},
+	}
+
+	for _, c := range cases {
+		step, point, finished := EvaluateProfile(c.profile, c.elapsed)
+		g.Expect(step).Should(Equal(c.step), c.name)
+		g.Expect(time.Duration(point.Latency)).Should(Equal(c.latency), c.name)
+		g.Expect(point.Loss).Should(BeNumerically("~", c.loss, 0.001), c.name)
+		g.Expect(finished).Should(Equal(c.finished), c.name)
+	}
+}
+
+func TestApplyProfileStep(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	profile := &chaosdaemonpb.NetemProfile{
+		Steps: []*chaosdaemonpb.NetemProfileStep{
+			{Latency: int64(10 * time.Millisecond)},
+			{Rate: 1024},
+		},
+	}
+
+	netem := ApplyProfileStep(&chaosdaemonpb.Netem{Time: "1s", Loss: 20, Jitter: "5ms"}, profile, &chaosdaemonpb.NetemProfileStep{
+		Latency: int64(5 * time.Millisecond),
+		Rate:    512,
+	})
+	g.Expect(proto.Equal(netem, &chaosdaemonpb.Netem{Time: "5000us", Loss: 20, Jitter: "5ms", Rate: "512bps"})).Should(BeTrue())
+}
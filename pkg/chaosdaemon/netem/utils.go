@@ -86,7 +86,8 @@ func maxf32(a, b float32) float32 {
 	return float32(math.Max(float64(a), float64(b)))
 }
 
-func parseRate(nu string) uint64 {
+// ParseRate parses the rate of tc into bytes per second, it returns 0 if the rate is invalid
+func ParseRate(nu string) uint64 {
 	// normalize input
 	s := strings.ToLower(strings.TrimSpace(nu))
 
@@ -135,7 +136,7 @@ func parseRate(nu string) uint64 {
 }
 
 func maxRateString(a, b string) string {
-	if parseRate(a) > parseRate(b) {
+	if ParseRate(a) > ParseRate(b) {
 		return a
 	}
 	return b
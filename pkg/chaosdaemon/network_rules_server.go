@@ -0,0 +1,333 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+	"github.com/chaos-mesh/chaos-mesh/pkg/metrics"
+)
+
+// networkRules represents the last requests applied on the network namespace of a container,
+// they are compared with the rules read back from the network namespace to detect the drift
+type networkRules struct {
+	sync.Mutex
+
+	ipsets   *pb.IPSetsRequest
+	iptables *pb.IptablesChainsRequest
+	tcs      *pb.TcsRequest
+
+	driftCount int64
+
+	// removed represents this item has been removed from the map,
+	// and it shouldn't be used anymore
+	removed bool
+}
+
+func (r *networkRules) empty() bool {
+	return len(r.ipsets.GetIpsets()) == 0 && len(r.iptables.GetChains()) == 0 && len(r.tcs.GetTcs()) == 0
+}
+
+func (r *networkRules) enterNS() bool {
+	return r.ipsets.GetEnterNS() || r.iptables.GetEnterNS() || r.tcs.GetEnterNS()
+}
+
+// missing returns the rules in the requests, but not in the network namespace, grouped by the kind of rules.
+// The tcs are compared by the kinds and number of qdiscs set by the backend on every device, as the
+// parameters of qdiscs are shown differently by tc and may be changed by the netem profiles.
+func (r *networkRules) missing(backend tcBackend, current *pb.NetworkRulesResponse, now time.Time) map[string][]string {
+	missing := make(map[string][]string)
+
+	ipsets := make(map[string]bool)
+	for _, name := range current.Ipsets {
+		ipsets[name] = true
+	}
+	for _, set := range r.ipsets.GetIpsets() {
+		if !ipsets[set.Name] {
+			missing["ipset"] = append(missing["ipset"], set.Name)
+		}
+	}
+
+	iptables := make(map[string]bool)
+	for _, rule := range current.Iptables {
+		iptables[rule] = true
+	}
+	for _, chain := range r.iptables.GetChains() {
+		parent := "CHAOS-OUTPUT"
+		if chain.Direction == pb.Chain_INPUT {
+			parent = "CHAOS-INPUT"
+		}
+		if !iptables["-N "+chain.Name] || !iptables["-A "+parent+" -j "+chain.Name] {
+			missing["iptables"] = append(missing["iptables"], chain.Name)
+		}
+	}
+
+	// the kinds of qdiscs on every device, e.g. "eth0" -> "netem" -> 2
+	qdiscs := make(map[string]map[string]int)
+	roots := make(map[string]bool)
+	for _, qdisc := range current.Qdiscs {
+		// qdisc <kind> <handle> dev <device> [root|parent <parent>] ...
+		fields := strings.Fields(qdisc)
+		if len(fields) < 5 || fields[0] != "qdisc" || fields[3] != "dev" {
+			continue
+		}
+		kind, handle, device := fields[1], fields[2], fields[4]
+		if qdiscs[device] == nil {
+			qdiscs[device] = make(map[string]int)
+		}
+		qdiscs[device][kind]++
+		// all the qdiscs set by chaos daemon are under the root qdisc with handle 1:
+		if handle == "1:" && len(fields) > 5 && fields[5] == "root" {
+			roots[device] = true
+		}
+	}
+
+	devices := make(map[string][]*pb.Tc)
+	var deviceNames []string
+	for _, tc := range activeTcs(r.tcs.GetTcs(), now) {
+		device := tc.Device
+		if device == "" {
+			device = defaultDevice
+		}
+		if tc.Ingress {
+			device = ifbDeviceName(device)
+		}
+		if _, ok := devices[device]; !ok {
+			deviceNames = append(deviceNames, device)
+		}
+		devices[device] = append(devices[device], tc)
+	}
+	for _, device := range deviceNames {
+		expected := make(map[string]int)
+		for _, kind := range backend.qdiscKinds(devices[device]) {
+			expected[kind]++
+		}
+
+		found := roots[device]
+		for kind, count := range expected {
+			if qdiscs[device][kind] < count {
+				found = false
+			}
+		}
+		if !found {
+			missing["tc"] = append(missing["tc"], device)
+		}
+	}
+
+	return missing
+}
+
+// lockNetworkRules returns the locked network rules of the container, and
+// the requests setting the network rules should be serialized by it
+func (s *DaemonServer) lockNetworkRules(containerID string) *networkRules {
+	for {
+		actual, _ := s.networkRules.LoadOrStore(containerID, &networkRules{})
+		rules := actual.(*networkRules)
+		rules.Lock()
+		if !rules.removed {
+			return rules
+		}
+		rules.Unlock()
+	}
+}
+
+// unlockNetworkRules unlocks the network rules, and removes them if there is no rules
+// on the container anymore
+func (s *DaemonServer) unlockNetworkRules(containerID string, rules *networkRules) {
+	if rules.empty() {
+		rules.removed = true
+		s.networkRules.Delete(containerID)
+	}
+	rules.Unlock()
+}
+
+// GetNetworkRules reads back the network rules in the network namespace of container,
+// and compares them with the last applied requests
+func (s *DaemonServer) GetNetworkRules(ctx context.Context, req *pb.NetworkRulesRequest) (*pb.NetworkRulesResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("get network rules", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "error while getting PID")
+		return nil, err
+	}
+
+	if value, ok := s.networkRules.Load(req.ContainerId); ok {
+		rules := value.(*networkRules)
+		rules.Lock()
+		defer rules.Unlock()
+
+		if !rules.removed {
+			resp, err := readNetworkRules(ctx, req.EnterNS, pid, rules.iptables.GetEnableIpv6())
+			if err != nil {
+				log.Error(err, "error while reading network rules")
+				return nil, err
+			}
+
+			resp.Tracked = true
+			resp.DriftCount = rules.driftCount
+			for kind, names := range rules.missing(s.tcBackend, resp, time.Now()) {
+				for _, name := range names {
+					resp.Missing = append(resp.Missing, kind+" "+name)
+				}
+			}
+			return resp, nil
+		}
+	}
+
+	resp, err := readNetworkRules(ctx, req.EnterNS, pid, false)
+	if err != nil {
+		log.Error(err, "error while reading network rules")
+		return nil, err
+	}
+	return resp, nil
+}
+
+// readNetworkRules reads the ipsets, iptables rules and qdiscs in the network namespace
+func readNetworkRules(ctx context.Context, enterNS bool, pid uint32, enableIPv6 bool) (*pb.NetworkRulesResponse, error) {
+	resp := &pb.NetworkRulesResponse{}
+
+	var err error
+	resp.Ipsets, err = readLines(ctx, enterNS, pid, "ipset", "list", "-n")
+	if err != nil {
+		return nil, err
+	}
+
+	commands := []string{iptablesCmd}
+	if enableIPv6 {
+		commands = append(commands, ip6tablesCmd)
+	}
+	for _, command := range commands {
+		rules, err := readLines(ctx, enterNS, pid, command, "-w", "-S")
+		if err != nil {
+			return nil, err
+		}
+		resp.Iptables = append(resp.Iptables, rules...)
+	}
+
+	resp.Qdiscs, err = readLines(ctx, enterNS, pid, "tc", "qdisc", "show")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func readLines(ctx context.Context, enterNS bool, pid uint32, cmd string, args ...string) ([]string, error) {
+	processBuilder := bpm.DefaultProcessBuilder(cmd, args...).SetContext(ctx)
+	if enterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+
+	out, err := processBuilder.Build(ctx).CombinedOutput()
+	if err != nil {
+		return nil, util.EncodeOutputToError(out, err)
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+	return lines, nil
+}
+
+// watchNetworkRules checks the drift of network rules on all containers every interval,
+// until the context is canceled
+func (s *DaemonServer) watchNetworkRules(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		s.networkRules.Range(func(key, value interface{}) bool {
+			containerID := key.(string)
+			rules := value.(*networkRules)
+
+			rules.Lock()
+			if !rules.removed {
+				s.healNetworkRules(ctx, s.rootLogger.WithValues("containerID", containerID), containerID, rules)
+			}
+			s.unlockNetworkRules(containerID, rules)
+
+			return ctx.Err() == nil
+		})
+	}
+}
+
+// healNetworkRules applies the last requests again if any of the rules is missing
+func (s *DaemonServer) healNetworkRules(ctx context.Context, log logr.Logger, containerID string, rules *networkRules) {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		// the container may have been removed, so the rules don't need to be checked anymore
+		log.Error(err, "error while getting PID, stop checking the network rules")
+		rules.ipsets, rules.iptables, rules.tcs = nil, nil, nil
+		return
+	}
+
+	current, err := readNetworkRules(ctx, rules.enterNS(), pid, rules.iptables.GetEnableIpv6())
+	if err != nil {
+		log.Error(err, "error while reading network rules")
+		return
+	}
+
+	missing := rules.missing(s.tcBackend, current, time.Now())
+	if len(missing) == 0 {
+		return
+	}
+
+	rules.driftCount++
+	log.Info("network rules drifted, apply them again", "missing", missing, "driftCount", rules.driftCount)
+	for kind := range missing {
+		metrics.DefaultChaosDaemonMetricsCollector.ObserveNetworkRuleDrift(kind)
+	}
+
+	// the iptables rules and tc filters refer to the ipsets, so all of them are applied again in order
+	if rules.ipsets != nil {
+		if err := s.flushIPSets(ctx, rules.ipsets); err != nil {
+			log.Error(err, "error while applying ipsets again")
+			return
+		}
+	}
+	if rules.iptables != nil {
+		if err := s.setIptablesChains(ctx, rules.iptables); err != nil {
+			log.Error(err, "error while applying iptables chains again")
+			return
+		}
+	}
+	if rules.tcs != nil {
+		if err := s.applyTcs(ctx, rules.tcs); err != nil {
+			log.Error(err, "error while applying tcs again")
+			return
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+var _ = Describe("network rules server", func() {
+	defer mock.With("MockContainerdClient", &test.MockClient{})()
+	logger, err := log.NewDefaultZapLogger()
+	Expect(err).To(BeNil())
+	s, _ := newDaemonServer(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd}, nil, logger)
+
+	Context("GetNetworkRules", func() {
+		It("should report the missing rules", func() {
+			defer mock.With("pid", 9527)()
+			defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+				switch args[3] {
+				case iptablesCmd:
+					if args[len(args)-1] == "-S" {
+						return exec.Command("printf", "%s", "-N CHAOS-INPUT\n-N TEST\n-A CHAOS-INPUT -j TEST\n")
+					}
+				case "tc":
+					return exec.Command("printf", "%s", "qdisc noqueue 0: dev lo root refcnt 2\n")
+				}
+				return exec.Command("echo", "-n")
+			})()
+
+			_, err := s.SetIptablesChains(context.TODO(), &pb.IptablesChainsRequest{
+				Chains: []*pb.Chain{
+					{Name: "TEST", Direction: pb.Chain_INPUT},
+					{Name: "TEST-OUT", Direction: pb.Chain_OUTPUT},
+				},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+
+			resp, err := s.GetNetworkRules(context.TODO(), &pb.NetworkRulesRequest{
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Tracked).To(BeTrue())
+			Expect(resp.Iptables).To(HaveLen(3))
+			Expect(resp.Missing).To(Equal([]string{"iptables TEST-OUT"}))
+
+			_, err = s.SetIptablesChains(context.TODO(), &pb.IptablesChainsRequest{
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+
+			resp, err = s.GetNetworkRules(context.TODO(), &pb.NetworkRulesRequest{
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(resp.Tracked).To(BeFalse())
+			Expect(resp.Missing).To(BeEmpty())
+		})
+	})
+
+	Context("missing", func() {
+		It("should check the qdiscs of active tcs", func() {
+			now := time.Now()
+			rules := &networkRules{
+				ipsets: &pb.IPSetsRequest{
+					Ipsets: []*pb.IPSet{{Name: "chaos-tgt"}, {Name: "chaos-src"}},
+				},
+				tcs: &pb.TcsRequest{
+					Tcs: []*pb.Tc{
+						{Type: pb.Tc_NETEM},
+						{Type: pb.Tc_NETEM, Ingress: true},
+						{Type: pb.Tc_NETEM, Device: "eth1", Flap: &pb.Flap{
+							UpDuration:   int64(time.Minute),
+							DownDuration: int64(time.Minute),
+							StartTime:    now.Add(-90 * time.Second).UnixNano(),
+						}},
+					},
+				},
+			}
+
+			missing := rules.missing(&netemTcBackend{}, &pb.NetworkRulesResponse{
+				Ipsets: []string{"chaos-tgt"},
+				Qdiscs: []string{"qdisc netem 1: dev eth0 root refcnt 2 limit 1000 delay 100ms"},
+			}, now)
+			Expect(missing).To(Equal(map[string][]string{
+				"ipset": {"chaos-src"},
+				"tc":    {"ifbeth0"},
+			}))
+		})
+
+		It("should compare the kinds of qdiscs", func() {
+			rules := &networkRules{
+				tcs: &pb.TcsRequest{
+					Tcs: []*pb.Tc{
+						{Type: pb.Tc_NETEM},
+						{Type: pb.Tc_BANDWIDTH, Ipset: "chaos-tgt"},
+					},
+				},
+			}
+
+			qdiscs := []string{
+				"qdisc netem 1: dev eth0 root refcnt 2 limit 1000 delay 100ms",
+				"qdisc prio 2: dev eth0 parent 1: bands 4 priomap 1 2 2 2 1 2 0 0 1 1 1 1 1 1 1 1",
+				"qdisc sfq 3: dev eth0 parent 2:1 limit 127p quantum 1514b depth 127 divisor 1024",
+				"qdisc sfq 4: dev eth0 parent 2:2 limit 127p quantum 1514b depth 127 divisor 1024",
+				"qdisc sfq 5: dev eth0 parent 2:3 limit 127p quantum 1514b depth 127 divisor 1024",
+				"qdisc tbf 6: dev eth0 parent 2:4 rate 1Mbit burst 10000b lat 0us",
+			}
+			Expect(rules.missing(&netemTcBackend{}, &pb.NetworkRulesResponse{Qdiscs: qdiscs}, time.Now())).To(BeEmpty())
+
+			// the tbf under the prio qdisc is removed, while the root qdisc is still there
+			missing := rules.missing(&netemTcBackend{}, &pb.NetworkRulesResponse{Qdiscs: qdiscs[:5]}, time.Now())
+			Expect(missing).To(Equal(map[string][]string{"tc": {"eth0"}}))
+
+			// the root qdisc is replaced
+			missing = rules.missing(&netemTcBackend{}, &pb.NetworkRulesResponse{Qdiscs: append([]string{
+				"qdisc fq_codel 0: dev eth0 root refcnt 2",
+			}, qdiscs[1:]...)}, time.Now())
+			Expect(missing).To(Equal(map[string][]string{"tc": {"eth0"}}))
+
+			Expect(rules.missing(&bpfTcBackend{}, &pb.NetworkRulesResponse{Qdiscs: []string{
+				"qdisc fq 1: dev eth0 root refcnt 2 limit 10000p flow_limit 100p",
+				"qdisc clsact ffff: dev eth0 parent ffff:fff1",
+			}}, time.Now())).To(BeEmpty())
+		})
+	})
+})
@@ -119,7 +119,7 @@ func (x ContainerAction_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ContainerAction_Action.Descriptor instead.
 func (ContainerAction_Action) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{19, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type ExecStressRequest_Scope int32
@@ -165,7 +165,7 @@ func (x ExecStressRequest_Scope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExecStressRequest_Scope.Descriptor instead.
 func (ExecStressRequest_Scope) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{20, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type Tc_Type int32
@@ -211,22 +211,74 @@ func (x Tc_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Tc_Type.Descriptor instead.
 func (Tc_Type) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{41, 0}
+}
+
+type NetemProfile_Type int32
+
+const (
+	NetemProfile_RAMP NetemProfile_Type = 0
+	NetemProfile_STEP NetemProfile_Type = 1
+	NetemProfile_SINE NetemProfile_Type = 2
+)
+
+// Enum value maps for NetemProfile_Type.
+var (
+	NetemProfile_Type_name = map[int32]string{
+		0: "RAMP",
+		1: "STEP",
+		2: "SINE",
+	}
+	NetemProfile_Type_value = map[string]int32{
+		"RAMP": 0,
+		"STEP": 1,
+		"SINE": 2,
+	}
+)
+
+func (x NetemProfile_Type) Enum() *NetemProfile_Type {
+	p := new(NetemProfile_Type)
+	*p = x
+	return p
+}
+
+func (x NetemProfile_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NetemProfile_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_chaosdaemon_proto_enumTypes[4].Descriptor()
+}
+
+func (NetemProfile_Type) Type() protoreflect.EnumType {
+	return &file_chaosdaemon_proto_enumTypes[4]
+}
+
+func (x NetemProfile_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NetemProfile_Type.Descriptor instead.
+func (NetemProfile_Type) EnumDescriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{43, 0}
 }
 
 type ApplyBlockChaosRequest_Action int32
 
 const (
 	ApplyBlockChaosRequest_Delay ApplyBlockChaosRequest_Action = 0
+	ApplyBlockChaosRequest_Limit ApplyBlockChaosRequest_Action = 1
 )
 
 // Enum value maps for ApplyBlockChaosRequest_Action.
 var (
 	ApplyBlockChaosRequest_Action_name = map[int32]string{
 		0: "Delay",
+		1: "Limit",
 	}
 	ApplyBlockChaosRequest_Action_value = map[string]int32{
 		"Delay": 0,
+		"Limit": 1,
 	}
 )
 
@@ -241,11 +293,11 @@ func (x ApplyBlockChaosRequest_Action) String() string {
 }
 
 func (ApplyBlockChaosRequest_Action) Descriptor() protoreflect.EnumDescriptor {
-	return file_chaosdaemon_proto_enumTypes[4].Descriptor()
+	return file_chaosdaemon_proto_enumTypes[5].Descriptor()
 }
 
 func (ApplyBlockChaosRequest_Action) Type() protoreflect.EnumType {
-	return &file_chaosdaemon_proto_enumTypes[4]
+	return &file_chaosdaemon_proto_enumTypes[5]
 }
 
 func (x ApplyBlockChaosRequest_Action) Number() protoreflect.EnumNumber {
@@ -254,7 +306,7 @@ func (x ApplyBlockChaosRequest_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplyBlockChaosRequest_Action.Descriptor instead.
 func (ApplyBlockChaosRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{52, 0}
 }
 
 type TcHandle struct {
@@ -1205,6 +1257,7 @@ type IPSet struct {
 	CidrAndPorts []*CidrAndPort `protobuf:"bytes,3,rep,name=cidr_and_ports,json=cidrAndPorts,proto3" json:"cidr_and_ports,omitempty"`
 	SetNames     []string       `protobuf:"bytes,4,rep,name=set_names,json=setNames,proto3" json:"set_names,omitempty"`
 	Type         string         `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Family       string         `protobuf:"bytes,6,opt,name=family,proto3" json:"family,omitempty"`
 }
 
 func (x *IPSet) Reset() {
@@ -1274,6 +1327,13 @@ func (x *IPSet) GetType() string {
 	return ""
 }
 
+func (x *IPSet) GetFamily() string {
+	if x != nil {
+		return x.Family
+	}
+	return ""
+}
+
 type CidrAndPort struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1337,6 +1397,7 @@ type IptablesChainsRequest struct {
 	Chains      []*Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
 	ContainerId string   `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	EnterNS     bool     `protobuf:"varint,3,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	EnableIpv6  bool     `protobuf:"varint,4,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
 }
 
 func (x *IptablesChainsRequest) Reset() {
@@ -1392,6 +1453,13 @@ func (x *IptablesChainsRequest) GetEnterNS() bool {
 	return false
 }
 
+func (x *IptablesChainsRequest) GetEnableIpv6() bool {
+	if x != nil {
+		return x.EnableIpv6
+	}
+	return false
+}
+
 type Chain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1406,6 +1474,11 @@ type Chain struct {
 	DestinationPorts string          `protobuf:"bytes,7,opt,name=destination_ports,json=destinationPorts,proto3" json:"destination_ports,omitempty"`
 	TcpFlags         string          `protobuf:"bytes,8,opt,name=tcp_flags,json=tcpFlags,proto3" json:"tcp_flags,omitempty"`
 	Device           string          `protobuf:"bytes,9,opt,name=device,proto3" json:"device,omitempty"`
+	// the response of rejected packets, only available when target is REJECT
+	RejectWith string `protobuf:"bytes,10,opt,name=reject_with,json=rejectWith,proto3" json:"reject_with,omitempty"`
+	// the probability (0, 1] of matching packets, all packets are matched if it's 0
+	Probability float32 `protobuf:"fixed32,11,opt,name=probability,proto3" json:"probability,omitempty"`
+	Flap        *Flap   `protobuf:"bytes,12,opt,name=flap,proto3" json:"flap,omitempty"`
 }
 
 func (x *Chain) Reset() {
@@ -1503,6 +1576,175 @@ func (x *Chain) GetDevice() string {
 	return ""
 }
 
+func (x *Chain) GetRejectWith() string {
+	if x != nil {
+		return x.RejectWith
+	}
+	return ""
+}
+
+func (x *Chain) GetProbability() float32 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *Chain) GetFlap() *Flap {
+	if x != nil {
+		return x.Flap
+	}
+	return nil
+}
+
+type NetworkRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	EnterNS     bool   `protobuf:"varint,2,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *NetworkRulesRequest) Reset() {
+	*x = NetworkRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkRulesRequest) ProtoMessage() {}
+
+func (x *NetworkRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkRulesRequest.ProtoReflect.Descriptor instead.
+func (*NetworkRulesRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NetworkRulesRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *NetworkRulesRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type NetworkRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the names of ipsets in the network namespace
+	Ipsets []string `protobuf:"bytes,1,rep,name=ipsets,proto3" json:"ipsets,omitempty"`
+	// the iptables rules in the network namespace, in the format of `iptables -S`
+	Iptables []string `protobuf:"bytes,2,rep,name=iptables,proto3" json:"iptables,omitempty"`
+	// the qdiscs in the network namespace, in the format of `tc qdisc show`
+	Qdiscs []string `protobuf:"bytes,3,rep,name=qdiscs,proto3" json:"qdiscs,omitempty"`
+	// whether the network rules of this container are applied by chaos daemon
+	Tracked bool `protobuf:"varint,4,opt,name=tracked,proto3" json:"tracked,omitempty"`
+	// the rules applied by the last requests but missing in the network namespace
+	Missing []string `protobuf:"bytes,5,rep,name=missing,proto3" json:"missing,omitempty"`
+	// the number of times the rules drifted and were applied again
+	DriftCount int64 `protobuf:"varint,6,opt,name=drift_count,json=driftCount,proto3" json:"drift_count,omitempty"`
+}
+
+func (x *NetworkRulesResponse) Reset() {
+	*x = NetworkRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkRulesResponse) ProtoMessage() {}
+
+func (x *NetworkRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkRulesResponse.ProtoReflect.Descriptor instead.
+func (*NetworkRulesResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NetworkRulesResponse) GetIpsets() []string {
+	if x != nil {
+		return x.Ipsets
+	}
+	return nil
+}
+
+func (x *NetworkRulesResponse) GetIptables() []string {
+	if x != nil {
+		return x.Iptables
+	}
+	return nil
+}
+
+func (x *NetworkRulesResponse) GetQdiscs() []string {
+	if x != nil {
+		return x.Qdiscs
+	}
+	return nil
+}
+
+func (x *NetworkRulesResponse) GetTracked() bool {
+	if x != nil {
+		return x.Tracked
+	}
+	return false
+}
+
+func (x *NetworkRulesResponse) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *NetworkRulesResponse) GetDriftCount() int64 {
+	if x != nil {
+		return x.DriftCount
+	}
+	return 0
+}
+
 type TimeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1519,7 +1761,7 @@ type TimeRequest struct {
 func (x *TimeRequest) Reset() {
 	*x = TimeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[18]
+		mi := &file_chaosdaemon_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1532,7 +1774,7 @@ func (x *TimeRequest) String() string {
 func (*TimeRequest) ProtoMessage() {}
 
 func (x *TimeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[18]
+	mi := &file_chaosdaemon_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,7 +1787,7 @@ func (x *TimeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeRequest.ProtoReflect.Descriptor instead.
 func (*TimeRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{18}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *TimeRequest) GetContainerId() string {
@@ -1601,7 +1843,7 @@ type ContainerAction struct {
 func (x *ContainerAction) Reset() {
 	*x = ContainerAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[19]
+		mi := &file_chaosdaemon_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1614,7 +1856,7 @@ func (x *ContainerAction) String() string {
 func (*ContainerAction) ProtoMessage() {}
 
 func (x *ContainerAction) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[19]
+	mi := &file_chaosdaemon_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +1869,7 @@ func (x *ContainerAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainerAction.ProtoReflect.Descriptor instead.
 func (*ContainerAction) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{19}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ContainerAction) GetAction() ContainerAction_Action {
@@ -1653,7 +1895,7 @@ type ExecStressRequest struct {
 func (x *ExecStressRequest) Reset() {
 	*x = ExecStressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[20]
+		mi := &file_chaosdaemon_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1666,7 +1908,7 @@ func (x *ExecStressRequest) String() string {
 func (*ExecStressRequest) ProtoMessage() {}
 
 func (x *ExecStressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[20]
+	mi := &file_chaosdaemon_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +1921,7 @@ func (x *ExecStressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecStressRequest.ProtoReflect.Descriptor instead.
 func (*ExecStressRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{20}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ExecStressRequest) GetScope() ExecStressRequest_Scope {
@@ -1740,7 +1982,7 @@ type ExecStressResponse struct {
 func (x *ExecStressResponse) Reset() {
 	*x = ExecStressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[21]
+		mi := &file_chaosdaemon_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1753,7 +1995,7 @@ func (x *ExecStressResponse) String() string {
 func (*ExecStressResponse) ProtoMessage() {}
 
 func (x *ExecStressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[21]
+	mi := &file_chaosdaemon_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1766,7 +2008,7 @@ func (x *ExecStressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecStressResponse.ProtoReflect.Descriptor instead.
 func (*ExecStressResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{21}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ExecStressResponse) GetCpuInstance() string {
@@ -1827,7 +2069,7 @@ type CancelStressRequest struct {
 func (x *CancelStressRequest) Reset() {
 	*x = CancelStressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[22]
+		mi := &file_chaosdaemon_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1840,7 +2082,7 @@ func (x *CancelStressRequest) String() string {
 func (*CancelStressRequest) ProtoMessage() {}
 
 func (x *CancelStressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[22]
+	mi := &file_chaosdaemon_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +2095,7 @@ func (x *CancelStressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelStressRequest.ProtoReflect.Descriptor instead.
 func (*CancelStressRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{22}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CancelStressRequest) GetCpuInstance() string {
@@ -1915,7 +2157,7 @@ type ApplyIOChaosRequest struct {
 func (x *ApplyIOChaosRequest) Reset() {
 	*x = ApplyIOChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[23]
+		mi := &file_chaosdaemon_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1928,7 +2170,7 @@ func (x *ApplyIOChaosRequest) String() string {
 func (*ApplyIOChaosRequest) ProtoMessage() {}
 
 func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[23]
+	mi := &file_chaosdaemon_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1941,7 +2183,7 @@ func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ApplyIOChaosRequest) GetActions() string {
@@ -2006,7 +2248,7 @@ type ApplyIOChaosResponse struct {
 func (x *ApplyIOChaosResponse) Reset() {
 	*x = ApplyIOChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[24]
+		mi := &file_chaosdaemon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2019,7 +2261,7 @@ func (x *ApplyIOChaosResponse) String() string {
 func (*ApplyIOChaosResponse) ProtoMessage() {}
 
 func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[24]
+	mi := &file_chaosdaemon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2032,7 +2274,7 @@ func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ApplyIOChaosResponse) GetInstance() int64 {
@@ -2061,20 +2303,21 @@ type ApplyHttpChaosRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Rules       string   `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
-	ProxyPorts  []uint32 `protobuf:"varint,2,rep,packed,name=proxy_ports,json=proxyPorts,proto3" json:"proxy_ports,omitempty"`
-	ContainerId string   `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Instance    int64    `protobuf:"varint,4,opt,name=instance,proto3" json:"instance,omitempty"`
-	StartTime   int64    `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EnterNS     bool     `protobuf:"varint,6,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
-	InstanceUid string   `protobuf:"bytes,7,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
-	Tls         string   `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	Rules         string              `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	ProxyPorts    []uint32            `protobuf:"varint,2,rep,packed,name=proxy_ports,json=proxyPorts,proto3" json:"proxy_ports,omitempty"`
+	ContainerId   string              `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Instance      int64               `protobuf:"varint,4,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime     int64               `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EnterNS       bool                `protobuf:"varint,6,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	InstanceUid   string              `protobuf:"bytes,7,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	Tls           string              `protobuf:"bytes,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	EgressTargets []*HttpEgressTarget `protobuf:"bytes,9,rep,name=egress_targets,json=egressTargets,proto3" json:"egress_targets,omitempty"`
 }
 
 func (x *ApplyHttpChaosRequest) Reset() {
 	*x = ApplyHttpChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[25]
+		mi := &file_chaosdaemon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2087,7 +2330,7 @@ func (x *ApplyHttpChaosRequest) String() string {
 func (*ApplyHttpChaosRequest) ProtoMessage() {}
 
 func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[25]
+	mi := &file_chaosdaemon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,7 +2343,7 @@ func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyHttpChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyHttpChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ApplyHttpChaosRequest) GetRules() string {
@@ -2159,35 +2402,40 @@ func (x *ApplyHttpChaosRequest) GetTls() string {
 	return ""
 }
 
-type ApplyHttpChaosResponse struct {
+func (x *ApplyHttpChaosRequest) GetEgressTargets() []*HttpEgressTarget {
+	if x != nil {
+		return x.EgressTargets
+	}
+	return nil
+}
+
+type HttpEgressTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instance    int64  `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	StartTime   int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	StatusCode  int32  `protobuf:"varint,3,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
-	Error       string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
-	InstanceUid string `protobuf:"bytes,5,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	Port uint32 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// the cidrs of destinations, all the destinations are selected if it's empty
+	Cidrs []string `protobuf:"bytes,2,rep,name=cidrs,proto3" json:"cidrs,omitempty"`
 }
 
-func (x *ApplyHttpChaosResponse) Reset() {
-	*x = ApplyHttpChaosResponse{}
+func (x *HttpEgressTarget) Reset() {
+	*x = HttpEgressTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[26]
+		mi := &file_chaosdaemon_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApplyHttpChaosResponse) String() string {
+func (x *HttpEgressTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyHttpChaosResponse) ProtoMessage() {}
+func (*HttpEgressTarget) ProtoMessage() {}
 
-func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[26]
+func (x *HttpEgressTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,73 +2446,54 @@ func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyHttpChaosResponse.ProtoReflect.Descriptor instead.
-func (*ApplyHttpChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use HttpEgressTarget.ProtoReflect.Descriptor instead.
+func (*HttpEgressTarget) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ApplyHttpChaosResponse) GetInstance() int64 {
+func (x *HttpEgressTarget) GetPort() uint32 {
 	if x != nil {
-		return x.Instance
+		return x.Port
 	}
 	return 0
 }
 
-func (x *ApplyHttpChaosResponse) GetStartTime() int64 {
+func (x *HttpEgressTarget) GetCidrs() []string {
 	if x != nil {
-		return x.StartTime
+		return x.Cidrs
 	}
-	return 0
+	return nil
 }
 
-func (x *ApplyHttpChaosResponse) GetStatusCode() int32 {
-	if x != nil {
-		return x.StatusCode
-	}
-	return 0
+type ApplyHttpChaosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance    int64  `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime   int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	StatusCode  int32  `protobuf:"varint,3,opt,name=statusCode,proto3" json:"statusCode,omitempty"`
+	Error       string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	InstanceUid string `protobuf:"bytes,5,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
 }
 
-func (x *ApplyHttpChaosResponse) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
-}
-
-func (x *ApplyHttpChaosResponse) GetInstanceUid() string {
-	if x != nil {
-		return x.InstanceUid
-	}
-	return ""
-}
-
-type TcsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Tcs         []*Tc  `protobuf:"bytes,1,rep,name=tcs,proto3" json:"tcs,omitempty"`
-	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
-}
-
-func (x *TcsRequest) Reset() {
-	*x = TcsRequest{}
+func (x *ApplyHttpChaosResponse) Reset() {
+	*x = ApplyHttpChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[27]
+		mi := &file_chaosdaemon_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TcsRequest) String() string {
+func (x *ApplyHttpChaosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TcsRequest) ProtoMessage() {}
+func (*ApplyHttpChaosResponse) ProtoMessage() {}
 
-func (x *TcsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[27]
+func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,64 +2504,75 @@ func (x *TcsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TcsRequest.ProtoReflect.Descriptor instead.
-func (*TcsRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ApplyHttpChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyHttpChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *TcsRequest) GetTcs() []*Tc {
+func (x *ApplyHttpChaosResponse) GetInstance() int64 {
 	if x != nil {
-		return x.Tcs
+		return x.Instance
 	}
-	return nil
+	return 0
 }
 
-func (x *TcsRequest) GetContainerId() string {
+func (x *ApplyHttpChaosResponse) GetStartTime() int64 {
 	if x != nil {
-		return x.ContainerId
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *ApplyHttpChaosResponse) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *ApplyHttpChaosResponse) GetError() string {
+	if x != nil {
+		return x.Error
 	}
 	return ""
 }
 
-func (x *TcsRequest) GetEnterNS() bool {
+func (x *ApplyHttpChaosResponse) GetInstanceUid() string {
 	if x != nil {
-		return x.EnterNS
+		return x.InstanceUid
 	}
-	return false
+	return ""
 }
 
-type Tc struct {
+type HttpChaosStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       Tc_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Tc_Type" json:"type,omitempty"`
-	Netem      *Netem  `protobuf:"bytes,2,opt,name=netem,proto3" json:"netem,omitempty"`
-	Tbf        *Tbf    `protobuf:"bytes,3,opt,name=tbf,proto3" json:"tbf,omitempty"`
-	Ipset      string  `protobuf:"bytes,4,opt,name=ipset,proto3" json:"ipset,omitempty"`
-	Protocol   string  `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	SourcePort string  `protobuf:"bytes,6,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	EgressPort string  `protobuf:"bytes,7,opt,name=egress_port,json=egressPort,proto3" json:"egress_port,omitempty"`
-	Device     string  `protobuf:"bytes,9,opt,name=device,proto3" json:"device,omitempty"`
+	Instance    int64  `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime   int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	InstanceUid string `protobuf:"bytes,3,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	// the container where tproxy runs in, used to label the metrics of observations
+	ContainerId string `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 }
 
-func (x *Tc) Reset() {
-	*x = Tc{}
+func (x *HttpChaosStatsRequest) Reset() {
+	*x = HttpChaosStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[28]
+		mi := &file_chaosdaemon_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Tc) String() string {
+func (x *HttpChaosStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tc) ProtoMessage() {}
+func (*HttpChaosStatsRequest) ProtoMessage() {}
 
-func (x *Tc) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[28]
+func (x *HttpChaosStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2343,95 +2583,114 @@ func (x *Tc) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tc.ProtoReflect.Descriptor instead.
-func (*Tc) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use HttpChaosStatsRequest.ProtoReflect.Descriptor instead.
+func (*HttpChaosStatsRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Tc) GetType() Tc_Type {
+func (x *HttpChaosStatsRequest) GetInstance() int64 {
 	if x != nil {
-		return x.Type
+		return x.Instance
 	}
-	return Tc_NETEM
+	return 0
 }
 
-func (x *Tc) GetNetem() *Netem {
+func (x *HttpChaosStatsRequest) GetStartTime() int64 {
 	if x != nil {
-		return x.Netem
+		return x.StartTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Tc) GetTbf() *Tbf {
+func (x *HttpChaosStatsRequest) GetInstanceUid() string {
 	if x != nil {
-		return x.Tbf
+		return x.InstanceUid
 	}
-	return nil
+	return ""
 }
 
-func (x *Tc) GetIpset() string {
+func (x *HttpChaosStatsRequest) GetContainerId() string {
 	if x != nil {
-		return x.Ipset
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *Tc) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
-	}
-	return ""
+type HttpChaosStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the counters of every rule, in the same order as the rules applied
+	Rules []*HttpChaosRuleStats `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *Tc) GetSourcePort() string {
-	if x != nil {
-		return x.SourcePort
+func (x *HttpChaosStatsResponse) Reset() {
+	*x = HttpChaosStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Tc) GetEgressPort() string {
-	if x != nil {
-		return x.EgressPort
+func (x *HttpChaosStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpChaosStatsResponse) ProtoMessage() {}
+
+func (x *HttpChaosStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Tc) GetDevice() string {
+// Deprecated: Use HttpChaosStatsResponse.ProtoReflect.Descriptor instead.
+func (*HttpChaosStatsResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HttpChaosStatsResponse) GetRules() []*HttpChaosRuleStats {
 	if x != nil {
-		return x.Device
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type SetDNSServerRequest struct {
+type HttpChaosRuleStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	DnsServer   string `protobuf:"bytes,2,opt,name=dns_server,json=dnsServer,proto3" json:"dns_server,omitempty"`
-	Enable      bool   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
-	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	Matched      int64              `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Faulted      int64              `protobuf:"varint,2,opt,name=faulted,proto3" json:"faulted,omitempty"`
+	Observations []*HttpObservation `protobuf:"bytes,3,rep,name=observations,proto3" json:"observations,omitempty"`
 }
 
-func (x *SetDNSServerRequest) Reset() {
-	*x = SetDNSServerRequest{}
+func (x *HttpChaosRuleStats) Reset() {
+	*x = HttpChaosRuleStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[29]
+		mi := &file_chaosdaemon_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetDNSServerRequest) String() string {
+func (x *HttpChaosRuleStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDNSServerRequest) ProtoMessage() {}
+func (*HttpChaosRuleStats) ProtoMessage() {}
 
-func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[29]
+func (x *HttpChaosRuleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,67 +2701,63 @@ func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDNSServerRequest.ProtoReflect.Descriptor instead.
-func (*SetDNSServerRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *SetDNSServerRequest) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// Deprecated: Use HttpChaosRuleStats.ProtoReflect.Descriptor instead.
+func (*HttpChaosRuleStats) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SetDNSServerRequest) GetDnsServer() string {
+func (x *HttpChaosRuleStats) GetMatched() int64 {
 	if x != nil {
-		return x.DnsServer
+		return x.Matched
 	}
-	return ""
+	return 0
 }
 
-func (x *SetDNSServerRequest) GetEnable() bool {
+func (x *HttpChaosRuleStats) GetFaulted() int64 {
 	if x != nil {
-		return x.Enable
+		return x.Faulted
 	}
-	return false
+	return 0
 }
 
-func (x *SetDNSServerRequest) GetEnterNS() bool {
+func (x *HttpChaosRuleStats) GetObservations() []*HttpObservation {
 	if x != nil {
-		return x.EnterNS
+		return x.Observations
 	}
-	return false
+	return nil
 }
 
-type InstallJVMRulesRequest struct {
+type HttpObservation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Rule        string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Port        int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
-	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	Path       string  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Method     string  `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Code       int32   `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	Count      int64   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	LatencySum float64 `protobuf:"fixed64,5,opt,name=latency_sum,json=latencySum,proto3" json:"latency_sum,omitempty"`
+	// the cumulative counts of the buckets in tproxyconfig.ObservationLatencyBuckets
+	LatencyBuckets []uint64 `protobuf:"varint,6,rep,packed,name=latency_buckets,json=latencyBuckets,proto3" json:"latency_buckets,omitempty"`
 }
 
-func (x *InstallJVMRulesRequest) Reset() {
-	*x = InstallJVMRulesRequest{}
+func (x *HttpObservation) Reset() {
+	*x = HttpObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[30]
+		mi := &file_chaosdaemon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InstallJVMRulesRequest) String() string {
+func (x *HttpObservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InstallJVMRulesRequest) ProtoMessage() {}
+func (*HttpObservation) ProtoMessage() {}
 
-func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[30]
+func (x *HttpObservation) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,67 +2768,87 @@ func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InstallJVMRulesRequest.ProtoReflect.Descriptor instead.
-func (*InstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use HttpObservation.ProtoReflect.Descriptor instead.
+func (*HttpObservation) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *InstallJVMRulesRequest) GetContainerId() string {
+func (x *HttpObservation) GetPath() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *InstallJVMRulesRequest) GetRule() string {
+func (x *HttpObservation) GetMethod() string {
 	if x != nil {
-		return x.Rule
+		return x.Method
 	}
 	return ""
 }
 
-func (x *InstallJVMRulesRequest) GetPort() int32 {
+func (x *HttpObservation) GetCode() int32 {
 	if x != nil {
-		return x.Port
+		return x.Code
 	}
 	return 0
 }
 
-func (x *InstallJVMRulesRequest) GetEnterNS() bool {
+func (x *HttpObservation) GetCount() int64 {
 	if x != nil {
-		return x.EnterNS
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-type UninstallJVMRulesRequest struct {
+func (x *HttpObservation) GetLatencySum() float64 {
+	if x != nil {
+		return x.LatencySum
+	}
+	return 0
+}
+
+func (x *HttpObservation) GetLatencyBuckets() []uint64 {
+	if x != nil {
+		return x.LatencyBuckets
+	}
+	return nil
+}
+
+type ApplyGrpcChaosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Rule        string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	Port        int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// the json of rules, in the format of tproxyconfig.GrpcRule
+	Rules       string `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	Port        uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	ContainerId string `protobuf:"bytes,3,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
 	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	// not used, the proxy is found by the container and port
+	InstanceUid string `protobuf:"bytes,5,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	// the namespaced name of GRPCChaos, the rules of all the sources on the same
+	// port are merged into one proxy
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *UninstallJVMRulesRequest) Reset() {
-	*x = UninstallJVMRulesRequest{}
+func (x *ApplyGrpcChaosRequest) Reset() {
+	*x = ApplyGrpcChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[31]
+		mi := &file_chaosdaemon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UninstallJVMRulesRequest) String() string {
+func (x *ApplyGrpcChaosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UninstallJVMRulesRequest) ProtoMessage() {}
+func (*ApplyGrpcChaosRequest) ProtoMessage() {}
 
-func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[31]
+func (x *ApplyGrpcChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2584,68 +2859,78 @@ func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UninstallJVMRulesRequest.ProtoReflect.Descriptor instead.
-func (*UninstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ApplyGrpcChaosRequest.ProtoReflect.Descriptor instead.
+func (*ApplyGrpcChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *UninstallJVMRulesRequest) GetContainerId() string {
+func (x *ApplyGrpcChaosRequest) GetRules() string {
 	if x != nil {
-		return x.ContainerId
+		return x.Rules
 	}
 	return ""
 }
 
-func (x *UninstallJVMRulesRequest) GetRule() string {
+func (x *ApplyGrpcChaosRequest) GetPort() uint32 {
 	if x != nil {
-		return x.Rule
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *UninstallJVMRulesRequest) GetPort() int32 {
+func (x *ApplyGrpcChaosRequest) GetContainerId() string {
 	if x != nil {
-		return x.Port
+		return x.ContainerId
 	}
-	return 0
+	return ""
 }
 
-func (x *UninstallJVMRulesRequest) GetEnterNS() bool {
+func (x *ApplyGrpcChaosRequest) GetEnterNS() bool {
 	if x != nil {
 		return x.EnterNS
 	}
 	return false
 }
 
-type ApplyBlockChaosRequest struct {
+func (x *ApplyGrpcChaosRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+func (x *ApplyGrpcChaosRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ApplyGrpcChaosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string                        `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	VolumePath  string                        `protobuf:"bytes,2,opt,name=volume_path,json=volumePath,proto3" json:"volume_path,omitempty"`
-	Action      ApplyBlockChaosRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=pb.ApplyBlockChaosRequest_Action" json:"action,omitempty"`
-	Delay       *BlockDelaySpec               `protobuf:"bytes,5,opt,name=delay,proto3" json:"delay,omitempty"`
-	EnterNS     bool                          `protobuf:"varint,6,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
 }
 
-func (x *ApplyBlockChaosRequest) Reset() {
-	*x = ApplyBlockChaosRequest{}
+func (x *ApplyGrpcChaosResponse) Reset() {
+	*x = ApplyGrpcChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[32]
+		mi := &file_chaosdaemon_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApplyBlockChaosRequest) String() string {
+func (x *ApplyGrpcChaosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyBlockChaosRequest) ProtoMessage() {}
+func (*ApplyGrpcChaosResponse) ProtoMessage() {}
 
-func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[32]
+func (x *ApplyGrpcChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,73 +2941,45 @@ func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyBlockChaosRequest.ProtoReflect.Descriptor instead.
-func (*ApplyBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *ApplyBlockChaosRequest) GetContainerId() string {
-	if x != nil {
-		return x.ContainerId
-	}
-	return ""
+// Deprecated: Use ApplyGrpcChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyGrpcChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *ApplyBlockChaosRequest) GetVolumePath() string {
+func (x *ApplyGrpcChaosResponse) GetInstanceUid() string {
 	if x != nil {
-		return x.VolumePath
+		return x.InstanceUid
 	}
 	return ""
 }
 
-func (x *ApplyBlockChaosRequest) GetAction() ApplyBlockChaosRequest_Action {
-	if x != nil {
-		return x.Action
-	}
-	return ApplyBlockChaosRequest_Delay
-}
-
-func (x *ApplyBlockChaosRequest) GetDelay() *BlockDelaySpec {
-	if x != nil {
-		return x.Delay
-	}
-	return nil
-}
-
-func (x *ApplyBlockChaosRequest) GetEnterNS() bool {
-	if x != nil {
-		return x.EnterNS
-	}
-	return false
-}
-
-type BlockDelaySpec struct {
+type RecoverGrpcChaosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Delay       int64   `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
-	Correlation float64 `protobuf:"fixed64,2,opt,name=correlation,proto3" json:"correlation,omitempty"`
-	Jitter      int64   `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	// the rules of source are removed, and the proxy is stopped without any rule
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *BlockDelaySpec) Reset() {
-	*x = BlockDelaySpec{}
+func (x *RecoverGrpcChaosRequest) Reset() {
+	*x = RecoverGrpcChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[33]
+		mi := &file_chaosdaemon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockDelaySpec) String() string {
+func (x *RecoverGrpcChaosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockDelaySpec) ProtoMessage() {}
+func (*RecoverGrpcChaosRequest) ProtoMessage() {}
 
-func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[33]
+func (x *RecoverGrpcChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,58 +2990,61 @@ func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockDelaySpec.ProtoReflect.Descriptor instead.
-func (*BlockDelaySpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *BlockDelaySpec) GetDelay() int64 {
-	if x != nil {
-		return x.Delay
-	}
-	return 0
+// Deprecated: Use RecoverGrpcChaosRequest.ProtoReflect.Descriptor instead.
+func (*RecoverGrpcChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *BlockDelaySpec) GetCorrelation() float64 {
+func (x *RecoverGrpcChaosRequest) GetInstanceUid() string {
 	if x != nil {
-		return x.Correlation
+		return x.InstanceUid
 	}
-	return 0
+	return ""
 }
 
-func (x *BlockDelaySpec) GetJitter() int64 {
+func (x *RecoverGrpcChaosRequest) GetSource() string {
 	if x != nil {
-		return x.Jitter
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-type BlockLimitSpec struct {
+type ApplyProtocolChaosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Quota    uint64 `protobuf:"varint,1,opt,name=quota,proto3" json:"quota,omitempty"`
-	PeriodUs uint64 `protobuf:"varint,2,opt,name=period_us,json=periodUs,proto3" json:"period_us,omitempty"`
-}
-
-func (x *BlockLimitSpec) Reset() {
-	*x = BlockLimitSpec{}
+	// the wire protocol of the target server, redis, mysql or kafka
+	Protocol string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// the json of rules, in the format of tproxyconfig.ProtocolRule
+	Rules       string `protobuf:"bytes,2,opt,name=rules,proto3" json:"rules,omitempty"`
+	Port        uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	ContainerId string `protobuf:"bytes,4,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	EnterNS     bool   `protobuf:"varint,5,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	// not used, the proxy is found by the container and port
+	InstanceUid string `protobuf:"bytes,6,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	// the namespaced name of ProtocolChaos, the rules of all the sources on the
+	// same port are merged into one proxy
+	Source string `protobuf:"bytes,7,opt,name=source,proto3" json:"source,omitempty"`
+}
+
+func (x *ApplyProtocolChaosRequest) Reset() {
+	*x = ApplyProtocolChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[34]
+		mi := &file_chaosdaemon_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlockLimitSpec) String() string {
+func (x *ApplyProtocolChaosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockLimitSpec) ProtoMessage() {}
+func (*ApplyProtocolChaosRequest) ProtoMessage() {}
 
-func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[34]
+func (x *ApplyProtocolChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2795,50 +3055,85 @@ func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockLimitSpec.ProtoReflect.Descriptor instead.
-func (*BlockLimitSpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ApplyProtocolChaosRequest.ProtoReflect.Descriptor instead.
+func (*ApplyProtocolChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BlockLimitSpec) GetQuota() uint64 {
+func (x *ApplyProtocolChaosRequest) GetProtocol() string {
 	if x != nil {
-		return x.Quota
+		return x.Protocol
 	}
-	return 0
+	return ""
+}
+
+func (x *ApplyProtocolChaosRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
 }
 
-func (x *BlockLimitSpec) GetPeriodUs() uint64 {
+func (x *ApplyProtocolChaosRequest) GetPort() uint32 {
 	if x != nil {
-		return x.PeriodUs
+		return x.Port
 	}
 	return 0
 }
 
-type ApplyBlockChaosResponse struct {
+func (x *ApplyProtocolChaosRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ApplyProtocolChaosRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+func (x *ApplyProtocolChaosRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+func (x *ApplyProtocolChaosRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type ApplyProtocolChaosResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InjectionId int32 `protobuf:"varint,1,opt,name=injection_id,json=injectionId,proto3" json:"injection_id,omitempty"`
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
 }
 
-func (x *ApplyBlockChaosResponse) Reset() {
-	*x = ApplyBlockChaosResponse{}
+func (x *ApplyProtocolChaosResponse) Reset() {
+	*x = ApplyProtocolChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[35]
+		mi := &file_chaosdaemon_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ApplyBlockChaosResponse) String() string {
+func (x *ApplyProtocolChaosResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyBlockChaosResponse) ProtoMessage() {}
+func (*ApplyProtocolChaosResponse) ProtoMessage() {}
 
-func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[35]
+func (x *ApplyProtocolChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,43 +3144,45 @@ func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyBlockChaosResponse.ProtoReflect.Descriptor instead.
-func (*ApplyBlockChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ApplyProtocolChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyProtocolChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ApplyBlockChaosResponse) GetInjectionId() int32 {
+func (x *ApplyProtocolChaosResponse) GetInstanceUid() string {
 	if x != nil {
-		return x.InjectionId
+		return x.InstanceUid
 	}
-	return 0
+	return ""
 }
 
-type RecoverBlockChaosRequest struct {
+type RecoverProtocolChaosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InjectionId int32 `protobuf:"varint,1,opt,name=injection_id,json=injectionId,proto3" json:"injection_id,omitempty"`
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+	// the rules of source are removed, and the proxy is stopped without any rule
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
 }
 
-func (x *RecoverBlockChaosRequest) Reset() {
-	*x = RecoverBlockChaosRequest{}
+func (x *RecoverProtocolChaosRequest) Reset() {
+	*x = RecoverProtocolChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[36]
+		mi := &file_chaosdaemon_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RecoverBlockChaosRequest) String() string {
+func (x *RecoverProtocolChaosRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverBlockChaosRequest) ProtoMessage() {}
+func (*RecoverProtocolChaosRequest) ProtoMessage() {}
 
-func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[36]
+func (x *RecoverProtocolChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,52 +3193,53 @@ func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverBlockChaosRequest.ProtoReflect.Descriptor instead.
-func (*RecoverBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use RecoverProtocolChaosRequest.ProtoReflect.Descriptor instead.
+func (*RecoverProtocolChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RecoverBlockChaosRequest) GetInjectionId() int32 {
+func (x *RecoverProtocolChaosRequest) GetInstanceUid() string {
 	if x != nil {
-		return x.InjectionId
+		return x.InstanceUid
 	}
-	return 0
+	return ""
 }
 
-type RuntimeMutatorRequest struct {
+func (x *RecoverProtocolChaosRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type TcsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
-	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
-	Class       string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
-	Method      string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
-	Signature   string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
-	From        string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
-	To          string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
-	Strategy    string `protobuf:"bytes,8,opt,name=strategy,proto3" json:"strategy,omitempty"`
-	Port        int32  `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
-	EnterNS     bool   `protobuf:"varint,10,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	Tcs         []*Tc  `protobuf:"bytes,1,rep,name=tcs,proto3" json:"tcs,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	EnableIpv6  bool   `protobuf:"varint,5,opt,name=enable_ipv6,json=enableIpv6,proto3" json:"enable_ipv6,omitempty"`
 }
 
-func (x *RuntimeMutatorRequest) Reset() {
-	*x = RuntimeMutatorRequest{}
+func (x *TcsRequest) Reset() {
+	*x = TcsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[37]
+		mi := &file_chaosdaemon_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RuntimeMutatorRequest) String() string {
+func (x *TcsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuntimeMutatorRequest) ProtoMessage() {}
+func (*TcsRequest) ProtoMessage() {}
 
-func (x *RuntimeMutatorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[37]
+func (x *TcsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2952,51 +3250,1260 @@ func (x *RuntimeMutatorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuntimeMutatorRequest.ProtoReflect.Descriptor instead.
-func (*RuntimeMutatorRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use TcsRequest.ProtoReflect.Descriptor instead.
+func (*TcsRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RuntimeMutatorRequest) GetContainerId() string {
+func (x *TcsRequest) GetTcs() []*Tc {
 	if x != nil {
-		return x.ContainerId
+		return x.Tcs
 	}
-	return ""
+	return nil
 }
 
-func (x *RuntimeMutatorRequest) GetAction() string {
+func (x *TcsRequest) GetContainerId() string {
 	if x != nil {
-		return x.Action
+		return x.ContainerId
 	}
 	return ""
 }
 
-func (x *RuntimeMutatorRequest) GetClass() string {
+func (x *TcsRequest) GetEnterNS() bool {
 	if x != nil {
-		return x.Class
+		return x.EnterNS
 	}
-	return ""
+	return false
 }
 
-func (x *RuntimeMutatorRequest) GetMethod() string {
+func (x *TcsRequest) GetEnableIpv6() bool {
 	if x != nil {
-		return x.Method
+		return x.EnableIpv6
 	}
-	return ""
+	return false
 }
 
-func (x *RuntimeMutatorRequest) GetSignature() string {
-	if x != nil {
-		return x.Signature
-	}
-	return ""
+type Tc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       Tc_Type       `protobuf:"varint,1,opt,name=type,proto3,enum=pb.Tc_Type" json:"type,omitempty"`
+	Netem      *Netem        `protobuf:"bytes,2,opt,name=netem,proto3" json:"netem,omitempty"`
+	Tbf        *Tbf          `protobuf:"bytes,3,opt,name=tbf,proto3" json:"tbf,omitempty"`
+	Ipset      string        `protobuf:"bytes,4,opt,name=ipset,proto3" json:"ipset,omitempty"`
+	Protocol   string        `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	SourcePort string        `protobuf:"bytes,6,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	EgressPort string        `protobuf:"bytes,7,opt,name=egress_port,json=egressPort,proto3" json:"egress_port,omitempty"`
+	Device     string        `protobuf:"bytes,9,opt,name=device,proto3" json:"device,omitempty"`
+	Ingress    bool          `protobuf:"varint,10,opt,name=ingress,proto3" json:"ingress,omitempty"`
+	Profile    *NetemProfile `protobuf:"bytes,11,opt,name=profile,proto3" json:"profile,omitempty"`
+	Flap       *Flap         `protobuf:"bytes,12,opt,name=flap,proto3" json:"flap,omitempty"`
 }
 
-func (x *RuntimeMutatorRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (x *Tc) Reset() {
+	*x = Tc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
+}
+
+func (x *Tc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tc) ProtoMessage() {}
+
+func (x *Tc) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tc.ProtoReflect.Descriptor instead.
+func (*Tc) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Tc) GetType() Tc_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Tc_NETEM
+}
+
+func (x *Tc) GetNetem() *Netem {
+	if x != nil {
+		return x.Netem
+	}
+	return nil
+}
+
+func (x *Tc) GetTbf() *Tbf {
+	if x != nil {
+		return x.Tbf
+	}
+	return nil
+}
+
+func (x *Tc) GetIpset() string {
+	if x != nil {
+		return x.Ipset
+	}
+	return ""
+}
+
+func (x *Tc) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Tc) GetSourcePort() string {
+	if x != nil {
+		return x.SourcePort
+	}
+	return ""
+}
+
+func (x *Tc) GetEgressPort() string {
+	if x != nil {
+		return x.EgressPort
+	}
+	return ""
+}
+
+func (x *Tc) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *Tc) GetIngress() bool {
+	if x != nil {
+		return x.Ingress
+	}
+	return false
+}
+
+func (x *Tc) GetProfile() *NetemProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *Tc) GetFlap() *Flap {
+	if x != nil {
+		return x.Flap
+	}
+	return nil
+}
+
+type Flap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// durations in nanoseconds
+	UpDuration   int64 `protobuf:"varint,1,opt,name=up_duration,json=upDuration,proto3" json:"up_duration,omitempty"`
+	DownDuration int64 `protobuf:"varint,2,opt,name=down_duration,json=downDuration,proto3" json:"down_duration,omitempty"`
+	Jitter       int64 `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// unix time in nanoseconds which the cycles start from
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *Flap) Reset() {
+	*x = Flap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Flap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Flap) ProtoMessage() {}
+
+func (x *Flap) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Flap.ProtoReflect.Descriptor instead.
+func (*Flap) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Flap) GetUpDuration() int64 {
+	if x != nil {
+		return x.UpDuration
+	}
+	return 0
+}
+
+func (x *Flap) GetDownDuration() int64 {
+	if x != nil {
+		return x.DownDuration
+	}
+	return 0
+}
+
+func (x *Flap) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *Flap) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+type NetemProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  NetemProfile_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=pb.NetemProfile_Type" json:"type,omitempty"`
+	Steps []*NetemProfileStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps,omitempty"`
+	// period of sine profile in nanoseconds
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// interval of updating netem in nanoseconds
+	Interval int64 `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	// unix time in nanoseconds which the offsets are relative to
+	StartTime int64 `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *NetemProfile) Reset() {
+	*x = NetemProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetemProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetemProfile) ProtoMessage() {}
+
+func (x *NetemProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetemProfile.ProtoReflect.Descriptor instead.
+func (*NetemProfile) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NetemProfile) GetType() NetemProfile_Type {
+	if x != nil {
+		return x.Type
+	}
+	return NetemProfile_RAMP
+}
+
+func (x *NetemProfile) GetSteps() []*NetemProfileStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *NetemProfile) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
+func (x *NetemProfile) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *NetemProfile) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+type NetemProfileStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// offset in nanoseconds
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// latency in nanoseconds
+	Latency int64   `protobuf:"varint,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	Loss    float32 `protobuf:"fixed32,3,opt,name=loss,proto3" json:"loss,omitempty"`
+	// rate in bytes per second
+	Rate uint64 `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *NetemProfileStep) Reset() {
+	*x = NetemProfileStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetemProfileStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetemProfileStep) ProtoMessage() {}
+
+func (x *NetemProfileStep) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetemProfileStep.ProtoReflect.Descriptor instead.
+func (*NetemProfileStep) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NetemProfileStep) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *NetemProfileStep) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *NetemProfileStep) GetLoss() float32 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *NetemProfileStep) GetRate() uint64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type SetDNSServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	DnsServer   string `protobuf:"bytes,2,opt,name=dns_server,json=dnsServer,proto3" json:"dns_server,omitempty"`
+	Enable      bool   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *SetDNSServerRequest) Reset() {
+	*x = SetDNSServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetDNSServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDNSServerRequest) ProtoMessage() {}
+
+func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDNSServerRequest.ProtoReflect.Descriptor instead.
+func (*SetDNSServerRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SetDNSServerRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *SetDNSServerRequest) GetDnsServer() string {
+	if x != nil {
+		return x.DnsServer
+	}
+	return ""
+}
+
+func (x *SetDNSServerRequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *SetDNSServerRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type ApplyDNSChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the json of rules, in the format of tproxyconfig.DNSRule
+	Rules       string `protobuf:"bytes,1,opt,name=rules,proto3" json:"rules,omitempty"`
+	ContainerId string `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	EnterNS     bool   `protobuf:"varint,3,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+	InstanceUid string `protobuf:"bytes,4,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+}
+
+func (x *ApplyDNSChaosRequest) Reset() {
+	*x = ApplyDNSChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDNSChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDNSChaosRequest) ProtoMessage() {}
+
+func (x *ApplyDNSChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDNSChaosRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDNSChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ApplyDNSChaosRequest) GetRules() string {
+	if x != nil {
+		return x.Rules
+	}
+	return ""
+}
+
+func (x *ApplyDNSChaosRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ApplyDNSChaosRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+func (x *ApplyDNSChaosRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+type ApplyDNSChaosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+}
+
+func (x *ApplyDNSChaosResponse) Reset() {
+	*x = ApplyDNSChaosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyDNSChaosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDNSChaosResponse) ProtoMessage() {}
+
+func (x *ApplyDNSChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDNSChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDNSChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ApplyDNSChaosResponse) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+type RecoverDNSChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceUid string `protobuf:"bytes,1,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+}
+
+func (x *RecoverDNSChaosRequest) Reset() {
+	*x = RecoverDNSChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverDNSChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverDNSChaosRequest) ProtoMessage() {}
+
+func (x *RecoverDNSChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverDNSChaosRequest.ProtoReflect.Descriptor instead.
+func (*RecoverDNSChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RecoverDNSChaosRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+type SetTrustedCARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	// the PEM encoded certificate of CA
+	Ca      string `protobuf:"bytes,2,opt,name=ca,proto3" json:"ca,omitempty"`
+	Enable  bool   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	EnterNS bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *SetTrustedCARequest) Reset() {
+	*x = SetTrustedCARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTrustedCARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTrustedCARequest) ProtoMessage() {}
+
+func (x *SetTrustedCARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTrustedCARequest.ProtoReflect.Descriptor instead.
+func (*SetTrustedCARequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetTrustedCARequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *SetTrustedCARequest) GetCa() string {
+	if x != nil {
+		return x.Ca
+	}
+	return ""
+}
+
+func (x *SetTrustedCARequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *SetTrustedCARequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type InstallJVMRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Rule        string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Port        int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *InstallJVMRulesRequest) Reset() {
+	*x = InstallJVMRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstallJVMRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallJVMRulesRequest) ProtoMessage() {}
+
+func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallJVMRulesRequest.ProtoReflect.Descriptor instead.
+func (*InstallJVMRulesRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *InstallJVMRulesRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *InstallJVMRulesRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *InstallJVMRulesRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *InstallJVMRulesRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type UninstallJVMRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Rule        string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Port        int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	EnterNS     bool   `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *UninstallJVMRulesRequest) Reset() {
+	*x = UninstallJVMRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallJVMRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallJVMRulesRequest) ProtoMessage() {}
+
+func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallJVMRulesRequest.ProtoReflect.Descriptor instead.
+func (*UninstallJVMRulesRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UninstallJVMRulesRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *UninstallJVMRulesRequest) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *UninstallJVMRulesRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *UninstallJVMRulesRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type ApplyBlockChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string                        `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	VolumePath  string                        `protobuf:"bytes,2,opt,name=volume_path,json=volumePath,proto3" json:"volume_path,omitempty"`
+	Action      ApplyBlockChaosRequest_Action `protobuf:"varint,3,opt,name=action,proto3,enum=pb.ApplyBlockChaosRequest_Action" json:"action,omitempty"`
+	Limit       *BlockLimitSpec               `protobuf:"bytes,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Delay       *BlockDelaySpec               `protobuf:"bytes,5,opt,name=delay,proto3" json:"delay,omitempty"`
+	EnterNS     bool                          `protobuf:"varint,6,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *ApplyBlockChaosRequest) Reset() {
+	*x = ApplyBlockChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBlockChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBlockChaosRequest) ProtoMessage() {}
+
+func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBlockChaosRequest.ProtoReflect.Descriptor instead.
+func (*ApplyBlockChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApplyBlockChaosRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ApplyBlockChaosRequest) GetVolumePath() string {
+	if x != nil {
+		return x.VolumePath
+	}
+	return ""
+}
+
+func (x *ApplyBlockChaosRequest) GetAction() ApplyBlockChaosRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ApplyBlockChaosRequest_Delay
+}
+
+func (x *ApplyBlockChaosRequest) GetLimit() *BlockLimitSpec {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *ApplyBlockChaosRequest) GetDelay() *BlockDelaySpec {
+	if x != nil {
+		return x.Delay
+	}
+	return nil
+}
+
+func (x *ApplyBlockChaosRequest) GetEnterNS() bool {
+	if x != nil {
+		return x.EnterNS
+	}
+	return false
+}
+
+type BlockDelaySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delay       int64   `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	Correlation float64 `protobuf:"fixed64,2,opt,name=correlation,proto3" json:"correlation,omitempty"`
+	Jitter      int64   `protobuf:"varint,3,opt,name=jitter,proto3" json:"jitter,omitempty"`
+}
+
+func (x *BlockDelaySpec) Reset() {
+	*x = BlockDelaySpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockDelaySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockDelaySpec) ProtoMessage() {}
+
+func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockDelaySpec.ProtoReflect.Descriptor instead.
+func (*BlockDelaySpec) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BlockDelaySpec) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *BlockDelaySpec) GetCorrelation() float64 {
+	if x != nil {
+		return x.Correlation
+	}
+	return 0
+}
+
+func (x *BlockDelaySpec) GetJitter() int64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+type BlockLimitSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the limits of cgroup io controller, zero means no limit
+	Iops uint64 `protobuf:"varint,3,opt,name=iops,proto3" json:"iops,omitempty"`
+	Bps  uint64 `protobuf:"varint,4,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (x *BlockLimitSpec) Reset() {
+	*x = BlockLimitSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockLimitSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockLimitSpec) ProtoMessage() {}
+
+func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockLimitSpec.ProtoReflect.Descriptor instead.
+func (*BlockLimitSpec) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BlockLimitSpec) GetIops() uint64 {
+	if x != nil {
+		return x.Iops
+	}
+	return 0
+}
+
+func (x *BlockLimitSpec) GetBps() uint64 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+type ApplyBlockChaosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InjectionId int32 `protobuf:"varint,1,opt,name=injection_id,json=injectionId,proto3" json:"injection_id,omitempty"`
+}
+
+func (x *ApplyBlockChaosResponse) Reset() {
+	*x = ApplyBlockChaosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyBlockChaosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyBlockChaosResponse) ProtoMessage() {}
+
+func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyBlockChaosResponse.ProtoReflect.Descriptor instead.
+func (*ApplyBlockChaosResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApplyBlockChaosResponse) GetInjectionId() int32 {
+	if x != nil {
+		return x.InjectionId
+	}
+	return 0
+}
+
+type RecoverBlockChaosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InjectionId int32 `protobuf:"varint,1,opt,name=injection_id,json=injectionId,proto3" json:"injection_id,omitempty"`
+	// the limit action is recovered by the container and volume instead of injection_id
+	ContainerId string                        `protobuf:"bytes,2,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	VolumePath  string                        `protobuf:"bytes,3,opt,name=volume_path,json=volumePath,proto3" json:"volume_path,omitempty"`
+	Action      ApplyBlockChaosRequest_Action `protobuf:"varint,4,opt,name=action,proto3,enum=pb.ApplyBlockChaosRequest_Action" json:"action,omitempty"`
+}
+
+func (x *RecoverBlockChaosRequest) Reset() {
+	*x = RecoverBlockChaosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverBlockChaosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverBlockChaosRequest) ProtoMessage() {}
+
+func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverBlockChaosRequest.ProtoReflect.Descriptor instead.
+func (*RecoverBlockChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RecoverBlockChaosRequest) GetInjectionId() int32 {
+	if x != nil {
+		return x.InjectionId
+	}
+	return 0
+}
+
+func (x *RecoverBlockChaosRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RecoverBlockChaosRequest) GetVolumePath() string {
+	if x != nil {
+		return x.VolumePath
+	}
+	return ""
+}
+
+func (x *RecoverBlockChaosRequest) GetAction() ApplyBlockChaosRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return ApplyBlockChaosRequest_Delay
+}
+
+type RuntimeMutatorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Class       string `protobuf:"bytes,3,opt,name=class,proto3" json:"class,omitempty"`
+	Method      string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Signature   string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	From        string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To          string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	Strategy    string `protobuf:"bytes,8,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Port        int32  `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
+	EnterNS     bool   `protobuf:"varint,10,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
+}
+
+func (x *RuntimeMutatorRequest) Reset() {
+	*x = RuntimeMutatorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeMutatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeMutatorRequest) ProtoMessage() {}
+
+func (x *RuntimeMutatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeMutatorRequest.ProtoReflect.Descriptor instead.
+func (*RuntimeMutatorRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *RuntimeMutatorRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RuntimeMutatorRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RuntimeMutatorRequest) GetClass() string {
+	if x != nil {
+		return x.Class
+	}
+	return ""
+}
+
+func (x *RuntimeMutatorRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *RuntimeMutatorRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *RuntimeMutatorRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
 }
 
 func (x *RuntimeMutatorRequest) GetTo() string {
@@ -3039,7 +4546,7 @@ type RuntimeMutatorResponse struct {
 func (x *RuntimeMutatorResponse) Reset() {
 	*x = RuntimeMutatorResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[38]
+		mi := &file_chaosdaemon_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3052,7 +4559,7 @@ func (x *RuntimeMutatorResponse) String() string {
 func (*RuntimeMutatorResponse) ProtoMessage() {}
 
 func (x *RuntimeMutatorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[38]
+	mi := &file_chaosdaemon_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3065,7 +4572,7 @@ func (x *RuntimeMutatorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuntimeMutatorResponse.ProtoReflect.Descriptor instead.
 func (*RuntimeMutatorResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{38}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *RuntimeMutatorResponse) GetSuccess() bool {
@@ -3194,7 +4701,7 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
 	0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x99, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xb1, 0x01,
 	0x0a, 0x05, 0x49, 0x50, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
 	0x69, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64, 0x72,
@@ -3204,338 +4711,567 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x41, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f,
 	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x74,
 	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x69, 0x64,
-	0x72, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x22, 0x77, 0x0a, 0x15, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x6d, 0x69, 0x6c,
+	0x79, 0x22, 0x35, 0x0a, 0x0b, 0x43, 0x69, 0x64, 0x72, 0x41, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x63, 0x69, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x49, 0x70, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x70, 0x76,
+	0x36, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x49,
+	0x70, 0x76, 0x36, 0x22, 0xa4, 0x03, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x77, 0x69, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x57, 0x69, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x70,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62, 0x2e, 0x46, 0x6c, 0x61, 0x70,
+	0x52, 0x04, 0x66, 0x6c, 0x61, 0x70, 0x22, 0x22, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01, 0x22, 0x52, 0x0a, 0x13, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xb7,
+	0x01, 0x0a, 0x14, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x71,
+	0x64, 0x69, 0x73, 0x63, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x71, 0x64, 0x69,
+	0x73, 0x63, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e, 0x73, 0x65,
+	0x63, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6c, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x6c, 0x6b, 0x49, 0x64, 0x73, 0x4d,
+	0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x70, 0x6f, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4b, 0x49, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x47, 0x45, 0x54, 0x50, 0x49, 0x44, 0x10, 0x01, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x45,
+	0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x70, 0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
+	0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41,
+	0x64, 0x6a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x41, 0x64, 0x6a, 0x22, 0x1f, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0d,
+	0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a,
+	0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22, 0x82, 0x02, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x53,
+	0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x13,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69,
+	0x64, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x65, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x45, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x0d, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x48, 0x74, 0x74, 0x70, 0x45, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x69, 0x64, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x69, 0x64,
+	0x72, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64,
+	0x22, 0x97, 0x01, 0x0a, 0x15, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x22, 0x46, 0x0a, 0x16, 0x48, 0x74,
+	0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a,
+	0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x4f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0f, 0x48, 0x74, 0x74, 0x70, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x73, 0x75, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x75,
+	0x6d, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0e, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x15, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3b, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47,
+	0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x47, 0x72,
+	0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0xd9, 0x01, 0x0a, 0x19, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x1a, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x84, 0x01, 0x0a, 0x0a, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x03, 0x74, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x63, 0x52, 0x03, 0x74, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x70, 0x76, 0x36, 0x22, 0xf3, 0x02, 0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70,
+	0x62, 0x2e, 0x54, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d,
+	0x12, 0x19, 0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x70, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a,
+	0x04, 0x66, 0x6c, 0x61, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x70, 0x62,
+	0x2e, 0x46, 0x6c, 0x61, 0x70, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x70, 0x22, 0x20, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x54, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x0d,
+	0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01, 0x22, 0x83, 0x01,
+	0x0a, 0x04, 0x46, 0x6c, 0x61, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x64, 0x6f, 0x77, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0c, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x24,
+	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x41, 0x4d, 0x50, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x54, 0x45, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x49,
+	0x4e, 0x45, 0x10, 0x02, 0x22, 0x6c, 0x0a, 0x10, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f,
+	0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x8c,
+	0x01, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x3a, 0x0a,
+	0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x16, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x7a, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x0e, 0x0a, 0x02, 0x63, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x63, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x22, 0x7d, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0xc3, 0x02, 0x0a, 0x05, 0x43, 0x68,
-	0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x70,
-	0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x70, 0x73, 0x65,
-	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x6c,
-	0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x63, 0x70, 0x46, 0x6c,
-	0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x22, 0x0a, 0x09, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4e, 0x50, 0x55,
-	0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10, 0x01, 0x22,
-	0xb8, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x03, 0x73, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x04, 0x6e, 0x73, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x6c, 0x6b, 0x5f,
-	0x69, 0x64, 0x73, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x63, 0x6c, 0x6b, 0x49, 0x64, 0x73, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12,
-	0x70, 0x6f, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x6f, 0x64, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
-	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x1e, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4b,
-	0x49, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x54, 0x50, 0x49, 0x44, 0x10,
-	0x01, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63,
-	0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f,
-	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e,
-	0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53,
-	0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
-	0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6f,
-	0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x22, 0x1f, 0x0a, 0x05,
-	0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22, 0x82, 0x02,
-	0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70,
-	0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x69, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70,
-	0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x14,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69,
-	0x64, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43,
-	0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0xab,
-	0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x0a,
-	0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x74, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x52,
-	0x03, 0x74, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
+	0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
 	0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e,
-	0x53, 0x22, 0x8f, 0x02, 0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74,
-	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x62,
-	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x62, 0x66,
-	0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x22, 0x20, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x54,
-	0x45, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54,
-	0x48, 0x10, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22,
-	0x7d, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x53, 0x22, 0x7f, 0x0a, 0x18, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56,
+	0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x22, 0xa5, 0x02, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x1e, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x10, 0x01, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x0e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12,
+	0x0a, 0x04, 0x69, 0x6f, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x69, 0x6f,
+	0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x03, 0x62, 0x70, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f,
+	0x75, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x22, 0xbc, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x8c, 0x02, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
 	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
 	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7f,
-	0x0a, 0x18, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22,
-	0xf0, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68,
-	0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x39,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68,
-	0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c,
-	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x13, 0x0a,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x10, 0x00, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x8c, 0x02, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x73,
-	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x4c, 0x0a, 0x16, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x32, 0xf4, 0x09, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x44, 0x61, 0x65,
-	0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x63, 0x73, 0x12, 0x0e, 0x2e,
-	0x70, 0x62, 0x2e, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c, 0x75, 0x73, 0x68,
-	0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x53, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x70,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x0d, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
-	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
-	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x4c,
+	0x0a, 0x16, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0x9e, 0x0f, 0x0a,
+	0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06,
+	0x53, 0x65, 0x74, 0x54, 0x63, 0x73, 0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12,
+	0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11,
+	0x53, 0x65, 0x74, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0d, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64, 0x12, 0x14, 0x2e,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d,
-	0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x15, 0x2e,
-	0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
-	0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72,
-	0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43,
-	0x68, 0x61, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49,
-	0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62,
-	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x41, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12,
-	0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64, 0x12, 0x14,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
+	0x0d, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x15,
+	0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53,
+	0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x47, 0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x47, 0x72, 0x70, 0x63, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49,
+	0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x47, 0x72, 0x70, 0x63, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x12, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x47,
+	0x72, 0x70, 0x63, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12,
+	0x1d, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x51, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17,
+	0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61,
+	0x6f, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x4e, 0x53,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70,
+	0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x44, 0x4e, 0x53, 0x43, 0x68, 0x61, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x43, 0x41, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x43, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
+	0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b,
+	0x0a, 0x11, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61,
 	0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x55,
-	0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a,
-	0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x15, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f,
-	0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70,
-	0x62, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x17, 0x55, 0x6e,
-	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
-	0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x15, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4e, 0x0a,
+	0x17, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3550,124 +5286,173 @@ func file_chaosdaemon_proto_rawDescGZIP() []byte {
 	return file_chaosdaemon_proto_rawDescData
 }
 
-var file_chaosdaemon_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_chaosdaemon_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_chaosdaemon_proto_goTypes = []interface{}{
-	(Chain_Direction)(0),               // 0: pb.Chain.Direction
-	(ContainerAction_Action)(0),        // 1: pb.ContainerAction.Action
-	(ExecStressRequest_Scope)(0),       // 2: pb.ExecStressRequest.Scope
-	(Tc_Type)(0),                       // 3: pb.Tc.Type
-	(ApplyBlockChaosRequest_Action)(0), // 4: pb.ApplyBlockChaosRequest.Action
-	(*TcHandle)(nil),                   // 5: pb.TcHandle
-	(*ContainerRequest)(nil),           // 6: pb.ContainerRequest
-	(*ContainerResponse)(nil),          // 7: pb.ContainerResponse
-	(*NetemRequest)(nil),               // 8: pb.NetemRequest
-	(*Netem)(nil),                      // 9: pb.Netem
-	(*TbfRequest)(nil),                 // 10: pb.TbfRequest
-	(*Tbf)(nil),                        // 11: pb.Tbf
-	(*QdiscRequest)(nil),               // 12: pb.QdiscRequest
-	(*Qdisc)(nil),                      // 13: pb.Qdisc
-	(*EmatchFilterRequest)(nil),        // 14: pb.EmatchFilterRequest
-	(*EmatchFilter)(nil),               // 15: pb.EmatchFilter
-	(*TcFilterRequest)(nil),            // 16: pb.TcFilterRequest
-	(*TcFilter)(nil),                   // 17: pb.TcFilter
-	(*IPSetsRequest)(nil),              // 18: pb.IPSetsRequest
-	(*IPSet)(nil),                      // 19: pb.IPSet
-	(*CidrAndPort)(nil),                // 20: pb.CidrAndPort
-	(*IptablesChainsRequest)(nil),      // 21: pb.IptablesChainsRequest
-	(*Chain)(nil),                      // 22: pb.Chain
-	(*TimeRequest)(nil),                // 23: pb.TimeRequest
-	(*ContainerAction)(nil),            // 24: pb.ContainerAction
-	(*ExecStressRequest)(nil),          // 25: pb.ExecStressRequest
-	(*ExecStressResponse)(nil),         // 26: pb.ExecStressResponse
-	(*CancelStressRequest)(nil),        // 27: pb.CancelStressRequest
-	(*ApplyIOChaosRequest)(nil),        // 28: pb.ApplyIOChaosRequest
-	(*ApplyIOChaosResponse)(nil),       // 29: pb.ApplyIOChaosResponse
-	(*ApplyHttpChaosRequest)(nil),      // 30: pb.ApplyHttpChaosRequest
-	(*ApplyHttpChaosResponse)(nil),     // 31: pb.ApplyHttpChaosResponse
-	(*TcsRequest)(nil),                 // 32: pb.TcsRequest
-	(*Tc)(nil),                         // 33: pb.Tc
-	(*SetDNSServerRequest)(nil),        // 34: pb.SetDNSServerRequest
-	(*InstallJVMRulesRequest)(nil),     // 35: pb.InstallJVMRulesRequest
-	(*UninstallJVMRulesRequest)(nil),   // 36: pb.UninstallJVMRulesRequest
-	(*ApplyBlockChaosRequest)(nil),     // 37: pb.ApplyBlockChaosRequest
-	(*BlockDelaySpec)(nil),             // 38: pb.BlockDelaySpec
-	(*BlockLimitSpec)(nil),             // 39: pb.BlockLimitSpec
-	(*ApplyBlockChaosResponse)(nil),    // 40: pb.ApplyBlockChaosResponse
-	(*RecoverBlockChaosRequest)(nil),   // 41: pb.RecoverBlockChaosRequest
-	(*RuntimeMutatorRequest)(nil),      // 42: pb.RuntimeMutatorRequest
-	(*RuntimeMutatorResponse)(nil),     // 43: pb.RuntimeMutatorResponse
-	(*empty.Empty)(nil),                // 44: google.protobuf.Empty
+	(Chain_Direction)(0),                // 0: pb.Chain.Direction
+	(ContainerAction_Action)(0),         // 1: pb.ContainerAction.Action
+	(ExecStressRequest_Scope)(0),        // 2: pb.ExecStressRequest.Scope
+	(Tc_Type)(0),                        // 3: pb.Tc.Type
+	(NetemProfile_Type)(0),              // 4: pb.NetemProfile.Type
+	(ApplyBlockChaosRequest_Action)(0),  // 5: pb.ApplyBlockChaosRequest.Action
+	(*TcHandle)(nil),                    // 6: pb.TcHandle
+	(*ContainerRequest)(nil),            // 7: pb.ContainerRequest
+	(*ContainerResponse)(nil),           // 8: pb.ContainerResponse
+	(*NetemRequest)(nil),                // 9: pb.NetemRequest
+	(*Netem)(nil),                       // 10: pb.Netem
+	(*TbfRequest)(nil),                  // 11: pb.TbfRequest
+	(*Tbf)(nil),                         // 12: pb.Tbf
+	(*QdiscRequest)(nil),                // 13: pb.QdiscRequest
+	(*Qdisc)(nil),                       // 14: pb.Qdisc
+	(*EmatchFilterRequest)(nil),         // 15: pb.EmatchFilterRequest
+	(*EmatchFilter)(nil),                // 16: pb.EmatchFilter
+	(*TcFilterRequest)(nil),             // 17: pb.TcFilterRequest
+	(*TcFilter)(nil),                    // 18: pb.TcFilter
+	(*IPSetsRequest)(nil),               // 19: pb.IPSetsRequest
+	(*IPSet)(nil),                       // 20: pb.IPSet
+	(*CidrAndPort)(nil),                 // 21: pb.CidrAndPort
+	(*IptablesChainsRequest)(nil),       // 22: pb.IptablesChainsRequest
+	(*Chain)(nil),                       // 23: pb.Chain
+	(*NetworkRulesRequest)(nil),         // 24: pb.NetworkRulesRequest
+	(*NetworkRulesResponse)(nil),        // 25: pb.NetworkRulesResponse
+	(*TimeRequest)(nil),                 // 26: pb.TimeRequest
+	(*ContainerAction)(nil),             // 27: pb.ContainerAction
+	(*ExecStressRequest)(nil),           // 28: pb.ExecStressRequest
+	(*ExecStressResponse)(nil),          // 29: pb.ExecStressResponse
+	(*CancelStressRequest)(nil),         // 30: pb.CancelStressRequest
+	(*ApplyIOChaosRequest)(nil),         // 31: pb.ApplyIOChaosRequest
+	(*ApplyIOChaosResponse)(nil),        // 32: pb.ApplyIOChaosResponse
+	(*ApplyHttpChaosRequest)(nil),       // 33: pb.ApplyHttpChaosRequest
+	(*HttpEgressTarget)(nil),            // 34: pb.HttpEgressTarget
+	(*ApplyHttpChaosResponse)(nil),      // 35: pb.ApplyHttpChaosResponse
+	(*HttpChaosStatsRequest)(nil),       // 36: pb.HttpChaosStatsRequest
+	(*HttpChaosStatsResponse)(nil),      // 37: pb.HttpChaosStatsResponse
+	(*HttpChaosRuleStats)(nil),          // 38: pb.HttpChaosRuleStats
+	(*HttpObservation)(nil),             // 39: pb.HttpObservation
+	(*ApplyGrpcChaosRequest)(nil),       // 40: pb.ApplyGrpcChaosRequest
+	(*ApplyGrpcChaosResponse)(nil),      // 41: pb.ApplyGrpcChaosResponse
+	(*RecoverGrpcChaosRequest)(nil),     // 42: pb.RecoverGrpcChaosRequest
+	(*ApplyProtocolChaosRequest)(nil),   // 43: pb.ApplyProtocolChaosRequest
+	(*ApplyProtocolChaosResponse)(nil),  // 44: pb.ApplyProtocolChaosResponse
+	(*RecoverProtocolChaosRequest)(nil), // 45: pb.RecoverProtocolChaosRequest
+	(*TcsRequest)(nil),                  // 46: pb.TcsRequest
+	(*Tc)(nil),                          // 47: pb.Tc
+	(*Flap)(nil),                        // 48: pb.Flap
+	(*NetemProfile)(nil),                // 49: pb.NetemProfile
+	(*NetemProfileStep)(nil),            // 50: pb.NetemProfileStep
+	(*SetDNSServerRequest)(nil),         // 51: pb.SetDNSServerRequest
+	(*ApplyDNSChaosRequest)(nil),        // 52: pb.ApplyDNSChaosRequest
+	(*ApplyDNSChaosResponse)(nil),       // 53: pb.ApplyDNSChaosResponse
+	(*RecoverDNSChaosRequest)(nil),      // 54: pb.RecoverDNSChaosRequest
+	(*SetTrustedCARequest)(nil),         // 55: pb.SetTrustedCARequest
+	(*InstallJVMRulesRequest)(nil),      // 56: pb.InstallJVMRulesRequest
+	(*UninstallJVMRulesRequest)(nil),    // 57: pb.UninstallJVMRulesRequest
+	(*ApplyBlockChaosRequest)(nil),      // 58: pb.ApplyBlockChaosRequest
+	(*BlockDelaySpec)(nil),              // 59: pb.BlockDelaySpec
+	(*BlockLimitSpec)(nil),              // 60: pb.BlockLimitSpec
+	(*ApplyBlockChaosResponse)(nil),     // 61: pb.ApplyBlockChaosResponse
+	(*RecoverBlockChaosRequest)(nil),    // 62: pb.RecoverBlockChaosRequest
+	(*RuntimeMutatorRequest)(nil),       // 63: pb.RuntimeMutatorRequest
+	(*RuntimeMutatorResponse)(nil),      // 64: pb.RuntimeMutatorResponse
+	(*empty.Empty)(nil),                 // 65: google.protobuf.Empty
 }
 var file_chaosdaemon_proto_depIdxs = []int32{
-	24, // 0: pb.ContainerRequest.action:type_name -> pb.ContainerAction
-	9,  // 1: pb.NetemRequest.netem:type_name -> pb.Netem
-	5,  // 2: pb.NetemRequest.handle:type_name -> pb.TcHandle
-	5,  // 3: pb.NetemRequest.parent:type_name -> pb.TcHandle
-	5,  // 4: pb.Netem.parent:type_name -> pb.TcHandle
-	5,  // 5: pb.Netem.handle:type_name -> pb.TcHandle
-	11, // 6: pb.TbfRequest.tbf:type_name -> pb.Tbf
-	13, // 7: pb.QdiscRequest.qdisc:type_name -> pb.Qdisc
-	5,  // 8: pb.Qdisc.parent:type_name -> pb.TcHandle
-	5,  // 9: pb.Qdisc.handle:type_name -> pb.TcHandle
-	15, // 10: pb.EmatchFilterRequest.filter:type_name -> pb.EmatchFilter
-	5,  // 11: pb.EmatchFilter.parent:type_name -> pb.TcHandle
-	5,  // 12: pb.EmatchFilter.classid:type_name -> pb.TcHandle
-	17, // 13: pb.TcFilterRequest.filter:type_name -> pb.TcFilter
-	5,  // 14: pb.TcFilter.parent:type_name -> pb.TcHandle
-	19, // 15: pb.IPSetsRequest.ipsets:type_name -> pb.IPSet
-	20, // 16: pb.IPSet.cidr_and_ports:type_name -> pb.CidrAndPort
-	22, // 17: pb.IptablesChainsRequest.chains:type_name -> pb.Chain
+	27, // 0: pb.ContainerRequest.action:type_name -> pb.ContainerAction
+	10, // 1: pb.NetemRequest.netem:type_name -> pb.Netem
+	6,  // 2: pb.NetemRequest.handle:type_name -> pb.TcHandle
+	6,  // 3: pb.NetemRequest.parent:type_name -> pb.TcHandle
+	6,  // 4: pb.Netem.parent:type_name -> pb.TcHandle
+	6,  // 5: pb.Netem.handle:type_name -> pb.TcHandle
+	12, // 6: pb.TbfRequest.tbf:type_name -> pb.Tbf
+	14, // 7: pb.QdiscRequest.qdisc:type_name -> pb.Qdisc
+	6,  // 8: pb.Qdisc.parent:type_name -> pb.TcHandle
+	6,  // 9: pb.Qdisc.handle:type_name -> pb.TcHandle
+	16, // 10: pb.EmatchFilterRequest.filter:type_name -> pb.EmatchFilter
+	6,  // 11: pb.EmatchFilter.parent:type_name -> pb.TcHandle
+	6,  // 12: pb.EmatchFilter.classid:type_name -> pb.TcHandle
+	18, // 13: pb.TcFilterRequest.filter:type_name -> pb.TcFilter
+	6,  // 14: pb.TcFilter.parent:type_name -> pb.TcHandle
+	20, // 15: pb.IPSetsRequest.ipsets:type_name -> pb.IPSet
+	21, // 16: pb.IPSet.cidr_and_ports:type_name -> pb.CidrAndPort
+	23, // 17: pb.IptablesChainsRequest.chains:type_name -> pb.Chain
 	0,  // 18: pb.Chain.direction:type_name -> pb.Chain.Direction
-	1,  // 19: pb.ContainerAction.action:type_name -> pb.ContainerAction.Action
-	2,  // 20: pb.ExecStressRequest.scope:type_name -> pb.ExecStressRequest.Scope
-	33, // 21: pb.TcsRequest.tcs:type_name -> pb.Tc
-	3,  // 22: pb.Tc.type:type_name -> pb.Tc.Type
-	9,  // 23: pb.Tc.netem:type_name -> pb.Netem
-	11, // 24: pb.Tc.tbf:type_name -> pb.Tbf
-	4,  // 25: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
-	38, // 26: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
-	32, // 27: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
-	18, // 28: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
-	21, // 29: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
-	23, // 30: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
-	23, // 31: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
-	6,  // 32: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
-	6,  // 33: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
-	25, // 34: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
-	27, // 35: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
-	28, // 36: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
-	30, // 37: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
-	37, // 38: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
-	41, // 39: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
-	34, // 40: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
-	35, // 41: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
-	36, // 42: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
-	42, // 43: pb.ChaosDaemon.InstallRuntimeMutator:input_type -> pb.RuntimeMutatorRequest
-	42, // 44: pb.ChaosDaemon.UninstallRuntimeMutator:input_type -> pb.RuntimeMutatorRequest
-	44, // 45: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
-	44, // 46: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
-	44, // 47: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
-	44, // 48: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
-	44, // 49: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
-	44, // 50: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
-	7,  // 51: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
-	26, // 52: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
-	44, // 53: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
-	29, // 54: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
-	31, // 55: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
-	40, // 56: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
-	44, // 57: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
-	44, // 58: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
-	44, // 59: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
-	44, // 60: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
-	43, // 61: pb.ChaosDaemon.InstallRuntimeMutator:output_type -> pb.RuntimeMutatorResponse
-	44, // 62: pb.ChaosDaemon.UninstallRuntimeMutator:output_type -> google.protobuf.Empty
-	45, // [45:63] is the sub-list for method output_type
-	27, // [27:45] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	48, // 19: pb.Chain.flap:type_name -> pb.Flap
+	1,  // 20: pb.ContainerAction.action:type_name -> pb.ContainerAction.Action
+	2,  // 21: pb.ExecStressRequest.scope:type_name -> pb.ExecStressRequest.Scope
+	34, // 22: pb.ApplyHttpChaosRequest.egress_targets:type_name -> pb.HttpEgressTarget
+	38, // 23: pb.HttpChaosStatsResponse.rules:type_name -> pb.HttpChaosRuleStats
+	39, // 24: pb.HttpChaosRuleStats.observations:type_name -> pb.HttpObservation
+	47, // 25: pb.TcsRequest.tcs:type_name -> pb.Tc
+	3,  // 26: pb.Tc.type:type_name -> pb.Tc.Type
+	10, // 27: pb.Tc.netem:type_name -> pb.Netem
+	12, // 28: pb.Tc.tbf:type_name -> pb.Tbf
+	49, // 29: pb.Tc.profile:type_name -> pb.NetemProfile
+	48, // 30: pb.Tc.flap:type_name -> pb.Flap
+	4,  // 31: pb.NetemProfile.type:type_name -> pb.NetemProfile.Type
+	50, // 32: pb.NetemProfile.steps:type_name -> pb.NetemProfileStep
+	5,  // 33: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
+	60, // 34: pb.ApplyBlockChaosRequest.limit:type_name -> pb.BlockLimitSpec
+	59, // 35: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
+	5,  // 36: pb.RecoverBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
+	46, // 37: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
+	19, // 38: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
+	22, // 39: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
+	24, // 40: pb.ChaosDaemon.GetNetworkRules:input_type -> pb.NetworkRulesRequest
+	26, // 41: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
+	26, // 42: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
+	7,  // 43: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
+	7,  // 44: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
+	28, // 45: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
+	30, // 46: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
+	31, // 47: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
+	33, // 48: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
+	36, // 49: pb.ChaosDaemon.GetHttpChaosStats:input_type -> pb.HttpChaosStatsRequest
+	40, // 50: pb.ChaosDaemon.ApplyGrpcChaos:input_type -> pb.ApplyGrpcChaosRequest
+	42, // 51: pb.ChaosDaemon.RecoverGrpcChaos:input_type -> pb.RecoverGrpcChaosRequest
+	43, // 52: pb.ChaosDaemon.ApplyProtocolChaos:input_type -> pb.ApplyProtocolChaosRequest
+	45, // 53: pb.ChaosDaemon.RecoverProtocolChaos:input_type -> pb.RecoverProtocolChaosRequest
+	58, // 54: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
+	62, // 55: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
+	51, // 56: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
+	52, // 57: pb.ChaosDaemon.ApplyDNSChaos:input_type -> pb.ApplyDNSChaosRequest
+	54, // 58: pb.ChaosDaemon.RecoverDNSChaos:input_type -> pb.RecoverDNSChaosRequest
+	55, // 59: pb.ChaosDaemon.SetTrustedCA:input_type -> pb.SetTrustedCARequest
+	56, // 60: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
+	57, // 61: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
+	63, // 62: pb.ChaosDaemon.InstallRuntimeMutator:input_type -> pb.RuntimeMutatorRequest
+	63, // 63: pb.ChaosDaemon.UninstallRuntimeMutator:input_type -> pb.RuntimeMutatorRequest
+	65, // 64: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
+	65, // 65: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
+	65, // 66: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
+	25, // 67: pb.ChaosDaemon.GetNetworkRules:output_type -> pb.NetworkRulesResponse
+	65, // 68: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
+	65, // 69: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
+	65, // 70: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
+	8,  // 71: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
+	29, // 72: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
+	65, // 73: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
+	32, // 74: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
+	35, // 75: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
+	37, // 76: pb.ChaosDaemon.GetHttpChaosStats:output_type -> pb.HttpChaosStatsResponse
+	41, // 77: pb.ChaosDaemon.ApplyGrpcChaos:output_type -> pb.ApplyGrpcChaosResponse
+	65, // 78: pb.ChaosDaemon.RecoverGrpcChaos:output_type -> google.protobuf.Empty
+	44, // 79: pb.ChaosDaemon.ApplyProtocolChaos:output_type -> pb.ApplyProtocolChaosResponse
+	65, // 80: pb.ChaosDaemon.RecoverProtocolChaos:output_type -> google.protobuf.Empty
+	61, // 81: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
+	65, // 82: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
+	65, // 83: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
+	53, // 84: pb.ChaosDaemon.ApplyDNSChaos:output_type -> pb.ApplyDNSChaosResponse
+	65, // 85: pb.ChaosDaemon.RecoverDNSChaos:output_type -> google.protobuf.Empty
+	65, // 86: pb.ChaosDaemon.SetTrustedCA:output_type -> google.protobuf.Empty
+	65, // 87: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
+	65, // 88: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
+	64, // 89: pb.ChaosDaemon.InstallRuntimeMutator:output_type -> pb.RuntimeMutatorResponse
+	65, // 90: pb.ChaosDaemon.UninstallRuntimeMutator:output_type -> google.protobuf.Empty
+	64, // [64:91] is the sub-list for method output_type
+	37, // [37:64] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_chaosdaemon_proto_init() }
@@ -3832,8 +5617,248 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPSetsRequest); i {
+		file_chaosdaemon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPSetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IPSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CidrAndPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IptablesChainsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkRulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecStressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecStressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelStressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyIOChaosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyIOChaosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyHttpChaosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpEgressTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyHttpChaosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpChaosStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpChaosStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpChaosRuleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpObservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3844,8 +5869,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IPSet); i {
+		file_chaosdaemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyGrpcChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3856,8 +5881,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CidrAndPort); i {
+		file_chaosdaemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyGrpcChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3868,8 +5893,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IptablesChainsRequest); i {
+		file_chaosdaemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverGrpcChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3880,8 +5905,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chain); i {
+		file_chaosdaemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProtocolChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3892,8 +5917,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TimeRequest); i {
+		file_chaosdaemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProtocolChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3904,8 +5929,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainerAction); i {
+		file_chaosdaemon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverProtocolChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3916,8 +5941,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStressRequest); i {
+		file_chaosdaemon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TcsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3928,8 +5953,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecStressResponse); i {
+		file_chaosdaemon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3940,8 +5965,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelStressRequest); i {
+		file_chaosdaemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Flap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3952,8 +5977,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosRequest); i {
+		file_chaosdaemon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetemProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3964,8 +5989,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosResponse); i {
+		file_chaosdaemon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetemProfileStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3976,8 +6001,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosRequest); i {
+		file_chaosdaemon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetDNSServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3988,8 +6013,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosResponse); i {
+		file_chaosdaemon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDNSChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4000,8 +6025,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcsRequest); i {
+		file_chaosdaemon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyDNSChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4012,8 +6037,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tc); i {
+		file_chaosdaemon_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverDNSChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4024,8 +6049,8 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDNSServerRequest); i {
+		file_chaosdaemon_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTrustedCARequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4036,7 +6061,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstallJVMRulesRequest); i {
 			case 0:
 				return &v.state
@@ -4048,7 +6073,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UninstallJVMRulesRequest); i {
 			case 0:
 				return &v.state
@@ -4060,7 +6085,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplyBlockChaosRequest); i {
 			case 0:
 				return &v.state
@@ -4072,7 +6097,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockDelaySpec); i {
 			case 0:
 				return &v.state
@@ -4084,7 +6109,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlockLimitSpec); i {
 			case 0:
 				return &v.state
@@ -4096,7 +6121,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ApplyBlockChaosResponse); i {
 			case 0:
 				return &v.state
@@ -4108,7 +6133,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecoverBlockChaosRequest); i {
 			case 0:
 				return &v.state
@@ -4120,7 +6145,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuntimeMutatorRequest); i {
 			case 0:
 				return &v.state
@@ -4132,7 +6157,7 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
-		file_chaosdaemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_chaosdaemon_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RuntimeMutatorResponse); i {
 			case 0:
 				return &v.state
@@ -4150,8 +6175,8 @@ func file_chaosdaemon_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaosdaemon_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   39,
+			NumEnums:      6,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -4181,6 +6206,7 @@ type ChaosDaemonClient interface {
 	SetTcs(ctx context.Context, in *TcsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	FlushIPSets(ctx context.Context, in *IPSetsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetIptablesChains(ctx context.Context, in *IptablesChainsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	GetNetworkRules(ctx context.Context, in *NetworkRulesRequest, opts ...grpc.CallOption) (*NetworkRulesResponse, error)
 	SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	RecoverTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ContainerKill(ctx context.Context, in *ContainerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
@@ -4189,9 +6215,17 @@ type ChaosDaemonClient interface {
 	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ApplyIOChaos(ctx context.Context, in *ApplyIOChaosRequest, opts ...grpc.CallOption) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(ctx context.Context, in *ApplyHttpChaosRequest, opts ...grpc.CallOption) (*ApplyHttpChaosResponse, error)
+	GetHttpChaosStats(ctx context.Context, in *HttpChaosStatsRequest, opts ...grpc.CallOption) (*HttpChaosStatsResponse, error)
+	ApplyGrpcChaos(ctx context.Context, in *ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*ApplyGrpcChaosResponse, error)
+	RecoverGrpcChaos(ctx context.Context, in *RecoverGrpcChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ApplyProtocolChaos(ctx context.Context, in *ApplyProtocolChaosRequest, opts ...grpc.CallOption) (*ApplyProtocolChaosResponse, error)
+	RecoverProtocolChaos(ctx context.Context, in *RecoverProtocolChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(ctx context.Context, in *RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetDNSServer(ctx context.Context, in *SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ApplyDNSChaos(ctx context.Context, in *ApplyDNSChaosRequest, opts ...grpc.CallOption) (*ApplyDNSChaosResponse, error)
+	RecoverDNSChaos(ctx context.Context, in *RecoverDNSChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	SetTrustedCA(ctx context.Context, in *SetTrustedCARequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	InstallJVMRules(ctx context.Context, in *InstallJVMRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	UninstallJVMRules(ctx context.Context, in *UninstallJVMRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	InstallRuntimeMutator(ctx context.Context, in *RuntimeMutatorRequest, opts ...grpc.CallOption) (*RuntimeMutatorResponse, error)
@@ -4233,6 +6267,15 @@ func (c *chaosDaemonClient) SetIptablesChains(ctx context.Context, in *IptablesC
 	return out, nil
 }
 
+func (c *chaosDaemonClient) GetNetworkRules(ctx context.Context, in *NetworkRulesRequest, opts ...grpc.CallOption) (*NetworkRulesResponse, error) {
+	out := new(NetworkRulesResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/GetNetworkRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) SetTimeOffset(ctx context.Context, in *TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/SetTimeOffset", in, out, opts...)
@@ -4305,6 +6348,51 @@ func (c *chaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *ApplyHttpCha
 	return out, nil
 }
 
+func (c *chaosDaemonClient) GetHttpChaosStats(ctx context.Context, in *HttpChaosStatsRequest, opts ...grpc.CallOption) (*HttpChaosStatsResponse, error) {
+	out := new(HttpChaosStatsResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/GetHttpChaosStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ApplyGrpcChaos(ctx context.Context, in *ApplyGrpcChaosRequest, opts ...grpc.CallOption) (*ApplyGrpcChaosResponse, error) {
+	out := new(ApplyGrpcChaosResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyGrpcChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverGrpcChaos(ctx context.Context, in *RecoverGrpcChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RecoverGrpcChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) ApplyProtocolChaos(ctx context.Context, in *ApplyProtocolChaosRequest, opts ...grpc.CallOption) (*ApplyProtocolChaosResponse, error) {
+	out := new(ApplyProtocolChaosResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyProtocolChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverProtocolChaos(ctx context.Context, in *RecoverProtocolChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RecoverProtocolChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error) {
 	out := new(ApplyBlockChaosResponse)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyBlockChaos", in, out, opts...)
@@ -4332,6 +6420,33 @@ func (c *chaosDaemonClient) SetDNSServer(ctx context.Context, in *SetDNSServerRe
 	return out, nil
 }
 
+func (c *chaosDaemonClient) ApplyDNSChaos(ctx context.Context, in *ApplyDNSChaosRequest, opts ...grpc.CallOption) (*ApplyDNSChaosResponse, error) {
+	out := new(ApplyDNSChaosResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyDNSChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverDNSChaos(ctx context.Context, in *RecoverDNSChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RecoverDNSChaos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) SetTrustedCA(ctx context.Context, in *SetTrustedCARequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/SetTrustedCA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) InstallJVMRules(ctx context.Context, in *InstallJVMRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/InstallJVMRules", in, out, opts...)
@@ -4373,6 +6488,7 @@ type ChaosDaemonServer interface {
 	SetTcs(context.Context, *TcsRequest) (*empty.Empty, error)
 	FlushIPSets(context.Context, *IPSetsRequest) (*empty.Empty, error)
 	SetIptablesChains(context.Context, *IptablesChainsRequest) (*empty.Empty, error)
+	GetNetworkRules(context.Context, *NetworkRulesRequest) (*NetworkRulesResponse, error)
 	SetTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
 	RecoverTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error)
 	ContainerKill(context.Context, *ContainerRequest) (*empty.Empty, error)
@@ -4381,9 +6497,17 @@ type ChaosDaemonServer interface {
 	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
 	ApplyIOChaos(context.Context, *ApplyIOChaosRequest) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error)
+	GetHttpChaosStats(context.Context, *HttpChaosStatsRequest) (*HttpChaosStatsResponse, error)
+	ApplyGrpcChaos(context.Context, *ApplyGrpcChaosRequest) (*ApplyGrpcChaosResponse, error)
+	RecoverGrpcChaos(context.Context, *RecoverGrpcChaosRequest) (*empty.Empty, error)
+	ApplyProtocolChaos(context.Context, *ApplyProtocolChaosRequest) (*ApplyProtocolChaosResponse, error)
+	RecoverProtocolChaos(context.Context, *RecoverProtocolChaosRequest) (*empty.Empty, error)
 	ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(context.Context, *RecoverBlockChaosRequest) (*empty.Empty, error)
 	SetDNSServer(context.Context, *SetDNSServerRequest) (*empty.Empty, error)
+	ApplyDNSChaos(context.Context, *ApplyDNSChaosRequest) (*ApplyDNSChaosResponse, error)
+	RecoverDNSChaos(context.Context, *RecoverDNSChaosRequest) (*empty.Empty, error)
+	SetTrustedCA(context.Context, *SetTrustedCARequest) (*empty.Empty, error)
 	InstallJVMRules(context.Context, *InstallJVMRulesRequest) (*empty.Empty, error)
 	UninstallJVMRules(context.Context, *UninstallJVMRulesRequest) (*empty.Empty, error)
 	InstallRuntimeMutator(context.Context, *RuntimeMutatorRequest) (*RuntimeMutatorResponse, error)
@@ -4403,6 +6527,9 @@ func (*UnimplementedChaosDaemonServer) FlushIPSets(context.Context, *IPSetsReque
 func (*UnimplementedChaosDaemonServer) SetIptablesChains(context.Context, *IptablesChainsRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetIptablesChains not implemented")
 }
+func (*UnimplementedChaosDaemonServer) GetNetworkRules(context.Context, *NetworkRulesRequest) (*NetworkRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkRules not implemented")
+}
 func (*UnimplementedChaosDaemonServer) SetTimeOffset(context.Context, *TimeRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetTimeOffset not implemented")
 }
@@ -4427,6 +6554,21 @@ func (*UnimplementedChaosDaemonServer) ApplyIOChaos(context.Context, *ApplyIOCha
 func (*UnimplementedChaosDaemonServer) ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyHttpChaos not implemented")
 }
+func (*UnimplementedChaosDaemonServer) GetHttpChaosStats(context.Context, *HttpChaosStatsRequest) (*HttpChaosStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHttpChaosStats not implemented")
+}
+func (*UnimplementedChaosDaemonServer) ApplyGrpcChaos(context.Context, *ApplyGrpcChaosRequest) (*ApplyGrpcChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyGrpcChaos not implemented")
+}
+func (*UnimplementedChaosDaemonServer) RecoverGrpcChaos(context.Context, *RecoverGrpcChaosRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverGrpcChaos not implemented")
+}
+func (*UnimplementedChaosDaemonServer) ApplyProtocolChaos(context.Context, *ApplyProtocolChaosRequest) (*ApplyProtocolChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyProtocolChaos not implemented")
+}
+func (*UnimplementedChaosDaemonServer) RecoverProtocolChaos(context.Context, *RecoverProtocolChaosRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverProtocolChaos not implemented")
+}
 func (*UnimplementedChaosDaemonServer) ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyBlockChaos not implemented")
 }
@@ -4436,6 +6578,15 @@ func (*UnimplementedChaosDaemonServer) RecoverBlockChaos(context.Context, *Recov
 func (*UnimplementedChaosDaemonServer) SetDNSServer(context.Context, *SetDNSServerRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDNSServer not implemented")
 }
+func (*UnimplementedChaosDaemonServer) ApplyDNSChaos(context.Context, *ApplyDNSChaosRequest) (*ApplyDNSChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDNSChaos not implemented")
+}
+func (*UnimplementedChaosDaemonServer) RecoverDNSChaos(context.Context, *RecoverDNSChaosRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverDNSChaos not implemented")
+}
+func (*UnimplementedChaosDaemonServer) SetTrustedCA(context.Context, *SetTrustedCARequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrustedCA not implemented")
+}
 func (*UnimplementedChaosDaemonServer) InstallJVMRules(context.Context, *InstallJVMRulesRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InstallJVMRules not implemented")
 }
@@ -4507,6 +6658,24 @@ func _ChaosDaemon_SetIptablesChains_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_GetNetworkRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).GetNetworkRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/GetNetworkRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).GetNetworkRules(ctx, req.(*NetworkRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_SetTimeOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TimeRequest)
 	if err := dec(in); err != nil {
@@ -4651,6 +6820,96 @@ func _ChaosDaemon_ApplyHttpChaos_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_GetHttpChaosStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpChaosStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).GetHttpChaosStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/GetHttpChaosStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).GetHttpChaosStats(ctx, req.(*HttpChaosStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ApplyGrpcChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyGrpcChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyGrpcChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyGrpcChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyGrpcChaos(ctx, req.(*ApplyGrpcChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverGrpcChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverGrpcChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverGrpcChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RecoverGrpcChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverGrpcChaos(ctx, req.(*RecoverGrpcChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_ApplyProtocolChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyProtocolChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyProtocolChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyProtocolChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyProtocolChaos(ctx, req.(*ApplyProtocolChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverProtocolChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverProtocolChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverProtocolChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RecoverProtocolChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverProtocolChaos(ctx, req.(*RecoverProtocolChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_ApplyBlockChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyBlockChaosRequest)
 	if err := dec(in); err != nil {
@@ -4705,6 +6964,60 @@ func _ChaosDaemon_SetDNSServer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_ApplyDNSChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDNSChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyDNSChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyDNSChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyDNSChaos(ctx, req.(*ApplyDNSChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverDNSChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverDNSChaosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverDNSChaos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RecoverDNSChaos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverDNSChaos(ctx, req.(*RecoverDNSChaosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_SetTrustedCA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrustedCARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).SetTrustedCA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/SetTrustedCA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).SetTrustedCA(ctx, req.(*SetTrustedCARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_InstallJVMRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InstallJVMRulesRequest)
 	if err := dec(in); err != nil {
@@ -4793,6 +7106,10 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetIptablesChains",
 			Handler:    _ChaosDaemon_SetIptablesChains_Handler,
 		},
+		{
+			MethodName: "GetNetworkRules",
+			Handler:    _ChaosDaemon_GetNetworkRules_Handler,
+		},
 		{
 			MethodName: "SetTimeOffset",
 			Handler:    _ChaosDaemon_SetTimeOffset_Handler,
@@ -4825,6 +7142,26 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyHttpChaos",
 			Handler:    _ChaosDaemon_ApplyHttpChaos_Handler,
 		},
+		{
+			MethodName: "GetHttpChaosStats",
+			Handler:    _ChaosDaemon_GetHttpChaosStats_Handler,
+		},
+		{
+			MethodName: "ApplyGrpcChaos",
+			Handler:    _ChaosDaemon_ApplyGrpcChaos_Handler,
+		},
+		{
+			MethodName: "RecoverGrpcChaos",
+			Handler:    _ChaosDaemon_RecoverGrpcChaos_Handler,
+		},
+		{
+			MethodName: "ApplyProtocolChaos",
+			Handler:    _ChaosDaemon_ApplyProtocolChaos_Handler,
+		},
+		{
+			MethodName: "RecoverProtocolChaos",
+			Handler:    _ChaosDaemon_RecoverProtocolChaos_Handler,
+		},
 		{
 			MethodName: "ApplyBlockChaos",
 			Handler:    _ChaosDaemon_ApplyBlockChaos_Handler,
@@ -4837,6 +7174,18 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDNSServer",
 			Handler:    _ChaosDaemon_SetDNSServer_Handler,
 		},
+		{
+			MethodName: "ApplyDNSChaos",
+			Handler:    _ChaosDaemon_ApplyDNSChaos_Handler,
+		},
+		{
+			MethodName: "RecoverDNSChaos",
+			Handler:    _ChaosDaemon_RecoverDNSChaos_Handler,
+		},
+		{
+			MethodName: "SetTrustedCA",
+			Handler:    _ChaosDaemon_SetTrustedCA_Handler,
+		},
 		{
 			MethodName: "InstallJVMRules",
 			Handler:    _ChaosDaemon_InstallJVMRules_Handler,
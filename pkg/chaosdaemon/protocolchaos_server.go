@@ -0,0 +1,50 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func (s *DaemonServer) ApplyProtocolChaos(ctx context.Context, in *pb.ApplyProtocolChaosRequest) (*pb.ApplyProtocolChaosResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying protocol chaos", "protocol", in.Protocol, "port", in.Port, "source", in.Source)
+
+	uid, err := s.applyRedirectedProxy(ctx, in.ContainerId, in.Port, in.EnterNS, in.Source, in.Rules,
+		"protocol-proxy", "--protocol", in.Protocol, "--port", strconv.Itoa(int(in.Port)))
+	if err != nil {
+		return nil, errors.Wrap(err, "apply protocol chaos")
+	}
+
+	log.Info("protocol chaos applied", "uid", uid)
+	return &pb.ApplyProtocolChaosResponse{InstanceUid: uid}, nil
+}
+
+func (s *DaemonServer) RecoverProtocolChaos(ctx context.Context, in *pb.RecoverProtocolChaosRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("recovering protocol chaos", "uid", in.InstanceUid, "source", in.Source)
+
+	if err := s.recoverRedirectedProxy(ctx, in.InstanceUid, in.Source); err != nil {
+		return nil, errors.Wrap(err, "recover protocol chaos")
+	}
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolproxy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// the maximum size of a request, which is the default socket.request.max.bytes of kafka
+const kafkaMaxRequestSize = 100 * 1024 * 1024
+
+// the names of requests, the others are named by their api keys
+var kafkaAPIs = map[int16]string{
+	0:  "Produce",
+	1:  "Fetch",
+	2:  "ListOffsets",
+	3:  "Metadata",
+	8:  "OffsetCommit",
+	9:  "OffsetFetch",
+	10: "FindCoordinator",
+	11: "JoinGroup",
+	12: "Heartbeat",
+	13: "LeaveGroup",
+	14: "SyncGroup",
+	15: "DescribeGroups",
+	16: "ListGroups",
+	17: "SaslHandshake",
+	18: "ApiVersions",
+	19: "CreateTopics",
+	20: "DeleteTopics",
+	21: "DeleteRecords",
+	22: "InitProducerId",
+	24: "AddPartitionsToTxn",
+	25: "AddOffsetsToTxn",
+	26: "EndTxn",
+	28: "TxnOffsetCommit",
+	36: "SaslAuthenticate",
+}
+
+// serveKafka parses the requests from the client, and forwards the responses from
+// the server as they are. The requests after a delayed one are held until it's
+// forwarded, to keep the order of responses, so that a delay longer than the
+// request.timeout.ms of client makes the requests time out.
+func (p *Proxy) serveKafka(client net.Conn, server net.Conn) {
+	go func() {
+		io.Copy(client, server)
+		client.Close()
+	}()
+
+	reader := bufio.NewReader(client)
+	for {
+		request, err := readKafkaRequest(reader)
+		if err != nil {
+			return
+		}
+
+		apiKey := int16(binary.BigEndian.Uint16(request[4:6]))
+		cmd := command{name: kafkaAPIs[apiKey]}
+		if len(cmd.name) == 0 {
+			cmd.name = strconv.Itoa(int(apiKey))
+		}
+
+		if _, abort := p.inject(cmd); abort {
+			return
+		}
+
+		if _, err := server.Write(request); err != nil {
+			return
+		}
+	}
+}
+
+// readKafkaRequest reads a request including the size
+func readKafkaRequest(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header)
+	// a request starts with the api key and api version
+	if size < 4 || size > kafkaMaxRequestSize {
+		return nil, errors.Errorf("invalid size %d of request", size)
+	}
+
+	request := make([]byte, 4+size)
+	copy(request, header)
+	if _, err := io.ReadFull(r, request[4:]); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolproxy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	mysqlHeaderLength = 4
+	// the length of payload of a packet which is followed by another packet of the same payload
+	mysqlMaxPayloadLength = 0xffffff
+
+	mysqlClientCompress = 0x20
+	mysqlClientSSL      = 0x800
+
+	// ER_UNKNOWN_ERROR
+	defaultMySQLErrorCode = 1105
+	defaultMySQLError     = "injected by chaos mesh"
+)
+
+// the names of commands, the others are named by their numbers
+var mysqlCommands = map[byte]string{
+	0x01: "QUIT",
+	0x02: "INIT_DB",
+	0x03: "QUERY",
+	0x0e: "PING",
+	0x16: "STMT_PREPARE",
+	0x17: "STMT_EXECUTE",
+	0x19: "STMT_CLOSE",
+	0x1a: "STMT_RESET",
+	0x1f: "RESET_CONNECTION",
+}
+
+// serveMySQL parses the commands from the client, and forwards the packets from the
+// server as they are. The command phase of mysql is request-response, so an error
+// packet can be responded in place of a command. The connections using TLS or
+// compression are forwarded without injection.
+func (p *Proxy) serveMySQL(client net.Conn, server net.Conn) {
+	clientWriter := &lockedWriter{w: client}
+	go func() {
+		io.Copy(clientWriter, server)
+		client.Close()
+	}()
+
+	reader := bufio.NewReader(client)
+	first := true
+	skipping := false
+	for {
+		packet, err := readMySQLPacket(reader)
+		if err != nil {
+			return
+		}
+		seq, payload := packet[3], packet[mysqlHeaderLength:]
+
+		if first {
+			first = false
+			// the handshake response or the ssl request
+			if len(payload) >= 4 && binary.LittleEndian.Uint32(payload[:4])&(mysqlClientSSL|mysqlClientCompress) != 0 {
+				if _, err := server.Write(packet); err != nil {
+					return
+				}
+				io.Copy(server, reader)
+				return
+			}
+		}
+
+		if skipping {
+			// the rest of a command which is responded with an error
+			skipping = len(payload) == mysqlMaxPayloadLength
+			continue
+		}
+
+		// every command starts a new sequence
+		if seq == 0 && len(payload) > 0 {
+			cmd := command{name: mysqlCommands[payload[0]]}
+			if len(cmd.name) == 0 {
+				cmd.name = strconv.Itoa(int(payload[0]))
+			}
+			switch cmd.name {
+			case "QUERY", "STMT_PREPARE", "INIT_DB":
+				cmd.query = string(payload[1:])
+			}
+
+			rule, abort := p.inject(cmd)
+			if abort {
+				return
+			}
+			if rule != nil && rule.Actions.Error != nil {
+				code := int32(defaultMySQLErrorCode)
+				if rule.Actions.Error.Code != nil {
+					code = *rule.Actions.Error.Code
+				}
+				if _, err := clientWriter.Write(mysqlError(code, rule.Actions.Error.Message)); err != nil {
+					return
+				}
+				skipping = len(payload) == mysqlMaxPayloadLength
+				continue
+			}
+		}
+
+		if _, err := server.Write(packet); err != nil {
+			return
+		}
+	}
+}
+
+// mysqlError encodes an error packet in response to a command
+func mysqlError(code int32, message string) []byte {
+	if len(message) == 0 {
+		message = defaultMySQLError
+	}
+
+	payload := []byte{0xff, byte(code), byte(code >> 8), '#'}
+	payload = append(payload, "HY000"...)
+	payload = append(payload, message...)
+
+	packet := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), 1}
+	return append(packet, payload...)
+}
+
+// readMySQLPacket reads a packet including the header
+func readMySQLPacket(r *bufio.Reader) ([]byte, error) {
+	header := make([]byte, mysqlHeaderLength)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+
+	packet := make([]byte, mysqlHeaderLength+length)
+	copy(packet, header)
+	if _, err := io.ReadFull(r, packet[mysqlHeaderLength:]); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
@@ -0,0 +1,218 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolproxy
+
+import (
+	"io"
+	"net"
+	pathpkg "path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+const (
+	Redis = "redis"
+	MySQL = "mysql"
+	Kafka = "kafka"
+)
+
+// Proxy is a proxy of the plaintext connections of a wire protocol. It parses the
+// commands sent by the clients, injects faults into the commands selected by the
+// rules, and forwards the others to the upstream server of the connection.
+type Proxy struct {
+	protocol string
+
+	// target returns the address of the upstream server of an accepted connection
+	target func(conn net.Conn) (string, error)
+
+	sync.RWMutex
+	rules []rule
+}
+
+type rule struct {
+	tproxyconfig.ProtocolRule
+
+	delay time.Duration
+	query *regexp.Regexp
+}
+
+// command is a command parsed from the client, the key and query are empty if
+// the protocol or the command doesn't have them
+type command struct {
+	name  string
+	key   string
+	query string
+}
+
+// New creates a proxy of the protocol, the target returns the address of the upstream
+// server of an accepted connection, which is usually grpcproxy.OriginalDestination.
+func New(protocol string, target func(conn net.Conn) (string, error)) (*Proxy, error) {
+	switch protocol {
+	case Redis, MySQL, Kafka:
+	default:
+		return nil, errors.Errorf("protocol %s is not supported", protocol)
+	}
+
+	return &Proxy{
+		protocol: protocol,
+		target:   target,
+	}, nil
+}
+
+// SetRules replaces the rules of proxy, it's applied to the new commands.
+func (p *Proxy) SetRules(rules []tproxyconfig.ProtocolRule) error {
+	parsed := make([]rule, 0, len(rules))
+	for _, r := range rules {
+		item := rule{ProtocolRule: r}
+		if r.Actions.Delay != nil {
+			delay, err := time.ParseDuration(*r.Actions.Delay)
+			if err != nil {
+				return errors.Wrapf(err, "parse delay %s", *r.Actions.Delay)
+			}
+			item.delay = delay
+		}
+		if r.Actions.Error != nil && p.protocol == Kafka {
+			return errors.New("error action is not supported for kafka")
+		}
+		if r.Selector.KeyPattern != nil {
+			if _, err := pathpkg.Match(*r.Selector.KeyPattern, ""); err != nil {
+				return errors.Wrapf(err, "parse key pattern %s", *r.Selector.KeyPattern)
+			}
+		}
+		if r.Selector.QueryPattern != nil {
+			query, err := regexp.Compile(*r.Selector.QueryPattern)
+			if err != nil {
+				return errors.Wrapf(err, "parse query pattern %s", *r.Selector.QueryPattern)
+			}
+			item.query = query
+		}
+		parsed = append(parsed, item)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	p.rules = parsed
+	return nil
+}
+
+// ServeConfig serves the config on in and out with tproxyconfig.Serve, a `PUT /` request
+// with the json of tproxyconfig.ProtocolConfig replaces the rules of proxy.
+func (p *Proxy) ServeConfig(in io.Reader, out io.Writer) error {
+	return tproxyconfig.Serve(in, out, tproxyconfig.PutRules(p.SetRules))
+}
+
+// Serve accepts the connections on the listener, and serves them until the listener is closed.
+func (p *Proxy) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go p.serveConn(conn)
+	}
+}
+
+func (p *Proxy) serveConn(conn net.Conn) {
+	defer conn.Close()
+
+	target, err := p.target(conn)
+	if err != nil {
+		return
+	}
+
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		return
+	}
+	defer upstream.Close()
+
+	switch p.protocol {
+	case Redis:
+		p.serveRedis(conn, upstream)
+	case MySQL:
+		p.serveMySQL(conn, upstream)
+	case Kafka:
+		p.serveKafka(conn, upstream)
+	}
+}
+
+// match returns the first rule selecting the command, or nil
+func (p *Proxy) match(cmd command) *rule {
+	p.RLock()
+	defer p.RUnlock()
+	for i := range p.rules {
+		selector := p.rules[i].Selector
+		if selector.Command != nil && !strings.EqualFold(*selector.Command, cmd.name) {
+			continue
+		}
+		if selector.KeyPattern != nil {
+			if matched, _ := pathpkg.Match(*selector.KeyPattern, cmd.key); !matched {
+				continue
+			}
+		}
+		if p.rules[i].query != nil && !p.rules[i].query.MatchString(cmd.query) {
+			continue
+		}
+		return &p.rules[i]
+	}
+	return nil
+}
+
+// inject matches the command with the rules and waits for the delay of the
+// selected rule. It returns the rule, or nil if the command isn't selected,
+// and whether the connection should be closed.
+func (p *Proxy) inject(cmd command) (*rule, bool) {
+	rule := p.match(cmd)
+	if rule == nil {
+		return nil, false
+	}
+	if rule.delay > 0 {
+		time.Sleep(rule.delay)
+	}
+	return rule, rule.Actions.Abort
+}
+
+// pipe copies the data in both directions until any of them is closed
+func pipe(client io.ReadWriter, server io.ReadWriter) {
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(server, client)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(client, server)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// lockedWriter serializes the writes from the proxy and the upstream server to the client
+type lockedWriter struct {
+	sync.Mutex
+	w io.Writer
+}
+
+func (l *lockedWriter) Write(b []byte) (int, error) {
+	l.Lock()
+	defer l.Unlock()
+	return l.w.Write(b)
+}
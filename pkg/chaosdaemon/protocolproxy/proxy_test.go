@@ -0,0 +1,293 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolproxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+// startProxy starts a fake server which serves every connection with handle, and
+// a proxy in front of it. It returns the proxy and the address of the proxy.
+func startProxy(t *testing.T, protocol string, handle func(conn net.Conn)) (*Proxy, string) {
+	g := NewWithT(t)
+
+	serverListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(func() { serverListener.Close() })
+	go func() {
+		for {
+			conn, err := serverListener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				handle(conn)
+			}()
+		}
+	}()
+
+	proxyListener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).ShouldNot(HaveOccurred())
+	t.Cleanup(func() { proxyListener.Close() })
+	proxy, err := New(protocol, func(net.Conn) (string, error) {
+		return serverListener.Addr().String(), nil
+	})
+	g.Expect(err).ShouldNot(HaveOccurred())
+	go proxy.Serve(proxyListener)
+
+	return proxy, proxyListener.Addr().String()
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+// fakeRedis replies the value of GET with the key, and OK to the other commands
+func fakeRedis(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	for {
+		_, args, err := readRedisCommand(reader)
+		if err != nil {
+			return
+		}
+		if len(args) == 2 && args[0] == "GET" {
+			conn.Write([]byte("$" + strconv.Itoa(len(args[1])) + "\r\n" + args[1] + "\r\n"))
+		} else {
+			conn.Write([]byte("+OK\r\n"))
+		}
+	}
+}
+
+func redisCommand(args ...string) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		buf.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n" + arg + "\r\n")
+	}
+	return buf.Bytes()
+}
+
+func TestRedisProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	proxy, addr := startProxy(t, Redis, fakeRedis)
+	g.Expect(proxy.SetRules([]tproxyconfig.ProtocolRule{
+		{
+			Selector: tproxyconfig.ProtocolSelector{Command: stringPtr("get"), KeyPattern: stringPtr("session:*")},
+			Actions:  tproxyconfig.ProtocolActions{Error: &tproxyconfig.ProtocolError{Message: "READONLY injected"}},
+		},
+		{
+			Selector: tproxyconfig.ProtocolSelector{Command: stringPtr("FLUSHALL")},
+			Actions:  tproxyconfig.ProtocolActions{Abort: true},
+		},
+	})).Should(Succeed())
+
+	conn, err := net.Dial("tcp", addr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	// the commands are pipelined, and the replies keep the order
+	_, err = conn.Write(append(append(redisCommand("GET", "user:1"), redisCommand("GET", "session:1")...), redisCommand("SET", "session:1", "v")...))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	for _, expected := range []string{"$6\r\nuser:1\r\n", "-READONLY injected\r\n", "+OK\r\n"} {
+		reply, err := readRedisReply(reader)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(string(reply)).Should(Equal(expected))
+	}
+
+	_, err = conn.Write([]byte("PING\r\n"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	reply, err := readRedisReply(reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(reply)).Should(Equal("+OK\r\n"))
+
+	_, err = conn.Write(redisCommand("FLUSHALL"))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = reader.ReadByte()
+	g.Expect(err).Should(Equal(io.EOF))
+}
+
+func TestReadRedisReply(t *testing.T) {
+	g := NewWithT(t)
+
+	replies := []string{
+		"+OK\r\n",
+		"$-1\r\n",
+		"$5\r\nhello\r\n",
+		"*2\r\n$3\r\nfoo\r\n*-1\r\n",
+		"%1\r\n+key\r\n*2\r\n:1\r\n_\r\n",
+		"|1\r\n+ttl\r\n:3600\r\n$3\r\nbar\r\n",
+	}
+	all := ""
+	for _, reply := range replies {
+		all += reply
+	}
+
+	reader := bufio.NewReader(bytes.NewBufferString(all))
+	for _, expected := range replies {
+		reply, err := readRedisReply(reader)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(string(reply)).Should(Equal(expected))
+	}
+}
+
+func mysqlPacket(seq byte, payload []byte) []byte {
+	return append([]byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), seq}, payload...)
+}
+
+// fakeMySQL sends a handshake, accepts any handshake response, and responds OK to every command
+func fakeMySQL(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	conn.Write(mysqlPacket(0, []byte("\x0afake-mysql\x00")))
+	for {
+		packet, err := readMySQLPacket(reader)
+		if err != nil {
+			return
+		}
+		conn.Write(mysqlPacket(packet[3]+1, []byte{0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00}))
+	}
+}
+
+func TestMySQLProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	code := int32(1205)
+	proxy, addr := startProxy(t, MySQL, fakeMySQL)
+	g.Expect(proxy.SetRules([]tproxyconfig.ProtocolRule{
+		{
+			Selector: tproxyconfig.ProtocolSelector{Command: stringPtr("QUERY"), QueryPattern: stringPtr("(?i)^select .* from orders")},
+			Actions:  tproxyconfig.ProtocolActions{Error: &tproxyconfig.ProtocolError{Code: &code, Message: "Lock wait timeout exceeded"}},
+		},
+	})).Should(Succeed())
+
+	conn, err := net.Dial("tcp", addr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	handshake, err := readMySQLPacket(reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(handshake[3]).Should(Equal(byte(0)))
+
+	response := make([]byte, 32)
+	binary.LittleEndian.PutUint32(response, 0x0200)
+	_, err = conn.Write(mysqlPacket(1, response))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	ok, err := readMySQLPacket(reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(ok[4]).Should(Equal(byte(0x00)))
+
+	_, err = conn.Write(mysqlPacket(0, append([]byte{0x03}, "SELECT * FROM orders WHERE id = 1"...)))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	packet, err := readMySQLPacket(reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(packet[3]).Should(Equal(byte(1)))
+	g.Expect(packet[4]).Should(Equal(byte(0xff)))
+	g.Expect(binary.LittleEndian.Uint16(packet[5:7])).Should(Equal(uint16(1205)))
+	g.Expect(string(packet[7:13])).Should(Equal("#HY000"))
+	g.Expect(string(packet[13:])).Should(Equal("Lock wait timeout exceeded"))
+
+	_, err = conn.Write(mysqlPacket(0, append([]byte{0x03}, "SELECT * FROM users"...)))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	packet, err = readMySQLPacket(reader)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(packet[4]).Should(Equal(byte(0x00)))
+}
+
+func kafkaRequest(apiKey int16, correlationID int32) []byte {
+	request := make([]byte, 12)
+	binary.BigEndian.PutUint32(request, 8)
+	binary.BigEndian.PutUint16(request[4:], uint16(apiKey))
+	binary.BigEndian.PutUint32(request[8:], uint32(correlationID))
+	return request
+}
+
+// fakeKafka responds every request with its correlation id
+func fakeKafka(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	for {
+		request, err := readKafkaRequest(reader)
+		if err != nil {
+			return
+		}
+		response := make([]byte, 8)
+		binary.BigEndian.PutUint32(response, 4)
+		copy(response[4:], request[8:12])
+		conn.Write(response)
+	}
+}
+
+func TestKafkaProxy(t *testing.T) {
+	g := NewWithT(t)
+
+	proxy, addr := startProxy(t, Kafka, fakeKafka)
+	g.Expect(proxy.SetRules([]tproxyconfig.ProtocolRule{
+		{
+			Selector: tproxyconfig.ProtocolSelector{Command: stringPtr("Produce")},
+			Actions:  tproxyconfig.ProtocolActions{Delay: stringPtr("200ms")},
+		},
+		{
+			Selector: tproxyconfig.ProtocolSelector{Command: stringPtr("DeleteTopics")},
+			Actions:  tproxyconfig.ProtocolActions{Abort: true},
+		},
+	})).Should(Succeed())
+	g.Expect(proxy.SetRules([]tproxyconfig.ProtocolRule{
+		{Actions: tproxyconfig.ProtocolActions{Error: &tproxyconfig.ProtocolError{Message: "error"}}},
+	})).ShouldNot(Succeed())
+
+	conn, err := net.Dial("tcp", addr)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	readCorrelationID := func() int32 {
+		response := make([]byte, 8)
+		_, err := io.ReadFull(reader, response)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		return int32(binary.BigEndian.Uint32(response[4:]))
+	}
+
+	start := time.Now()
+	_, err = conn.Write(kafkaRequest(3, 1))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(readCorrelationID()).Should(Equal(int32(1)))
+	g.Expect(time.Since(start)).Should(BeNumerically("<", 200*time.Millisecond))
+
+	start = time.Now()
+	_, err = conn.Write(append(kafkaRequest(0, 2), kafkaRequest(3, 3)...))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(readCorrelationID()).Should(Equal(int32(2)))
+	g.Expect(readCorrelationID()).Should(Equal(int32(3)))
+	g.Expect(time.Since(start)).Should(BeNumerically(">=", 200*time.Millisecond))
+
+	_, err = conn.Write(kafkaRequest(20, 4))
+	g.Expect(err).ShouldNot(HaveOccurred())
+	_, err = reader.ReadByte()
+	g.Expect(err).Should(Equal(io.EOF))
+}
@@ -0,0 +1,215 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package protocolproxy
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// the default error of redis responded by error action
+const defaultRedisError = "ERR injected by chaos mesh"
+
+// the commands after which the server pushes messages without requests, so that
+// the commands and replies cannot be paired any more
+var redisPushCommands = map[string]bool{
+	"SUBSCRIBE":  true,
+	"PSUBSCRIBE": true,
+	"SSUBSCRIBE": true,
+	"MONITOR":    true,
+}
+
+// serveRedis forwards the commands one by one, and reads exactly one reply of each
+// forwarded command, so that an error can be responded in place of a command
+// without breaking the order of replies.
+func (p *Proxy) serveRedis(client net.Conn, server net.Conn) {
+	clientReader := bufio.NewReader(client)
+	serverReader := bufio.NewReader(server)
+
+	for {
+		raw, args, err := readRedisCommand(clientReader)
+		if err != nil {
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		cmd := command{name: strings.ToUpper(args[0])}
+		if len(args) > 1 {
+			cmd.key = args[1]
+		}
+
+		rule, abort := p.inject(cmd)
+		if abort {
+			return
+		}
+		if rule != nil && rule.Actions.Error != nil {
+			if _, err := client.Write(redisError(rule.Actions.Error.Message)); err != nil {
+				return
+			}
+			continue
+		}
+
+		if _, err := server.Write(raw); err != nil {
+			return
+		}
+		if redisPushCommands[cmd.name] {
+			pipe(struct {
+				io.Reader
+				io.Writer
+			}{clientReader, client}, struct {
+				io.Reader
+				io.Writer
+			}{serverReader, server})
+			return
+		}
+
+		reply, err := readRedisReply(serverReader)
+		if err != nil {
+			return
+		}
+		if _, err := client.Write(reply); err != nil {
+			return
+		}
+	}
+}
+
+// redisError encodes the message as a simple error of RESP
+func redisError(message string) []byte {
+	if len(message) == 0 {
+		message = defaultRedisError
+	}
+	message = strings.NewReplacer("\r", " ", "\n", " ").Replace(message)
+	return []byte("-" + message + "\r\n")
+}
+
+// readRedisCommand reads a command, which is an array of bulk strings or an
+// inline command. It returns the raw bytes and the arguments of the command.
+func readRedisCommand(r *bufio.Reader) ([]byte, []string, error) {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(line) == 0 || line[0] != '*' {
+		// an inline command
+		return line, strings.Fields(string(line)), nil
+	}
+
+	count, err := strconv.Atoi(string(bytes.TrimSpace(line[1:])))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse length of command")
+	}
+
+	raw := bytes.NewBuffer(line)
+	args := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		header, err := readRedisLine(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(header) == 0 || header[0] != '$' {
+			return nil, nil, errors.Errorf("unexpected argument %q", header)
+		}
+		length, err := strconv.Atoi(string(bytes.TrimSpace(header[1:])))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "parse length of argument")
+		}
+
+		raw.Write(header)
+		arg := make([]byte, length+2)
+		if _, err := io.ReadFull(r, arg); err != nil {
+			return nil, nil, err
+		}
+		raw.Write(arg)
+		args = append(args, string(arg[:length]))
+	}
+
+	return raw.Bytes(), args, nil
+}
+
+// readRedisReply reads the raw bytes of a complete reply of RESP2 or RESP3
+func readRedisReply(r *bufio.Reader) ([]byte, error) {
+	raw := &bytes.Buffer{}
+	if err := readRedisValue(r, raw); err != nil {
+		return nil, err
+	}
+	return raw.Bytes(), nil
+}
+
+func readRedisValue(r *bufio.Reader, raw *bytes.Buffer) error {
+	line, err := readRedisLine(r)
+	if err != nil {
+		return err
+	}
+	raw.Write(line)
+	if len(line) < 3 {
+		return errors.Errorf("unexpected reply %q", line)
+	}
+
+	switch line[0] {
+	case '+', '-', ':', '_', ',', '#', '(':
+		return nil
+	case '$', '!', '=':
+		length, err := strconv.Atoi(string(line[1 : len(line)-2]))
+		if err != nil {
+			return errors.Wrap(err, "parse length of bulk string")
+		}
+		if length < 0 {
+			return nil
+		}
+		_, err = io.CopyN(raw, r, int64(length)+2)
+		return err
+	case '*', '~', '>', '%', '|':
+		count, err := strconv.Atoi(string(line[1 : len(line)-2]))
+		if err != nil {
+			return errors.Wrap(err, "parse length of aggregate")
+		}
+		if line[0] == '%' || line[0] == '|' {
+			count *= 2
+		}
+		if line[0] == '|' {
+			// the attributes are followed by the actual reply
+			count++
+		}
+		for i := 0; i < count; i++ {
+			if err := readRedisValue(r, raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unexpected type of reply %q", line[0])
+	}
+}
+
+// readRedisLine reads a line including the trailing CRLF
+func readRedisLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return nil, errors.Errorf("unexpected line %q", line)
+	}
+	return line, nil
+}
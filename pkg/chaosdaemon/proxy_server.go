@@ -0,0 +1,243 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+)
+
+// redirectedProxy is a proxy process which the tcp connections to a port of container are
+// redirected into. Only one redirect rule of the port takes effect, so the proxy is shared
+// by all the chaos on the port, and their rules are merged in the order of sources.
+type redirectedProxy struct {
+	uid string
+
+	// args is the arguments of chaos-daemon-helper which starts the proxy
+	args []string
+
+	// rules is the json of rules by the chaos which they come from
+	rules map[string][]json.RawMessage
+}
+
+// redirectedProxies is the running redirected proxies by their containers and ports
+type redirectedProxies struct {
+	sync.Mutex
+	proxies map[string]*redirectedProxy
+}
+
+func newRedirectedProxies() *redirectedProxies {
+	return &redirectedProxies{proxies: make(map[string]*redirectedProxy)}
+}
+
+// applyRedirectedProxy replaces the rules of source in the proxy on the port of container, and
+// returns the uid of proxy. The proxy is started with the arguments of chaos-daemon-helper if
+// it's not running, and the proxies started with other arguments cannot be shared. The rules of
+// source are removed again if the proxy rejects them.
+func (s *DaemonServer) applyRedirectedProxy(ctx context.Context, containerID string, port uint32, enterNS bool, source string, rules string, args ...string) (string, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	var parsed []json.RawMessage
+	if err := json.Unmarshal([]byte(rules), &parsed); err != nil {
+		return "", errors.Wrap(err, "unmarshal rules")
+	}
+
+	s.redirectedProxies.Lock()
+	defer s.redirectedProxies.Unlock()
+
+	key := fmt.Sprintf("%s-%d", containerID, port)
+	proxy, ok := s.redirectedProxies.proxies[key]
+	if ok && !reflect.DeepEqual(proxy.args, args) {
+		for other := range proxy.rules {
+			if other != source {
+				return "", errors.Errorf("port %d is proxied by %v of %s", port, proxy.args, other)
+			}
+		}
+		// the proxy only has the rules of source, and is replaced by the new one
+		if err := s.stopRedirectedProxy(ctx, key, proxy); err != nil {
+			return "", err
+		}
+		proxy, ok = nil, false
+	}
+	if ok {
+		if _, running := s.backgroundProcessManager.GetPipes(proxy.uid); !running {
+			// the proxy has exited, start another one with the same rules
+			ok = false
+		}
+	}
+	if !ok {
+		uid, err := s.startRedirectedProxy(ctx, containerID, enterNS, fmt.Sprintf("%s-%s", args[0], key), args)
+		if err != nil {
+			return "", err
+		}
+		if proxy == nil {
+			proxy = &redirectedProxy{args: args, rules: make(map[string][]json.RawMessage)}
+			s.redirectedProxies.proxies[key] = proxy
+		}
+		proxy.uid = uid
+	}
+
+	proxy.rules[source] = parsed
+	err := s.putRedirectedProxyConfig(ctx, proxy)
+	if err == nil {
+		return proxy.uid, nil
+	}
+
+	delete(proxy.rules, source)
+	if len(proxy.rules) == 0 {
+		if stopError := s.stopRedirectedProxy(ctx, key, proxy); stopError != nil {
+			log.Error(stopError, "stop proxy", "uid", proxy.uid)
+		}
+	} else if restoreError := s.putRedirectedProxyConfig(ctx, proxy); restoreError != nil {
+		log.Error(restoreError, "restore rules of proxy", "uid", proxy.uid)
+	}
+	return "", errors.Wrap(err, "apply config")
+}
+
+// startRedirectedProxy starts chaos-daemon-helper with the arguments in the network namespace
+// of container, and returns the uid of process
+func (s *DaemonServer) startRedirectedProxy(ctx context.Context, containerID string, enterNS bool, identifier string, args []string) (string, error) {
+	pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+	if err != nil {
+		return "", errors.Wrapf(err, "get PID of container(%s)", containerID)
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, args...).
+		SetIdentifier(identifier).
+		SetEnv(pathEnv, os.Getenv(pathEnv))
+
+	if enterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.NetNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	cmd.Stderr = os.Stderr
+
+	proc, err := s.backgroundProcessManager.StartProcess(ctx, cmd)
+	if err != nil {
+		return "", errors.Wrapf(err, "execute command(%s)", cmd)
+	}
+	return proc.Uid, nil
+}
+
+// recoverRedirectedProxy removes the rules of source from the proxy with the uid, and stops the
+// proxy when no rule is left. All the rules are removed if the source is empty.
+func (s *DaemonServer) recoverRedirectedProxy(ctx context.Context, uid string, source string) error {
+	s.redirectedProxies.Lock()
+	defer s.redirectedProxies.Unlock()
+
+	for key, proxy := range s.redirectedProxies.proxies {
+		if proxy.uid != uid {
+			continue
+		}
+
+		delete(proxy.rules, source)
+		if len(proxy.rules) == 0 || source == "" {
+			return s.stopRedirectedProxy(ctx, key, proxy)
+		}
+		return errors.Wrap(s.putRedirectedProxyConfig(ctx, proxy), "apply config")
+	}
+
+	if _, ok := s.backgroundProcessManager.GetPipes(uid); !ok {
+		// the proxy has exited, or chaos daemon has restarted
+		return nil
+	}
+	return errors.Wrapf(s.backgroundProcessManager.KillBackgroundProcess(ctx, uid), "kill proxy(%s)", uid)
+}
+
+func (s *DaemonServer) stopRedirectedProxy(ctx context.Context, key string, proxy *redirectedProxy) error {
+	delete(s.redirectedProxies.proxies, key)
+
+	if _, ok := s.backgroundProcessManager.GetPipes(proxy.uid); !ok {
+		return nil
+	}
+	return errors.Wrapf(s.backgroundProcessManager.KillBackgroundProcess(ctx, proxy.uid), "kill proxy(%s)", proxy.uid)
+}
+
+// putRedirectedProxyConfig sends the merged rules to the proxy
+func (s *DaemonServer) putRedirectedProxyConfig(ctx context.Context, proxy *redirectedProxy) error {
+	config, err := proxy.config()
+	if err != nil {
+		return err
+	}
+	return s.putProxyConfig(ctx, proxy.uid, config)
+}
+
+// putProxyConfig sends the config to the proxy process with the uid as a `PUT /` request,
+// which is served by tproxyconfig.Serve in the proxy
+func (s *DaemonServer) putProxyConfig(ctx context.Context, uid string, config []byte) error {
+	log := s.getLoggerFromContext(ctx)
+
+	pipes, ok := s.backgroundProcessManager.GetPipes(uid)
+	if !ok {
+		return errors.Errorf("fail to get process(%s)", uid)
+	}
+
+	transport := &stdioTransport{
+		uid:    uid,
+		locker: s.tproxyLocker,
+		pipes:  pipes,
+	}
+
+	log.Info("ready to apply", "uid", uid, "config", string(config))
+
+	req, err := http.NewRequest(http.MethodPut, "/", bytes.NewReader(config))
+	if err != nil {
+		return errors.Wrap(err, "create http request")
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return errors.Wrap(err, "send http request")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("proxy responds %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+// config returns the json of config with the rules of all sources, which are in the
+// order of sources
+func (p *redirectedProxy) config() ([]byte, error) {
+	sources := make([]string, 0, len(p.rules))
+	for source := range p.rules {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	rules := []json.RawMessage{}
+	for _, source := range sources {
+		rules = append(rules, p.rules[source]...)
+	}
+	return json.Marshal(map[string][]json.RawMessage{"rules": rules})
+}
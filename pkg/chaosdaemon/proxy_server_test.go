@@ -0,0 +1,40 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_redirectedProxyConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	proxy := &redirectedProxy{rules: map[string][]json.RawMessage{
+		"ns/b": {json.RawMessage(`{"b":1}`)},
+		"ns/a": {json.RawMessage(`{"a":1}`), json.RawMessage(`{"a":2}`)},
+		"ns/c": {},
+	}}
+	config, err := proxy.config()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(config)).Should(Equal(`{"rules":[{"a":1},{"a":2},{"b":1}]}`))
+
+	config, err = (&redirectedProxy{}).config()
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(string(config)).Should(Equal(`{"rules":[]}`))
+}
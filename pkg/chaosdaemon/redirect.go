@@ -0,0 +1,66 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"os"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+// cleanStaleRedirects removes the iptables and ip6tables rules which redirect the traffic into the proxies
+// in the network namespaces of all containers. The proxies remove the rules when they are
+// terminated, but they are killed with the previous chaos daemon without removing the rules.
+// It should be called before serving, or the rules of new proxies would be removed.
+func (s *DaemonServer) cleanStaleRedirects(ctx context.Context) {
+	log := s.rootLogger.WithName("clean-stale-redirects")
+
+	containerIDs, err := s.crClient.ListContainerIDs(ctx)
+	if err != nil {
+		log.Error(err, "fail to list containers")
+		return
+	}
+
+	cleaned := make(map[string]bool)
+	for _, containerID := range containerIDs {
+		pid, err := s.crClient.GetPidFromContainerID(ctx, containerID)
+		if err != nil {
+			log.Error(err, "fail to get pid of container", "containerID", containerID)
+			continue
+		}
+
+		// the containers of a pod share the same network namespace
+		netns := bpm.GetNsPath(pid, bpm.NetNS)
+		if link, err := os.Readlink(netns); err == nil {
+			netns = link
+		}
+		if cleaned[netns] {
+			continue
+		}
+		cleaned[netns] = true
+
+		cmd := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, "clean-redirects").
+			SetNS(pid, bpm.NetNS).
+			SetEnv(pathEnv, os.Getenv(pathEnv)).
+			SetContext(ctx).
+			Build(ctx)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			log.Error(util.EncodeOutputToError(output, err), "fail to clean stale redirects", "containerID", containerID)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func Test_cleanStaleRedirects(t *testing.T) {
+	g := NewWithT(t)
+
+	var commands []string
+	defer mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+		commands = append(commands, strings.Join(append([]string{cmd}, args...), " "))
+		return exec.Command("echo", "-n")
+	})()
+	defer mock.With("MockContainerdClient", &test.MockClient{})()
+	defer mock.With("pid", 9527)()
+
+	crc, err := crclients.CreateContainerRuntimeInfoClient(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server := NewDaemonServerWithCRClient(crc, nil, logr.Discard())
+	server.cleanStaleRedirects(context.TODO())
+
+	g.Expect(commands).To(HaveLen(1))
+	g.Expect(commands[0]).To(HavePrefix("/usr/local/bin/nsexec"))
+	g.Expect(commands[0]).To(HaveSuffix("/proc/9527/ns/net -- cdh clean-redirects"))
+}
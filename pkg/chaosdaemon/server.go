@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -41,6 +42,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tasks"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tcbpf"
 	grpcUtils "github.com/chaos-mesh/chaos-mesh/pkg/grpc"
 	"github.com/chaos-mesh/chaos-mesh/pkg/log"
 	"github.com/chaos-mesh/chaos-mesh/pkg/metrics"
@@ -56,6 +58,13 @@ type Config struct {
 	CrClientConfig *crclients.CrClientConfig
 	Profiling      bool
 
+	// NetworkRulesCheckInterval is the interval of checking the drift of
+	// network rules, the check is disabled if it's zero
+	NetworkRulesCheckInterval time.Duration
+
+	// TcBackend is the backend to apply the traffic controls, which is netem or bpf
+	TcBackend string
+
 	tlsConfig
 }
 
@@ -85,6 +94,22 @@ type DaemonServer struct {
 	// tproxyLocker is a set of tproxy processes to lock stdin/stdout/stderr
 	tproxyLocker *sync.Map
 
+	// netemProfiles is a map from container id to the cancel function of
+	// goroutines updating the netem according to the network profile
+	netemProfiles *sync.Map
+
+	// flappings is a map from the kind of rules and container id to the
+	// goroutine toggling the rules periodically
+	flappings *sync.Map
+
+	// networkRules is a map from container id to the last applied network rules
+	networkRules *sync.Map
+
+	// redirectedProxies is the proxies shared by the chaos on the same port of container
+	redirectedProxies *redirectedProxies
+
+	tcBackend tcBackend
+
 	IPSetLocker     *locker.Locker
 	timeChaosServer TimeChaosServer
 }
@@ -104,11 +129,15 @@ func newDaemonServer(clientConfig *crclients.CrClientConfig, reg prometheus.Regi
 
 // NewDaemonServerWithCRClient returns DaemonServer with container runtime client
 func NewDaemonServerWithCRClient(crClient crclients.ContainerRuntimeInfoClient, reg prometheus.Registerer, log logr.Logger) *DaemonServer {
-	return &DaemonServer{
+	s := &DaemonServer{
 		IPSetLocker:              locker.New(),
 		crClient:                 crClient,
 		backgroundProcessManager: bpm.StartBackgroundProcessManager(reg, log),
 		tproxyLocker:             new(sync.Map),
+		netemProfiles:            new(sync.Map),
+		flappings:                new(sync.Map),
+		networkRules:             new(sync.Map),
+		redirectedProxies:        newRedirectedProxies(),
 		rootLogger:               log,
 		timeChaosServer: TimeChaosServer{
 			podContainerNameProcessMap: tasks.NewPodProcessMap(),
@@ -117,6 +146,8 @@ func NewDaemonServerWithCRClient(crClient crclients.ContainerRuntimeInfoClient,
 			logger:                     logr.New(log.GetSink()).WithName("TimeChaos"),
 		},
 	}
+	s.tcBackend = &netemTcBackend{s}
+	return s
 }
 
 func newGRPCServer(daemonServer *DaemonServer, reg prometheus.Registerer, tlsConf tlsConfig) (*grpc.Server, error) {
@@ -202,16 +233,30 @@ type Server struct {
 
 	conf   *Config
 	logger logr.Logger
+
+	// ctx is canceled when the server shuts down, to stop the background goroutines of daemon server
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // BuildServer builds a chaos daemon server
 func BuildServer(conf *Config, reg RegisterGatherer, log logr.Logger) (*Server, error) {
 	server := &Server{conf: conf, logger: log}
+	server.ctx, server.cancel = context.WithCancel(context.Background())
 	var err error
 	server.daemonServer, err = newDaemonServer(conf.CrClientConfig, reg, log)
 	if err != nil {
 		return nil, errors.Wrap(err, "create daemon server")
 	}
+	server.daemonServer.tcBackend, err = newTcBackend(conf.TcBackend, server.daemonServer)
+	if err != nil {
+		return nil, errors.Wrap(err, "create tc backend")
+	}
+	if conf.TcBackend == BPFTcBackend {
+		if err := tcbpf.Mount(bpfFSPath); err != nil {
+			return nil, errors.Wrap(err, "mount bpffs")
+		}
+	}
 
 	server.httpServer = newHTTPServerBuilder().Addr(conf.HttpAddr()).Metrics(reg).Profiling(conf.Profiling).Build()
 	server.grpcServer, err = newGRPCServer(server.daemonServer, reg, conf.tlsConfig)
@@ -232,6 +277,13 @@ func (s *Server) Start() error {
 
 	var eg errgroup.Group
 
+	s.daemonServer.cleanStaleRedirects(s.ctx)
+
+	if s.conf.NetworkRulesCheckInterval > 0 {
+		s.logger.Info("Starting to check the drift of network rules", "interval", s.conf.NetworkRulesCheckInterval)
+		go s.daemonServer.watchNetworkRules(s.ctx, s.conf.NetworkRulesCheckInterval)
+	}
+
 	eg.Go(func() error {
 		s.logger.Info("Starting http endpoint", "address", s.conf.HttpAddr())
 		if err := s.httpServer.ListenAndServe(); err != nil {
@@ -252,6 +304,7 @@ func (s *Server) Start() error {
 }
 
 func (s *Server) Shutdown() error {
+	s.cancel()
 	if err := s.httpServer.Shutdown(context.TODO()); err != nil {
 		return errors.Wrap(err, "shut grpc endpoint down")
 	}
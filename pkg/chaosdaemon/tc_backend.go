@@ -0,0 +1,192 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tcbpf"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+const (
+	// NetemTcBackend applies the traffic controls with the qdiscs of tc, e.g. netem, tbf and prio
+	NetemTcBackend = "netem"
+
+	// BPFTcBackend applies the traffic controls with a tc-bpf program, which delays the packets
+	// by setting their earliest departure time, and drops or limits the rate of every flow
+	BPFTcBackend = "bpf"
+
+	// bpfFSPath is the path to mount the bpffs, where the programs and maps are pinned
+	bpfFSPath = "/sys/fs/bpf"
+	// bpfPinPath is the directory on bpffs to pin the programs and maps of bpf backend
+	bpfPinPath = bpfFSPath + "/chaos-mesh/tc"
+
+	clsactNotExist = "Cannot find specified qdisc"
+)
+
+// tcBackend applies the traffic controls in the network namespace of a container
+type tcBackend interface {
+	// setTcs removes the traffic controls applied by previous requests on all devices, and
+	// applies the tcs. The ipsets are the ipsets applied on the container, which the tcs refer to.
+	setTcs(log logr.Logger, tcCli tcClient, in *pb.TcsRequest, tcs []*pb.Tc, ipsets []*pb.IPSet) error
+
+	// qdiscKinds returns the kinds of qdiscs set on a device for the tcs of the device,
+	// which are compared with the qdiscs in the network namespace to detect the drift
+	qdiscKinds(tcs []*pb.Tc) []string
+}
+
+func newTcBackend(name string, s *DaemonServer) (tcBackend, error) {
+	switch name {
+	case "", NetemTcBackend:
+		return &netemTcBackend{s}, nil
+	case BPFTcBackend:
+		return &bpfTcBackend{s}, nil
+	}
+	return nil, errors.Errorf("unknown tc backend %s", name)
+}
+
+// netemTcBackend chains the netem and tbf qdiscs, and classifies the packets into the qdiscs
+// through the prio qdisc and iptables
+type netemTcBackend struct {
+	s *DaemonServer
+}
+
+func (b *netemTcBackend) setTcs(log logr.Logger, tcCli tcClient, in *pb.TcsRequest, tcs []*pb.Tc, _ []*pb.IPSet) error {
+	return b.s.setTcs(log, tcCli, in, tcs)
+}
+
+func (b *netemTcBackend) qdiscKinds(tcs []*pb.Tc) []string {
+	var kinds []string
+	filtered := false
+	for _, tc := range tcs {
+		switch tc.Type {
+		case pb.Tc_NETEM:
+			kinds = append(kinds, "netem")
+		case pb.Tc_BANDWIDTH:
+			kinds = append(kinds, "tbf")
+		}
+		if len(abstractTcFilter(tc)) > 0 {
+			filtered = true
+		}
+	}
+	if filtered {
+		// the filtered tcs are under the bands of prio qdisc, and other bands go to sfq
+		kinds = append(kinds, "prio", "sfq", "sfq", "sfq")
+	}
+	return kinds
+}
+
+// bpfTcBackend attaches a tc-bpf program on the egress of devices, the fq qdisc on the root
+// of devices sends the packets at the departure time set by the program
+type bpfTcBackend struct {
+	s *DaemonServer
+}
+
+func (b *bpfTcBackend) setTcs(log logr.Logger, tcCli tcClient, in *pb.TcsRequest, tcs []*pb.Tc, ipsets []*pb.IPSet) error {
+	rules := make(map[string][]tcbpf.Rule)
+	for device, deviceTcs := range b.s.groupRulesAccordingToDevices(tcs) {
+		deviceRules, err := tcbpf.FromTcs(deviceTcs, ipsets)
+		if err != nil {
+			return err
+		}
+		rules[device] = deviceRules
+	}
+
+	ifaces, err := getAllInterfaces(tcCli.ctx, log, tcCli.pid, in.EnterNS)
+	if err != nil {
+		log.Error(err, "error while getting interfaces")
+		return err
+	}
+	for _, iface := range ifaces {
+		if err := tcCli.removeClsact(iface); err != nil {
+			log.Error(err, "fail to remove clsact qdisc on device", "device", iface)
+			return err
+		}
+		if err := tcCli.flush(iface); err != nil {
+			log.Error(err, "fail to flush tc rules on device", "device", iface)
+			return err
+		}
+	}
+
+	dir := bpfPinDir(in.ContainerId)
+	if err := tcbpf.Unpin(dir); err != nil {
+		log.Error(err, "fail to unpin bpf program", "path", dir)
+		return err
+	}
+
+	devices := make([]string, 0, len(rules))
+	for device := range rules {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+	for _, device := range devices {
+		deviceRules := rules[device]
+		prog, err := loadBPF(filepath.Join(dir, device), deviceRules)
+		if err != nil {
+			log.Error(err, "error while loading bpf program", "device", device)
+			return err
+		}
+
+		log.Info("attaching bpf program", "device", device, "program", prog, "rules", len(deviceRules))
+		commands := [][]string{
+			// the handle 1: is the same as netem backend, which is checked by the drift detection
+			{"tc", "qdisc", "add", "dev", device, "root", "handle", "1:", "fq"},
+			{"tc", "qdisc", "add", "dev", device, "clsact"},
+			{"tc", "filter", "add", "dev", device, "egress", "bpf", "direct-action", "object-pinned", prog},
+		}
+		for _, command := range commands {
+			if err := tcCli.run(command[0], command[1:]...); err != nil {
+				log.Error(err, "error while attaching bpf program", "device", device)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadBPF loads the program with the rules, which could be mocked in the tests
+func loadBPF(dir string, rules []tcbpf.Rule) (string, error) {
+	if load := mock.On("MockLoadBPF"); load != nil {
+		return load.(func(string, []tcbpf.Rule) (string, error))(dir, rules)
+	}
+	return tcbpf.Load(dir, rules)
+}
+
+func (b *bpfTcBackend) qdiscKinds(_ []*pb.Tc) []string {
+	return []string{"fq", "clsact"}
+}
+
+// bpfPinDir returns the directory to pin the programs and maps for the container
+func bpfPinDir(containerID string) string {
+	return filepath.Join(bpfPinPath, strings.NewReplacer("/", "_", ":", "_").Replace(containerID))
+}
+
+// removeClsact removes the clsact qdisc with the bpf filters on device
+func (c *tcClient) removeClsact(device string) error {
+	err := c.run("tc", "qdisc", "del", "dev", device, "clsact")
+	if err != nil && !strings.Contains(err.Error(), clsactNotExist) && !strings.Contains(err.Error(), ruleNotExistLowerVersion) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func Test_newTcBackend(t *testing.T) {
+	g := NewWithT(t)
+	s := &DaemonServer{}
+
+	backend, err := newTcBackend("", s)
+	g.Expect(err).To(BeNil())
+	g.Expect(backend).To(BeAssignableToTypeOf(&netemTcBackend{}))
+
+	backend, err = newTcBackend(BPFTcBackend, s)
+	g.Expect(err).To(BeNil())
+	g.Expect(backend).To(BeAssignableToTypeOf(&bpfTcBackend{}))
+
+	_, err = newTcBackend("unknown", s)
+	g.Expect(err).NotTo(BeNil())
+}
+
+func Test_bpfPinDir(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(bpfPinDir("containerd://abc")).To(Equal("/sys/fs/bpf/chaos-mesh/tc/containerd___abc"))
+}
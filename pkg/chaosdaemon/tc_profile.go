@@ -0,0 +1,109 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	netemutils "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/netem"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+const defaultNetemProfileInterval = time.Second
+
+// netemProfileTask represents a netem qdisc which is changed over time according to the profile
+type netemProfileTask struct {
+	device string
+	parent string
+	handle string
+	tc     *pb.Tc
+}
+
+// startNetemProfiles starts a goroutine for every task to update the netem, they
+// run until the profile finishes or the next tc request of the container arrives.
+func (s *DaemonServer) startNetemProfiles(log logr.Logger, containerID string, tcCli tcClient, tasks []netemProfileTask) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.netemProfiles.Store(containerID, cancel)
+
+	for _, task := range tasks {
+		go task.run(ctx, log, tcCli)
+	}
+}
+
+func (s *DaemonServer) stopNetemProfiles(containerID string) {
+	if cancel, ok := s.netemProfiles.LoadAndDelete(containerID); ok {
+		cancel.(context.CancelFunc)()
+	}
+}
+
+func (t netemProfileTask) run(ctx context.Context, log logr.Logger, tcCli tcClient) {
+	profile := t.tc.Profile
+	log = log.WithValues("device", t.device, "parent", t.parent, "handle", t.handle)
+
+	interval := time.Duration(profile.Interval)
+	if interval <= 0 {
+		interval = defaultNetemProfileInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var last *pb.Netem
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		_, point, finished := netemutils.EvaluateProfile(profile, time.Since(time.Unix(0, profile.StartTime)))
+		netem := netemutils.ApplyProfileStep(t.tc.Netem, profile, point)
+		if last == nil || !proto.Equal(last, netem) {
+			if err := tcCli.changeNetem(ctx, t.device, t.parent, t.handle, netem); err != nil {
+				// the qdisc may have been removed by the recovery, which will also stop this goroutine
+				log.Error(err, "fail to update netem according to profile")
+			} else {
+				last = netem
+			}
+		}
+
+		if finished {
+			log.Info("netem profile finished")
+			return
+		}
+	}
+}
+
+func (c *tcClient) changeNetem(ctx context.Context, device string, parent string, handle string, netem *pb.Netem) error {
+	args := fmt.Sprintf("qdisc change dev %s %s %s netem %s", device, parent, handle, convertNetemToArgs(netem))
+	processBuilder := bpm.DefaultProcessBuilder("tc", strings.Split(args, " ")...).SetContext(ctx)
+	if c.enterNS {
+		processBuilder = processBuilder.SetNS(c.pid, bpm.NetNS)
+	}
+	cmd := processBuilder.Build(ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return util.EncodeOutputToError(output, err)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -29,6 +30,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	netemutils "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/netem"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
 )
@@ -38,6 +40,12 @@ const (
 	ruleNotExistLowerVersion = "RTNETLINK answers: No such file or directory"
 
 	defaultDevice = "eth0"
+
+	// maxDeviceNameLength is the max length of network device name, which is IFNAMSIZ - 1
+	maxDeviceNameLength = 15
+
+	// maxPrioBands is the max number of bands of prio qdisc, which is TCQ_PRIO_BANDS
+	maxPrioBands = 16
 )
 
 func generateQdiscArgs(action string, qdisc *pb.Qdisc) ([]string, error) {
@@ -110,85 +118,235 @@ func getAllInterfaces(ctx context.Context, log logr.Logger, pid uint32, enterNS
 }
 
 func (s *DaemonServer) SetTcs(ctx context.Context, in *pb.TcsRequest) (*empty.Empty, error) {
+	rules := s.lockNetworkRules(in.ContainerId)
+	defer s.unlockNetworkRules(in.ContainerId, rules)
+
+	rules.tcs = nil
+	if err := s.applyTcs(ctx, in); err != nil {
+		return &empty.Empty{}, err
+	}
+	rules.tcs = in
+
+	return &empty.Empty{}, nil
+}
+
+func (s *DaemonServer) applyTcs(ctx context.Context, in *pb.TcsRequest) error {
 	log := s.getLoggerFromContext(ctx)
 	log.Info("handling tc request", "tcs", in)
 
 	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
+		return status.Errorf(codes.Internal, "get pid from containerID error: %v", err)
 	}
 
+	// the flapping of previous request is replaced by this request, it's stopped before
+	// the netem profiles, as the profiles are restarted by the flapping
+	s.stopFlapping(tcFlappingKey(in.ContainerId))
+
+	// the ipsets are applied before the tcs, and the requests of a container are serialized
+	var ipsets []*pb.IPSet
+	if value, ok := s.networkRules.Load(in.ContainerId); ok {
+		ipsets = value.(*networkRules).ipsets.GetIpsets()
+	}
+
+	if err := s.setActiveTcs(ctx, log, in, pid, ipsets); err != nil {
+		return err
+	}
+
+	var flaps []*pb.Flap
+	for _, tc := range in.Tcs {
+		if tc.Flap != nil {
+			flaps = append(flaps, tc.Flap)
+		}
+	}
+	if len(flaps) > 0 {
+		s.startFlapping(log, tcFlappingKey(in.ContainerId), flaps, func(ctx context.Context) error {
+			// all rules are set again, without the rules in the up phase of flapping
+			return s.setActiveTcs(ctx, log, in, pid, ipsets)
+		})
+	}
+
+	return nil
+}
+
+// setActiveTcs sets the tcs which are active at now, and restarts the netem profiles, as
+// the qdiscs changed by the profiles of previous request are removed or have other handles
+func (s *DaemonServer) setActiveTcs(ctx context.Context, log logr.Logger, in *pb.TcsRequest, pid uint32, ipsets []*pb.IPSet) error {
+	s.stopNetemProfiles(in.ContainerId)
+
 	tcCli := buildTcClient(ctx, log, in.EnterNS, pid)
+	if err := s.tcBackend.setTcs(log, tcCli, in, activeTcs(in.Tcs, time.Now()), ipsets); err != nil {
+		return err
+	}
 
-	ifaces, err := getAllInterfaces(ctx, log, pid, in.EnterNS)
+	if len(*tcCli.profiles) > 0 {
+		s.startNetemProfiles(log, in.ContainerId, buildTcClient(context.Background(), log, in.EnterNS, pid), *tcCli.profiles)
+	}
+	return nil
+}
+
+func tcFlappingKey(containerID string) string {
+	return "tc/" + containerID
+}
+
+// activeTcs returns the tc rules without flapping or in the down phase of flapping
+func activeTcs(tcs []*pb.Tc, now time.Time) []*pb.Tc {
+	active := []*pb.Tc{}
+	for _, tc := range tcs {
+		if isFlapDown(tc.Flap, now) {
+			active = append(active, tc)
+		}
+	}
+	return active
+}
+
+// setTcs flushes the existing tc rules on all devices and sets the tcs with the netem backend
+func (s *DaemonServer) setTcs(log logr.Logger, tcCli tcClient, in *pb.TcsRequest, tcs []*pb.Tc) error {
+	ifaces, err := getAllInterfaces(tcCli.ctx, log, tcCli.pid, in.EnterNS)
 	if err != nil {
 		log.Error(err, "error while getting interfaces")
-		return nil, err
+		return err
+	}
+
+	// the IFB devices are created by previous requests to shape the ingress traffic,
+	// they should be removed together with the ingress qdisc redirecting packets to them.
+	existing := make(map[string]bool)
+	for _, iface := range ifaces {
+		existing[iface] = true
+	}
+	removed := make(map[string]bool)
+	for _, iface := range ifaces {
+		ifb := ifbDeviceName(iface)
+		if !existing[ifb] || removed[iface] {
+			continue
+		}
+		err = tcCli.removeIFB(iface, ifb)
+		if err != nil {
+			log.Error(err, "fail to remove ifb device", "device", iface, "ifb", ifb)
+		}
+		removed[ifb] = true
 	}
+	if err != nil {
+		return err
+	}
+
 	for _, iface := range ifaces {
+		if removed[iface] {
+			continue
+		}
 		err = tcCli.flush(iface)
 		if err != nil {
 			log.Error(err, "fail to flush tc rules on device", "device", iface)
 		}
 	}
 	if err != nil {
-		return &empty.Empty{}, err
+		return err
 	}
 
-	for device, rules := range s.groupRulesAccordingToDevices(in.Tcs) {
-		// tc rules are split into two different kinds according to whether it has filter.
-		// all tc rules without filter are called `globalTc` and the tc rules with filter will be called `filterTc`.
-		// the `globalTc` rules will be piped one by one from root, and the last `globalTc` will be connected with a PRIO
-		// qdisc, which has `3 + len(filterTc)` bands. Then the 4.. bands will be connected to `filterTc` and a filter will
-		// be setuped to flow packet from PRIO qdisc to it.
-
-		// for example, four tc rules:
-		// - NETEM: 50ms latency without filter
-		// - NETEM: 100ms latency without filter
-		// - NETEM: 50ms latency with filter ipset A
-		// - NETEM: 100ms latency with filter ipset B
-		// will generate tc rules:
-		//	tc qdisc del dev eth0 root
-		//  tc qdisc add dev eth0 root handle 1: netem delay 50000
-		//  tc qdisc add dev eth0 parent 1: handle 2: netem delay 100000
-		//  tc qdisc add dev eth0 parent 2: handle 3: prio bands 5 priomap 1 2 2 2 1 2 0 0 1 1 1 1 1 1 1 1
-		//  tc qdisc add dev eth0 parent 3:1 handle 4: sfq
-		//  tc qdisc add dev eth0 parent 3:2 handle 5: sfq
-		//  tc qdisc add dev eth0 parent 3:3 handle 6: sfq
-		//  tc qdisc add dev eth0 parent 3:4 handle 7: netem delay 50000
-		//  iptables -A TC-TABLES-0 -o eth0 -m set --match-set A dst -j CLASSIFY --set-class 3:4 -w 5
-		//  tc qdisc add dev eth0 parent 3:5 handle 8: netem delay 100000
-		//  iptables -A TC-TABLES-1 -o eth0 -m set --match-set B dst -j CLASSIFY --set-class 3:5 -w 5
-
-		globalTc := []*pb.Tc{}
-		filterTc := make(map[string][]*pb.Tc)
-
+	for device, rules := range s.groupRulesAccordingToDevices(tcs) {
+		egressRules := []*pb.Tc{}
+		ingressRules := []*pb.Tc{}
 		for _, tc := range rules {
-			filter := abstractTcFilter(tc)
-			if len(filter) > 0 {
-				filterTc[filter] = append(filterTc[filter], tc)
+			if tc.Ingress {
+				ingressRules = append(ingressRules, tc)
 				continue
 			}
-			globalTc = append(globalTc, tc)
+			egressRules = append(egressRules, tc)
 		}
 
-		if len(globalTc) > 0 {
-			if err := s.setGlobalTcs(log, tcCli, globalTc, device); err != nil {
-				log.Error(err, "error while setting global tc")
-				return &empty.Empty{}, err
+		if len(egressRules) > 0 {
+			iptablesClis := buildIptablesClients(tcCli.ctx, in.EnterNS, tcCli.pid, in.EnableIpv6)
+			if err := s.setDeviceTcs(log, tcCli, iptablesClis, egressRules, device, false); err != nil {
+				return err
 			}
 		}
 
-		if len(filterTc) > 0 {
-			iptablesCli := buildIptablesClient(ctx, in.EnterNS, pid)
-			if err := s.setFilterTcs(log, tcCli, iptablesCli, filterTc, device, len(globalTc)); err != nil {
-				log.Error(err, "error while setting filter tc")
-				return &empty.Empty{}, err
+		if len(ingressRules) > 0 {
+			// the ingress traffic is redirected to an IFB device, so it could be shaped
+			// on the egress of the IFB device in the same way as other devices.
+			ifb, err := tcCli.addIFB(device)
+			if err != nil {
+				log.Error(err, "error while adding ifb device", "device", device)
+				return err
+			}
+			if err := s.setDeviceTcs(log, tcCli, nil, ingressRules, ifb, true); err != nil {
+				return err
 			}
 		}
 	}
 
-	return &empty.Empty{}, nil
+	return nil
+}
+
+func (s *DaemonServer) setDeviceTcs(
+	log logr.Logger,
+	tcCli tcClient,
+	iptablesClis []iptablesClient,
+	rules []*pb.Tc,
+	device string,
+	ingress bool,
+) error {
+	// tc rules are split into two different kinds according to whether it has filter.
+	// all tc rules without filter are called `globalTc` and the tc rules with filter will be called `filterTc`.
+	// the `globalTc` rules will be piped one by one from root, and the last `globalTc` will be connected with a PRIO
+	// qdisc, which has `3 + len(filterTc)` bands. Then the 4.. bands will be connected to `filterTc` and a filter will
+	// be setuped to flow packet from PRIO qdisc to it.
+
+	// for example, four tc rules:
+	// - NETEM: 50ms latency without filter
+	// - NETEM: 100ms latency without filter
+	// - NETEM: 50ms latency with filter ipset A
+	// - NETEM: 100ms latency with filter ipset B
+	// will generate tc rules:
+	//	tc qdisc del dev eth0 root
+	//  tc qdisc add dev eth0 root handle 1: netem delay 50000
+	//  tc qdisc add dev eth0 parent 1: handle 2: netem delay 100000
+	//  tc qdisc add dev eth0 parent 2: handle 3: prio bands 5 priomap 1 2 2 2 1 2 0 0 1 1 1 1 1 1 1 1
+	//  tc qdisc add dev eth0 parent 3:1 handle 4: sfq
+	//  tc qdisc add dev eth0 parent 3:2 handle 5: sfq
+	//  tc qdisc add dev eth0 parent 3:3 handle 6: sfq
+	//  tc qdisc add dev eth0 parent 3:4 handle 7: netem delay 50000
+	//  iptables -A TC-TABLES-0 -o eth0 -m set --match-set A dst -j CLASSIFY --set-class 3:4 -w 5
+	//  tc qdisc add dev eth0 parent 3:5 handle 8: netem delay 100000
+	//  iptables -A TC-TABLES-1 -o eth0 -m set --match-set B dst -j CLASSIFY --set-class 3:5 -w 5
+
+	// the ingress traffic redirected to IFB device won't go through the iptables, so
+	// the ipset is matched with a tc filter on the source address instead:
+	//  tc filter add dev ifbeth0 parent 3: protocol all basic match ipset(A src,src) classid 3:4
+
+	// the filters keep the order of request, so that the classification of later rules
+	// overrides the former ones when the packets are matched by more than one filter
+	globalTc := []*pb.Tc{}
+	filterTc := make(map[string][]*pb.Tc)
+	filters := []string{}
+
+	for _, tc := range rules {
+		filter := abstractTcFilter(tc)
+		if len(filter) > 0 {
+			if _, ok := filterTc[filter]; !ok {
+				filters = append(filters, filter)
+			}
+			filterTc[filter] = append(filterTc[filter], tc)
+			continue
+		}
+		globalTc = append(globalTc, tc)
+	}
+
+	if len(globalTc) > 0 {
+		if err := s.setGlobalTcs(log, tcCli, globalTc, device); err != nil {
+			log.Error(err, "error while setting global tc")
+			return err
+		}
+	}
+
+	if len(filterTc) > 0 {
+		if err := s.setFilterTcs(log, tcCli, iptablesClis, filters, filterTc, device, len(globalTc), ingress); err != nil {
+			log.Error(err, "error while setting filter tc")
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *DaemonServer) groupRulesAccordingToDevices(tcs []*pb.Tc) map[string][]*pb.Tc {
@@ -224,13 +382,18 @@ func (s *DaemonServer) setGlobalTcs(log logr.Logger, cli tcClient, tcs []*pb.Tc,
 func (s *DaemonServer) setFilterTcs(
 	log logr.Logger,
 	tcCli tcClient,
-	iptablesCli iptablesClient,
+	iptablesClis []iptablesClient,
+	filters []string,
 	filterTc map[string][]*pb.Tc,
 	device string,
 	baseIndex int,
+	ingress bool,
 ) error {
 	parent := baseIndex
 	band := 3 + len(filterTc) // 3 handlers for normal sfq on prio qdisc
+	if band > maxPrioBands {
+		return errors.Errorf("too many filtered traffic controls on device %s: %d, at most %d are supported", device, len(filterTc), maxPrioBands-3)
+	}
 	if err := tcCli.addPrio(device, parent, band); err != nil {
 		log.Error(err, "error while adding prio")
 		return err
@@ -244,7 +407,8 @@ func (s *DaemonServer) setFilterTcs(
 	// and iptables rules are recovered by previous call too, so there is no need
 	// to remove these rules here
 	chains := []*pb.Chain{}
-	for _, tcs := range filterTc {
+	for _, filter := range filters {
+		tcs := filterTc[filter]
 		for i, tc := range tcs {
 			parentArg := fmt.Sprintf("parent %d:%d", parent, index+4)
 			if i > 0 {
@@ -261,6 +425,20 @@ func (s *DaemonServer) setFilterTcs(
 			}
 		}
 
+		if ingress {
+			tc := tcs[0]
+			if len(tc.Protocol) > 0 || len(tc.SourcePort) > 0 || len(tc.EgressPort) > 0 {
+				return errors.New("protocol and ports filter is not supported on ingress")
+			}
+			if err := tcCli.addIPSetFilter(device, parent, index+4, tc.Ipset); err != nil {
+				log.Error(err, "error while adding ipset filter")
+				return err
+			}
+
+			index++
+			continue
+		}
+
 		ch := &pb.Chain{
 			Name:      fmt.Sprintf("TC-TABLES-%d", index),
 			Direction: pb.Chain_OUTPUT,
@@ -281,9 +459,11 @@ func (s *DaemonServer) setFilterTcs(
 
 		index++
 	}
-	if err := iptablesCli.setIptablesChains(chains); err != nil {
-		log.Error(err, "error while setting iptables")
-		return err
+	for _, iptablesCli := range iptablesClis {
+		if err := iptablesCli.setIptablesChains(chains); err != nil {
+			log.Error(err, "error while setting iptables", "command", iptablesCli.command)
+			return err
+		}
 	}
 
 	return nil
@@ -294,6 +474,9 @@ type tcClient struct {
 	log     logr.Logger
 	enterNS bool
 	pid     uint32
+
+	// profiles collects the netem qdiscs which should be updated over time
+	profiles *[]netemProfileTask
 }
 
 func buildTcClient(ctx context.Context, log logr.Logger, enterNS bool, pid uint32) tcClient {
@@ -302,6 +485,7 @@ func buildTcClient(ctx context.Context, log logr.Logger, enterNS bool, pid uint3
 		log,
 		enterNS,
 		pid,
+		&[]netemProfileTask{},
 	}
 }
 
@@ -338,10 +522,23 @@ func (c *tcClient) addTc(device string, parentArg string, handleArg string, tc *
 		if tc.Netem == nil {
 			return errors.New("netem is nil while type is NETEM")
 		}
-		err := c.addNetem(device, parentArg, handleArg, tc.Netem)
+		netem := tc.Netem
+		if tc.Profile != nil {
+			_, point, _ := netemutils.EvaluateProfile(tc.Profile, time.Since(time.Unix(0, tc.Profile.StartTime)))
+			netem = netemutils.ApplyProfileStep(tc.Netem, tc.Profile, point)
+		}
+		err := c.addNetem(device, parentArg, handleArg, netem)
 		if err != nil {
 			return err
 		}
+		if tc.Profile != nil {
+			*c.profiles = append(*c.profiles, netemProfileTask{
+				device: device,
+				parent: parentArg,
+				handle: handleArg,
+				tc:     tc,
+			})
+		}
 
 	} else {
 		return errors.New("unknown tc qdisc type")
@@ -386,6 +583,77 @@ func (c *tcClient) addPrio(device string, parent int, band int) error {
 	return nil
 }
 
+// ifbDeviceName returns the name of IFB device for the ingress traffic of device
+func ifbDeviceName(device string) string {
+	name := "ifb" + device
+	if len(name) > maxDeviceNameLength {
+		name = name[:maxDeviceNameLength]
+	}
+	return name
+}
+
+// addIFB creates an IFB device and redirects the ingress traffic of device to it
+func (c *tcClient) addIFB(device string) (string, error) {
+	ifb := ifbDeviceName(device)
+	c.log.Info("adding ifb", "device", device, "ifb", ifb)
+
+	commands := [][]string{
+		{"ip", "link", "add", ifb, "type", "ifb"},
+		{"ip", "link", "set", "dev", ifb, "up"},
+		{"tc", "qdisc", "add", "dev", device, "handle", "ffff:", "ingress"},
+		{"tc", "filter", "add", "dev", device, "parent", "ffff:", "protocol", "all", "u32", "match", "u32", "0", "0",
+			"action", "mirred", "egress", "redirect", "dev", ifb},
+	}
+	for _, command := range commands {
+		if err := c.run(command[0], command[1:]...); err != nil {
+			return "", err
+		}
+	}
+
+	return ifb, nil
+}
+
+// removeIFB removes the ingress qdisc on device and the IFB device
+func (c *tcClient) removeIFB(device string, ifb string) error {
+	c.log.Info("removing ifb", "device", device, "ifb", ifb)
+
+	processBuilder := bpm.DefaultProcessBuilder("tc", "qdisc", "del", "dev", device, "ingress").SetContext(c.ctx)
+	if c.enterNS {
+		processBuilder = processBuilder.SetNS(c.pid, bpm.NetNS)
+	}
+	cmd := processBuilder.Build(c.ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if (!strings.Contains(string(output), ruleNotExistLowerVersion)) && (!strings.Contains(string(output), ruleNotExist)) {
+			return util.EncodeOutputToError(output, err)
+		}
+	}
+
+	return c.run("ip", "link", "del", ifb)
+}
+
+// addIPSetFilter adds a filter to flow the packets from the source in ipset to the band of prio qdisc
+func (c *tcClient) addIPSetFilter(device string, parent int, band int, ipset string) error {
+	c.log.Info("adding ipset filter", "device", device, "parent", parent, "band", band, "ipset", ipset)
+
+	return c.run("tc", "filter", "add", "dev", device, "parent", fmt.Sprintf("%d:", parent),
+		"protocol", "all", "basic", "match", fmt.Sprintf("ipset(%s src,src)", ipset),
+		"classid", fmt.Sprintf("%d:%d", parent, band))
+}
+
+func (c *tcClient) run(name string, args ...string) error {
+	processBuilder := bpm.DefaultProcessBuilder(name, args...).SetContext(c.ctx)
+	if c.enterNS {
+		processBuilder = processBuilder.SetNS(c.pid, bpm.NetNS)
+	}
+	cmd := processBuilder.Build(c.ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return util.EncodeOutputToError(output, err)
+	}
+	return nil
+}
+
 func (c *tcClient) addNetem(device string, parent string, handle string, netem *pb.Netem) error {
 	c.log.Info("adding netem", "device", device, "parent", parent, "handle", handle)
 
@@ -507,7 +775,7 @@ func abstractTcFilter(tc *pb.Tc) string {
 	}
 
 	if len(tc.SourcePort) > 0 {
-		filter += "-" + tc.EgressPort
+		filter += "-" + tc.SourcePort
 	}
 
 	return filter
@@ -16,13 +16,264 @@
 package chaosdaemon
 
 import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tcbpf"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
 )
 
+var _ = Describe("tc server", func() {
+	defer mock.With("MockContainerdClient", &test.MockClient{})()
+	logger, err := log.NewDefaultZapLogger()
+	Expect(err).To(BeNil())
+	s, _ := newDaemonServer(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd}, nil, logger)
+
+	// mockTcCommands records the commands executed in the network namespace,
+	// and reports the interfaces in ifaces
+	var commandsLock sync.Mutex
+	mockTcCommands := func(commands *[]string, ifaces string) mock.Finalizer {
+		return mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+			if args[3] == "ip" && args[len(args)-1] == "show" {
+				return exec.Command("printf", "%s", ifaces)
+			}
+			commandsLock.Lock()
+			*commands = append(*commands, strings.Join(args[3:], " "))
+			commandsLock.Unlock()
+			return exec.Command("echo", "-n")
+		})
+	}
+
+	Context("SetTcs with flap", func() {
+		It("should restart the netem profiles with the flapping rules", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"}]`)()
+
+			changes := func() int {
+				commandsLock.Lock()
+				defer commandsLock.Unlock()
+
+				count := 0
+				for _, command := range commands {
+					if strings.HasPrefix(command, "tc qdisc change") {
+						count++
+					}
+				}
+				return count
+			}
+
+			phase := 500 * time.Millisecond
+			start := time.Now()
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs: []*pb.Tc{{
+					Type:  pb.Tc_NETEM,
+					Netem: &pb.Netem{Time: "10ms"},
+					Profile: &pb.NetemProfile{
+						Type: pb.NetemProfile_RAMP,
+						Steps: []*pb.NetemProfileStep{
+							{Offset: 0, Latency: int64(10 * time.Millisecond)},
+							{Offset: int64(time.Minute), Latency: int64(time.Minute)},
+						},
+						Interval:  int64(20 * time.Millisecond),
+						StartTime: start.UnixNano(),
+					},
+					Flap: &pb.Flap{
+						UpDuration:   int64(phase),
+						DownDuration: int64(phase),
+						StartTime:    start.UnixNano(),
+					},
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Eventually(changes).Should(BeNumerically(">", 0))
+
+			// the netem is removed in the up phase, and the profile shouldn't change it anymore
+			time.Sleep(time.Until(start.Add(phase + phase/5)))
+			count := changes()
+			Consistently(changes, phase/2).Should(Equal(count))
+
+			// the netem is added again in the next down phase, and the profile is restarted
+			time.Sleep(time.Until(start.Add(2 * phase)))
+			Eventually(changes, phase).Should(BeNumerically(">", count))
+
+			_, err = s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs:         []*pb.Tc{},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("SetTcs with ingress", func() {
+		It("should redirect the ingress traffic to an IFB device", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"}]`)()
+
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs: []*pb.Tc{{
+					Type:    pb.Tc_NETEM,
+					Netem:   &pb.Netem{Time: "100ms"},
+					Ipset:   "chaos-ingress",
+					Ingress: true,
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				"tc qdisc del dev lo root",
+				"tc qdisc del dev eth0 root",
+				"ip link add ifbeth0 type ifb",
+				"ip link set dev ifbeth0 up",
+				"tc qdisc add dev eth0 handle ffff: ingress",
+				"tc filter add dev eth0 parent ffff: protocol all u32 match u32 0 0 action mirred egress redirect dev ifbeth0",
+				"tc qdisc add dev ifbeth0 root handle 1: prio bands 4 priomap 1 2 2 2 1 2 0 0 1 1 1 1 1 1 1 1",
+				"tc qdisc add dev ifbeth0 parent 1:1 handle 2: sfq",
+				"tc qdisc add dev ifbeth0 parent 1:2 handle 3: sfq",
+				"tc qdisc add dev ifbeth0 parent 1:3 handle 4: sfq",
+				"tc qdisc add dev ifbeth0 parent 1:4 handle 5: netem delay 100ms",
+				"tc filter add dev ifbeth0 parent 1: protocol all basic match ipset(chaos-ingress src,src) classid 1:4",
+			}))
+		})
+
+		It("should reject too many filtered traffic controls", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"}]`)()
+
+			filteredTcs := func(n int) []*pb.Tc {
+				var tcs []*pb.Tc
+				for i := 0; i < n; i++ {
+					tcs = append(tcs, &pb.Tc{
+						Type:    pb.Tc_NETEM,
+						Netem:   &pb.Netem{Time: "100ms"},
+						Ipset:   fmt.Sprintf("chaos-ingress-%d", i),
+						Ingress: true,
+					})
+				}
+				return tcs
+			}
+
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs:         filteredTcs(maxPrioBands - 3),
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(ContainElement("tc filter add dev ifbeth0 parent 1: protocol all basic match ipset(chaos-ingress-12 src,src) classid 1:16"))
+
+			_, err = s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs:         filteredTcs(maxPrioBands - 2),
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(MatchError(ContainSubstring("too many filtered traffic controls on device ifbeth0")))
+		})
+
+		It("should remove the IFB device on recover", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"},{"ifname":"ifbeth0"}]`)()
+
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs:         []*pb.Tc{},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+			Expect(commands).To(Equal([]string{
+				"tc qdisc del dev eth0 ingress",
+				"ip link del ifbeth0",
+				"tc qdisc del dev lo root",
+				"tc qdisc del dev eth0 root",
+			}))
+		})
+	})
+
+	Context("SetTcs with bpf backend", func() {
+		It("should attach the pinned program with the rules", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"}]`)()
+
+			var loadedDir string
+			var loadedRules []tcbpf.Rule
+			defer mock.With("MockLoadBPF", func(dir string, rules []tcbpf.Rule) (string, error) {
+				loadedDir, loadedRules = dir, rules
+				return dir + "/prog", nil
+			})()
+
+			netemBackend := s.tcBackend
+			s.tcBackend = &bpfTcBackend{s}
+			defer func() { s.tcBackend = netemBackend }()
+
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs: []*pb.Tc{{
+					Type:  pb.Tc_NETEM,
+					Netem: &pb.Netem{Time: "100ms"},
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(BeNil())
+
+			dir := "/sys/fs/bpf/chaos-mesh/tc/containerd___container-id/eth0"
+			Expect(loadedDir).To(Equal(dir))
+			Expect(loadedRules).To(HaveLen(1))
+			Expect(loadedRules[0].Delay).To(Equal(100 * time.Millisecond))
+			Expect(commands).To(Equal([]string{
+				"tc qdisc del dev lo clsact",
+				"tc qdisc del dev lo root",
+				"tc qdisc del dev eth0 clsact",
+				"tc qdisc del dev eth0 root",
+				"tc qdisc add dev eth0 root handle 1: fq",
+				"tc qdisc add dev eth0 clsact",
+				"tc filter add dev eth0 egress bpf direct-action object-pinned " + dir + "/prog",
+			}))
+		})
+
+		It("should reject the ingress traffic controls", func() {
+			defer mock.With("pid", 9527)()
+			var commands []string
+			defer mockTcCommands(&commands, `[{"ifname":"lo"},{"ifname":"eth0"}]`)()
+
+			netemBackend := s.tcBackend
+			s.tcBackend = &bpfTcBackend{s}
+			defer func() { s.tcBackend = netemBackend }()
+
+			_, err := s.SetTcs(context.TODO(), &pb.TcsRequest{
+				Tcs: []*pb.Tc{{
+					Type:    pb.Tc_NETEM,
+					Netem:   &pb.Netem{Time: "100ms"},
+					Ingress: true,
+				}},
+				ContainerId: "containerd://container-id",
+				EnterNS:     true,
+			})
+			Expect(err).To(MatchError(ContainSubstring("not supported by bpf backend")))
+			Expect(commands).To(BeEmpty())
+		})
+	})
+})
+
 func Test_generateQdiscArgs(t *testing.T) {
 	g := NewWithT(t)
 
@@ -181,3 +432,34 @@ func Test_convertNetemToArgs(t *testing.T) {
 		g.Expect(args).To(Equal("delay 1000ms 10000ms rate 8000bit"))
 	})
 }
+
+func Test_abstractTcFilter(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Run("filter by ipset", func(t *testing.T) {
+		g.Expect(abstractTcFilter(&pb.Tc{Ipset: "test"})).To(Equal("test"))
+	})
+
+	t.Run("filter by protocol and ports", func(t *testing.T) {
+		filter := abstractTcFilter(&pb.Tc{
+			Ipset:      "test",
+			Protocol:   "tcp",
+			SourcePort: "8080",
+			EgressPort: "5432",
+		})
+		g.Expect(filter).To(Equal("test-tcp-5432-8080"))
+		g.Expect(filter).NotTo(Equal(abstractTcFilter(&pb.Tc{
+			Ipset:      "test",
+			Protocol:   "tcp",
+			SourcePort: "5432",
+			EgressPort: "8080",
+		})))
+	})
+}
+
+func Test_ifbDeviceName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ifbDeviceName("eth0")).To(Equal("ifbeth0"))
+	g.Expect(ifbDeviceName("a-very-long-name")).To(HaveLen(maxDeviceNameLength))
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tcbpf
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cilium/ebpf"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ProgramPin is the name of pinned program in the directory
+	ProgramPin = "prog"
+
+	maxRules = 1024
+	maxFlows = 65536
+)
+
+func collectionSpec() *ebpf.CollectionSpec {
+	return &ebpf.CollectionSpec{
+		Maps: map[string]*ebpf.MapSpec{
+			RulesMap: {
+				Name:       RulesMap,
+				Type:       ebpf.LPMTrie,
+				KeySize:    ruleKeySize,
+				ValueSize:  ruleValueSize,
+				MaxEntries: maxRules,
+				Flags:      unix.BPF_F_NO_PREALLOC,
+			},
+			FlowsMap: {
+				Name:       FlowsMap,
+				Type:       ebpf.LRUHash,
+				KeySize:    flowKeySize,
+				ValueSize:  flowValueSize,
+				MaxEntries: maxFlows,
+			},
+		},
+		Programs: map[string]*ebpf.ProgramSpec{
+			ProgramPin: {
+				Name:         "chaos_tc",
+				Type:         ebpf.SchedCLS,
+				Instructions: Program(),
+				License:      "Apache-2.0",
+			},
+		},
+	}
+}
+
+// Load creates the maps with rules, loads the program, and pins all of them into the directory
+// on bpffs. It returns the path of pinned program, which could be attached by tc.
+func Load(dir string, rules []Rule) (string, error) {
+	if len(rules) > maxRules {
+		return "", errors.Errorf("too many rules: %d, at most %d are supported", len(rules), maxRules)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", errors.Wrapf(err, "create directory %s", dir)
+	}
+
+	coll, err := ebpf.NewCollection(collectionSpec())
+	if err != nil {
+		return "", errors.Wrap(err, "load program")
+	}
+	// the pinned objects are still alive after the file descriptors are closed
+	defer coll.Close()
+
+	for _, rule := range rules {
+		if err := coll.Maps[RulesMap].Update(rule.MarshalKey(), rule.MarshalValue(), ebpf.UpdateAny); err != nil {
+			return "", errors.Wrapf(err, "update rule of %s", rule.Destination)
+		}
+	}
+
+	for name, m := range coll.Maps {
+		if err := m.Pin(filepath.Join(dir, name)); err != nil {
+			return "", errors.Wrapf(err, "pin %s", name)
+		}
+	}
+	progPath := filepath.Join(dir, ProgramPin)
+	if err := coll.Programs[ProgramPin].Pin(progPath); err != nil {
+		return "", errors.Wrapf(err, "pin %s", ProgramPin)
+	}
+
+	return progPath, nil
+}
+
+// Unpin removes the pinned program and maps in the directory, they are released
+// after the program is detached
+func Unpin(dir string) error {
+	return os.RemoveAll(dir)
+}
+
+// Mount mounts a bpffs on the path if it isn't a bpffs, because the /sys of container
+// is a sysfs without the bpffs of host
+func Mount(path string) error {
+	if err := os.MkdirAll(path, 0700); err != nil {
+		return errors.Wrapf(err, "create directory %s", path)
+	}
+	var fs unix.Statfs_t
+	if err := unix.Statfs(path, &fs); err != nil {
+		return errors.Wrapf(err, "statfs %s", path)
+	}
+	if fs.Type == unix.BPF_FS_MAGIC {
+		return nil
+	}
+	return errors.Wrapf(unix.Mount("bpf", path, "bpf", 0, ""), "mount bpffs on %s", path)
+}
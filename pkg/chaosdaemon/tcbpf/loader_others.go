@@ -0,0 +1,37 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+//go:build !linux
+
+package tcbpf
+
+import "github.com/pkg/errors"
+
+// ProgramPin is the name of pinned program in the directory
+const ProgramPin = "prog"
+
+// Load is only supported on linux
+func Load(dir string, rules []Rule) (string, error) {
+	return "", errors.New("bpf is only supported on linux")
+}
+
+// Unpin is only supported on linux
+func Unpin(dir string) error {
+	return errors.New("bpf is only supported on linux")
+}
+
+// Mount is only supported on linux
+func Mount(path string) error {
+	return errors.New("bpf is only supported on linux")
+}
@@ -0,0 +1,202 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tcbpf
+
+import "github.com/cilium/ebpf/asm"
+
+const (
+	// RulesMap is the name of LPM trie map from the destination to the rule
+	RulesMap = "rules"
+	// FlowsMap is the name of LRU hash map from the 5-tuple of flow to its next departure time
+	FlowsMap = "flows"
+
+	// flowKeySize is the size of key in the flows map, which is the addresses, ports and protocol
+	flowKeySize = 16
+	// flowValueSize is the size of value in the flows map
+	flowValueSize = 8
+)
+
+// the offsets of fields in struct __sk_buff
+const (
+	skbLenOffset    = 0
+	skbTstampOffset = 152
+)
+
+const (
+	tcActOK   = 0
+	tcActShot = 2
+
+	ethHeaderLength = 14
+	ipHeaderLength  = 20
+)
+
+// the layout of stack, the offsets are relative to the frame pointer
+const (
+	stackIPHeader = -96
+	stackFlowNext = -56
+	stackFlowKey  = -40
+	stackPorts    = -24
+	stackRuleKey  = -16
+)
+
+// Program returns the instructions of the tc classifier in direct action mode. For every IPv4
+// packet, it looks up the rule with the longest prefix matched with the destination, and checks
+// the protocol and ports of rule. The matched packet is dropped with the probability of loss,
+// or its earliest departure time is set to now plus the delay and a random jitter. If the rate
+// is limited, the departure time is postponed until the previous packets of the same 5-tuple
+// have been sent at the rate. The departure time takes effect with the fq qdisc on the device.
+func Program() asm.Instructions {
+	return asm.Instructions{
+		// R6 = skb
+		asm.Mov.Reg(asm.R6, asm.R1),
+
+		// load the IPv4 header into stack
+		asm.Mov.Reg(asm.R1, asm.R6),
+		asm.Mov.Imm(asm.R2, ethHeaderLength),
+		asm.Mov.Reg(asm.R3, asm.R10),
+		asm.Add.Imm(asm.R3, stackIPHeader),
+		asm.Mov.Imm(asm.R4, ipHeaderLength),
+		asm.FnSkbLoadBytes.Call(),
+		asm.JNE.Imm(asm.R0, 0, "pass"),
+		asm.LoadMem(asm.R1, asm.R10, stackIPHeader, asm.Byte),
+		asm.Mov.Reg(asm.R2, asm.R1),
+		asm.RSh.Imm(asm.R2, 4),
+		asm.JNE.Imm(asm.R2, 4, "pass"),
+		// R7 = the length of IPv4 header
+		asm.And.Imm(asm.R1, 0xf),
+		asm.LSh.Imm(asm.R1, 2),
+		asm.Mov.Reg(asm.R7, asm.R1),
+		// R8 = protocol
+		asm.LoadMem(asm.R8, asm.R10, stackIPHeader+9, asm.Byte),
+
+		// the key of rules map is the destination with full prefix length
+		asm.StoreImm(asm.R10, stackRuleKey, 32, asm.Word),
+		asm.LoadMem(asm.R1, asm.R10, stackIPHeader+16, asm.Word),
+		asm.StoreMem(asm.R10, stackRuleKey+4, asm.R1, asm.Word),
+
+		// load the ports of tcp and udp, or leave them zero
+		asm.StoreImm(asm.R10, stackPorts, 0, asm.Word),
+		asm.JEq.Imm(asm.R8, 6, "load_ports"),
+		asm.JNE.Imm(asm.R8, 17, "lookup_rule"),
+		asm.Mov.Reg(asm.R1, asm.R6).WithSymbol("load_ports"),
+		asm.Mov.Reg(asm.R2, asm.R7),
+		asm.Add.Imm(asm.R2, ethHeaderLength),
+		asm.Mov.Reg(asm.R3, asm.R10),
+		asm.Add.Imm(asm.R3, stackPorts),
+		asm.Mov.Imm(asm.R4, 4),
+		asm.FnSkbLoadBytes.Call(),
+		asm.JNE.Imm(asm.R0, 0, "pass"),
+
+		// R9 = rule
+		asm.LoadMapPtr(asm.R1, 0).WithReference(RulesMap).WithSymbol("lookup_rule"),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, stackRuleKey),
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, "pass"),
+		asm.Mov.Reg(asm.R9, asm.R0),
+
+		// check the protocol and ports
+		asm.LoadMem(asm.R1, asm.R9, ruleProtocolOffset, asm.Byte),
+		asm.JEq.Imm(asm.R1, 0, "check_ports"),
+		asm.JNE.Reg(asm.R1, asm.R8, "pass"),
+		asm.LoadMem(asm.R1, asm.R10, stackPorts, asm.Half).WithSymbol("check_ports"),
+		asm.HostTo(asm.BE, asm.R1, asm.Half),
+		asm.LoadMem(asm.R2, asm.R9, ruleSportsOffset, asm.Half),
+		asm.JGT.Reg(asm.R2, asm.R1, "pass"),
+		asm.LoadMem(asm.R2, asm.R9, ruleSportsOffset+2, asm.Half),
+		asm.JGT.Reg(asm.R1, asm.R2, "pass"),
+		asm.LoadMem(asm.R1, asm.R10, stackPorts+2, asm.Half),
+		asm.HostTo(asm.BE, asm.R1, asm.Half),
+		asm.LoadMem(asm.R2, asm.R9, ruleDportsOffset, asm.Half),
+		asm.JGT.Reg(asm.R2, asm.R1, "pass"),
+		asm.LoadMem(asm.R2, asm.R9, ruleDportsOffset+2, asm.Half),
+		asm.JGT.Reg(asm.R1, asm.R2, "pass"),
+
+		// drop the packet if the random number is less than the threshold of loss
+		asm.LoadMem(asm.R1, asm.R9, ruleLossOffset, asm.Word),
+		asm.JEq.Imm(asm.R1, 0, "delay"),
+		asm.FnGetPrandomU32.Call(),
+		asm.LoadMem(asm.R1, asm.R9, ruleLossOffset, asm.Word),
+		asm.JGT.Reg(asm.R1, asm.R0, "drop"),
+
+		// R7 = now + delay + random(-jitter, jitter)
+		asm.FnKtimeGetNs.Call().WithSymbol("delay"),
+		asm.Mov.Reg(asm.R7, asm.R0),
+		asm.LoadMem(asm.R1, asm.R9, ruleDelayOffset, asm.DWord),
+		asm.Add.Reg(asm.R7, asm.R1),
+		asm.LoadMem(asm.R1, asm.R9, ruleJitterOffset, asm.DWord),
+		asm.JEq.Imm(asm.R1, 0, "limit_rate"),
+		asm.FnGetPrandomU32.Call(),
+		asm.LoadMem(asm.R1, asm.R9, ruleJitterOffset, asm.DWord),
+		asm.Mov.Reg(asm.R2, asm.R1),
+		asm.LSh.Imm(asm.R2, 1),
+		asm.Add.Imm(asm.R2, 1),
+		asm.Mod.Reg(asm.R0, asm.R2),
+		asm.Add.Reg(asm.R7, asm.R0),
+		asm.Sub.Reg(asm.R7, asm.R1),
+
+		// the key of flows map is saddr, daddr, sport, dport and protocol
+		asm.LoadMem(asm.R1, asm.R9, ruleRateOffset, asm.DWord).WithSymbol("limit_rate"),
+		asm.JEq.Imm(asm.R1, 0, "set_tstamp"),
+		asm.LoadMem(asm.R1, asm.R10, stackIPHeader+12, asm.Word),
+		asm.StoreMem(asm.R10, stackFlowKey, asm.R1, asm.Word),
+		asm.LoadMem(asm.R1, asm.R10, stackIPHeader+16, asm.Word),
+		asm.StoreMem(asm.R10, stackFlowKey+4, asm.R1, asm.Word),
+		asm.LoadMem(asm.R1, asm.R10, stackPorts, asm.Word),
+		asm.StoreMem(asm.R10, stackFlowKey+8, asm.R1, asm.Word),
+		asm.StoreImm(asm.R10, stackFlowKey+12, 0, asm.Word),
+		asm.StoreMem(asm.R10, stackFlowKey+12, asm.R8, asm.Byte),
+		asm.LoadMapPtr(asm.R1, 0).WithReference(FlowsMap),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, stackFlowKey),
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, "new_flow"),
+
+		// the packet departs after the previous packets of this flow, and the next packet
+		// departs after the transmission time of this packet at the rate
+		asm.LoadMem(asm.R1, asm.R0, 0, asm.DWord),
+		asm.JGE.Reg(asm.R7, asm.R1, "update_flow"),
+		asm.Mov.Reg(asm.R7, asm.R1),
+		asm.LoadMem(asm.R1, asm.R6, skbLenOffset, asm.Word).WithSymbol("update_flow"),
+		asm.Mul.Imm(asm.R1, 1000000000),
+		asm.LoadMem(asm.R2, asm.R9, ruleRateOffset, asm.DWord),
+		asm.Div.Reg(asm.R1, asm.R2),
+		asm.Add.Reg(asm.R1, asm.R7),
+		asm.StoreMem(asm.R0, 0, asm.R1, asm.DWord),
+		asm.Ja.Label("set_tstamp"),
+
+		asm.LoadMem(asm.R1, asm.R6, skbLenOffset, asm.Word).WithSymbol("new_flow"),
+		asm.Mul.Imm(asm.R1, 1000000000),
+		asm.LoadMem(asm.R2, asm.R9, ruleRateOffset, asm.DWord),
+		asm.Div.Reg(asm.R1, asm.R2),
+		asm.Add.Reg(asm.R1, asm.R7),
+		asm.StoreMem(asm.R10, stackFlowNext, asm.R1, asm.DWord),
+		asm.LoadMapPtr(asm.R1, 0).WithReference(FlowsMap),
+		asm.Mov.Reg(asm.R2, asm.R10),
+		asm.Add.Imm(asm.R2, stackFlowKey),
+		asm.Mov.Reg(asm.R3, asm.R10),
+		asm.Add.Imm(asm.R3, stackFlowNext),
+		asm.Mov.Imm(asm.R4, 0),
+		asm.FnMapUpdateElem.Call(),
+
+		asm.StoreMem(asm.R6, skbTstampOffset, asm.R7, asm.DWord).WithSymbol("set_tstamp"),
+		asm.Mov.Imm(asm.R0, tcActOK).WithSymbol("pass"),
+		asm.Return(),
+
+		asm.Mov.Imm(asm.R0, tcActShot).WithSymbol("drop"),
+		asm.Return(),
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tcbpf
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cilium/ebpf/asm"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	regionStack = iota + 1
+	regionContext
+	regionValue
+
+	stackSize = 512
+	rulesFd   = 10
+	flowsFd   = 11
+)
+
+var maps = map[string]uint64{RulesMap: rulesFd, FlowsMap: flowsFd}
+
+// vm is a minimal interpreter of the instructions used by the program, the pointers are
+// encoded as the region in the high 32 bits and the offset in the low 32 bits
+type vm struct {
+	packet []byte
+	now    uint64
+	random uint32
+
+	rules []Rule
+	flows map[string]int
+
+	stack  []byte
+	skb    []byte
+	values [][]byte
+}
+
+func newVM(rules []Rule) *vm {
+	return &vm{rules: rules, flows: make(map[string]int), now: uint64(time.Hour)}
+}
+
+func pointer(region int, offset int) uint64 {
+	return uint64(region)<<32 | uint64(uint32(offset))
+}
+
+func (m *vm) memory(ptr uint64, size int) []byte {
+	region, offset := int(ptr>>32), int(int32(uint32(ptr)))
+	var mem []byte
+	switch {
+	case region == regionStack:
+		mem = m.stack
+	case region == regionContext:
+		mem = m.skb
+	case region >= regionValue:
+		mem = m.values[region-regionValue]
+	}
+	if offset < 0 || offset+size > len(mem) {
+		panic("out of bounds memory access")
+	}
+	return mem[offset : offset+size]
+}
+
+func (m *vm) load(ptr uint64, size asm.Size) uint64 {
+	switch size {
+	case asm.Byte:
+		return uint64(m.memory(ptr, 1)[0])
+	case asm.Half:
+		return uint64(binary.NativeEndian.Uint16(m.memory(ptr, 2)))
+	case asm.Word:
+		return uint64(binary.NativeEndian.Uint32(m.memory(ptr, 4)))
+	default:
+		return binary.NativeEndian.Uint64(m.memory(ptr, 8))
+	}
+}
+
+func (m *vm) store(ptr uint64, size asm.Size, value uint64) {
+	switch size {
+	case asm.Byte:
+		m.memory(ptr, 1)[0] = uint8(value)
+	case asm.Half:
+		binary.NativeEndian.PutUint16(m.memory(ptr, 2), uint16(value))
+	case asm.Word:
+		binary.NativeEndian.PutUint32(m.memory(ptr, 4), uint32(value))
+	default:
+		binary.NativeEndian.PutUint64(m.memory(ptr, 8), value)
+	}
+}
+
+func (m *vm) newValue(value []byte) uint64 {
+	m.values = append(m.values, value)
+	return pointer(regionValue+len(m.values)-1, 0)
+}
+
+func (m *vm) call(helper asm.BuiltinFunc, r []uint64) uint64 {
+	switch helper {
+	case asm.FnSkbLoadBytes:
+		offset, length := int(r[2]), int(r[4])
+		if offset+length > len(m.packet) {
+			return ^uint64(0)
+		}
+		copy(m.memory(r[3], length), m.packet[offset:offset+length])
+		return 0
+	case asm.FnMapLookupElem:
+		switch r[1] {
+		case rulesFd:
+			ip := net.IP(m.memory(r[2], ruleKeySize)[4:])
+			best := -1
+			for i, rule := range m.rules {
+				ones, _ := rule.Destination.Mask.Size()
+				if rule.Destination.Contains(ip) && (best < 0 || ones > bestOnes(m.rules[best])) {
+					best = i
+				}
+			}
+			if best < 0 {
+				return 0
+			}
+			return m.newValue(m.rules[best].MarshalValue())
+		case flowsFd:
+			index, ok := m.flows[string(m.memory(r[2], flowKeySize))]
+			if !ok {
+				return 0
+			}
+			return pointer(regionValue+index, 0)
+		}
+	case asm.FnMapUpdateElem:
+		m.values = append(m.values, append([]byte{}, m.memory(r[3], flowValueSize)...))
+		m.flows[string(m.memory(r[2], flowKeySize))] = len(m.values) - 1
+		return 0
+	case asm.FnKtimeGetNs:
+		return m.now
+	case asm.FnGetPrandomU32:
+		return uint64(m.random)
+	}
+	panic("unknown helper")
+}
+
+func bestOnes(rule Rule) int {
+	ones, _ := rule.Destination.Mask.Size()
+	return ones
+}
+
+// run runs the program with the packet, and returns the verdict and departure time of packet
+func (m *vm) run(prog asm.Instructions, packet []byte) (uint64, uint64) {
+	m.packet = packet
+	m.stack = make([]byte, stackSize)
+	m.skb = make([]byte, 256)
+	binary.NativeEndian.PutUint32(m.skb[skbLenOffset:], uint32(len(packet)))
+
+	symbols := make(map[string]int)
+	for i, ins := range prog {
+		if ins.Symbol() != "" {
+			symbols[ins.Symbol()] = i
+		}
+	}
+
+	r := make([]uint64, 11)
+	r[asm.R1] = pointer(regionContext, 0)
+	r[asm.R10] = pointer(regionStack, stackSize)
+
+	for pc := 0; ; pc++ {
+		ins := prog[pc]
+		dst, src := ins.Dst, ins.Src
+		offset := uint64(int64(ins.Offset))
+		imm := uint64(ins.Constant)
+
+		operand := imm
+		if ins.OpCode.Source() == asm.RegSource {
+			operand = r[src]
+		}
+
+		switch ins.OpCode.Class() {
+		case asm.LdClass:
+			r[dst] = maps[ins.Reference()]
+		case asm.LdXClass:
+			r[dst] = m.load(r[src]+offset, ins.OpCode.Size())
+		case asm.StClass:
+			m.store(r[dst]+offset, ins.OpCode.Size(), imm)
+		case asm.StXClass:
+			m.store(r[dst]+offset, ins.OpCode.Size(), r[src])
+		case asm.ALUClass:
+			// the only 32-bit operation is the conversion to big endian
+			var value [2]byte
+			binary.BigEndian.PutUint16(value[:], uint16(r[dst]))
+			r[dst] = uint64(binary.NativeEndian.Uint16(value[:]))
+		case asm.ALU64Class:
+			switch ins.OpCode.ALUOp() {
+			case asm.Add:
+				r[dst] += operand
+			case asm.Sub:
+				r[dst] -= operand
+			case asm.Mul:
+				r[dst] *= operand
+			case asm.Div:
+				r[dst] /= operand
+			case asm.Mod:
+				r[dst] %= operand
+			case asm.And:
+				r[dst] &= operand
+			case asm.LSh:
+				r[dst] <<= operand
+			case asm.RSh:
+				r[dst] >>= operand
+			case asm.Mov:
+				r[dst] = operand
+			}
+		case asm.JumpClass:
+			jump := false
+			switch ins.OpCode.JumpOp() {
+			case asm.Ja:
+				jump = true
+			case asm.JEq:
+				jump = r[dst] == operand
+			case asm.JNE:
+				jump = r[dst] != operand
+			case asm.JGT:
+				jump = r[dst] > operand
+			case asm.JGE:
+				jump = r[dst] >= operand
+			case asm.Call:
+				r[asm.R0] = m.call(asm.BuiltinFunc(ins.Constant), r)
+			case asm.Exit:
+				return r[asm.R0], binary.NativeEndian.Uint64(m.skb[skbTstampOffset:])
+			}
+			if jump {
+				pc = symbols[ins.Reference()] - 1
+			}
+		}
+	}
+}
+
+func ipv4Packet(dst string, protocol uint8, sport, dport uint16, length int) []byte {
+	packet := make([]byte, length)
+	binary.BigEndian.PutUint16(packet[12:], 0x0800)
+	packet[ethHeaderLength] = 0x45
+	packet[ethHeaderLength+9] = protocol
+	copy(packet[ethHeaderLength+12:], net.ParseIP("10.1.1.1").To4())
+	copy(packet[ethHeaderLength+16:], net.ParseIP(dst).To4())
+	binary.BigEndian.PutUint16(packet[ethHeaderLength+ipHeaderLength:], sport)
+	binary.BigEndian.PutUint16(packet[ethHeaderLength+ipHeaderLength+2:], dport)
+	return packet
+}
+
+func mustParseCidr(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+func TestProgram(t *testing.T) {
+	g := NewWithT(t)
+
+	prog := Program()
+	g.Expect(prog.Marshal(io.Discard, binary.LittleEndian)).Should(Succeed(), "all the jumps are resolved")
+
+	rules := []Rule{
+		{Destination: mustParseCidr("10.0.0.0/8"), SourcePorts: AllPorts, DestinationPorts: AllPorts, Delay: 10 * time.Millisecond},
+		{Destination: mustParseCidr("10.0.0.0/24"), Protocol: 6, SourcePorts: AllPorts, DestinationPorts: PortRange{From: 8000, To: 8080}, Delay: 100 * time.Millisecond, Jitter: 10 * time.Millisecond},
+		{Destination: mustParseCidr("172.16.0.1/32"), SourcePorts: AllPorts, DestinationPorts: AllPorts, Loss: 50},
+		{Destination: mustParseCidr("192.168.0.0/16"), SourcePorts: AllPorts, DestinationPorts: AllPorts, Rate: 1000},
+	}
+	m := newVM(rules)
+	now := m.now
+
+	m.random = 10
+	verdict, tstamp := m.run(prog, ipv4Packet("10.0.0.5", 6, 1234, 8000, 100))
+	g.Expect(verdict).Should(Equal(uint64(tcActOK)))
+	g.Expect(tstamp).Should(Equal(now+uint64(90*time.Millisecond)+10), "the longest prefix with jitter")
+
+	_, tstamp = m.run(prog, ipv4Packet("10.0.0.5", 6, 1234, 80, 100))
+	g.Expect(tstamp).Should(Equal(uint64(0)), "the port isn't matched")
+
+	_, tstamp = m.run(prog, ipv4Packet("10.0.0.5", 17, 1234, 8000, 100))
+	g.Expect(tstamp).Should(Equal(uint64(0)), "the protocol isn't matched")
+
+	_, tstamp = m.run(prog, ipv4Packet("10.2.0.5", 17, 1234, 53, 100))
+	g.Expect(tstamp).Should(Equal(now+uint64(10*time.Millisecond)), "the shorter prefix")
+
+	_, tstamp = m.run(prog, ipv4Packet("8.8.8.8", 17, 1234, 53, 100))
+	g.Expect(tstamp).Should(Equal(uint64(0)), "no rule is matched")
+
+	m.random = 1 << 30
+	verdict, _ = m.run(prog, ipv4Packet("172.16.0.1", 1, 0, 0, 100))
+	g.Expect(verdict).Should(Equal(uint64(tcActShot)), "the random number is less than the threshold")
+	m.random = 3 << 30
+	verdict, _ = m.run(prog, ipv4Packet("172.16.0.1", 1, 0, 0, 100))
+	g.Expect(verdict).Should(Equal(uint64(tcActOK)), "the random number is greater than the threshold")
+
+	for i := 0; i < 3; i++ {
+		_, tstamp = m.run(prog, ipv4Packet("192.168.1.1", 6, 1234, 80, 100))
+		g.Expect(tstamp).Should(Equal(now+uint64(i)*uint64(100*time.Millisecond)), "packets of the same flow are paced")
+	}
+	_, tstamp = m.run(prog, ipv4Packet("192.168.1.1", 6, 1235, 80, 100))
+	g.Expect(tstamp).Should(Equal(now), "another flow isn't affected")
+
+	packet := ipv4Packet("10.0.0.5", 6, 1234, 8000, 100)
+	packet[ethHeaderLength] = 0x60
+	_, tstamp = m.run(prog, packet)
+	g.Expect(tstamp).Should(Equal(uint64(0)), "ipv6 packets are ignored")
+}
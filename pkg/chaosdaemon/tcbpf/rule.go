@@ -0,0 +1,340 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tcbpf
+
+import (
+	"encoding/binary"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/netem"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// ruleKeySize is the size of key in the rules map, which is the prefix length and IPv4 address
+	ruleKeySize = 8
+	// ruleValueSize is the size of value in the rules map
+	ruleValueSize = 40
+
+	// the offsets of fields in the value of rules map
+	ruleDelayOffset    = 0
+	ruleJitterOffset   = 8
+	ruleRateOffset     = 16
+	ruleLossOffset     = 24
+	ruleProtocolOffset = 28
+	ruleSportsOffset   = 32
+	ruleDportsOffset   = 36
+)
+
+// PortRange is a range of ports, both ends are included
+type PortRange struct {
+	From uint16
+	To   uint16
+}
+
+// AllPorts matches all ports, including the packets without ports
+var AllPorts = PortRange{From: 0, To: math.MaxUint16}
+
+// Rule is the traffic control on the packets to a destination network. The packets are delayed
+// by setting their earliest departure time, and the rate is limited for every flow separately.
+type Rule struct {
+	// Destination is the IPv4 network of destination, the longest matched prefix wins
+	Destination *net.IPNet
+
+	// Protocol is the protocol number in IPv4 header, 0 matches all protocols
+	Protocol uint8
+
+	SourcePorts      PortRange
+	DestinationPorts PortRange
+
+	Delay  time.Duration
+	Jitter time.Duration
+
+	// Loss is the percentage of dropped packets
+	Loss float32
+
+	// Rate is the max bytes per second of every flow, 0 means no limit
+	Rate uint64
+}
+
+// MarshalKey encodes the destination into the key of rules map
+func (r Rule) MarshalKey() []byte {
+	ones, _ := r.Destination.Mask.Size()
+
+	key := make([]byte, ruleKeySize)
+	binary.NativeEndian.PutUint32(key[0:], uint32(ones))
+	copy(key[4:], r.Destination.IP.To4())
+	return key
+}
+
+// MarshalValue encodes the rule into the value of rules map, the loss is converted
+// into the threshold of a random uint32
+func (r Rule) MarshalValue() []byte {
+	value := make([]byte, ruleValueSize)
+	binary.NativeEndian.PutUint64(value[ruleDelayOffset:], uint64(r.Delay))
+	binary.NativeEndian.PutUint64(value[ruleJitterOffset:], uint64(r.Jitter))
+	binary.NativeEndian.PutUint64(value[ruleRateOffset:], r.Rate)
+	binary.NativeEndian.PutUint32(value[ruleLossOffset:], lossThreshold(r.Loss))
+	value[ruleProtocolOffset] = r.Protocol
+	binary.NativeEndian.PutUint16(value[ruleSportsOffset:], r.SourcePorts.From)
+	binary.NativeEndian.PutUint16(value[ruleSportsOffset+2:], r.SourcePorts.To)
+	binary.NativeEndian.PutUint16(value[ruleDportsOffset:], r.DestinationPorts.From)
+	binary.NativeEndian.PutUint16(value[ruleDportsOffset+2:], r.DestinationPorts.To)
+	return value
+}
+
+func lossThreshold(loss float32) uint32 {
+	if loss <= 0 {
+		return 0
+	}
+	if loss >= 100 {
+		return math.MaxUint32
+	}
+	return uint32(float64(loss) / 100 * math.MaxUint32)
+}
+
+// FromTcs converts the tcs into the rules, the destinations are resolved from the ipsets.
+// The tcs with the same destination are merged into one rule, and they should have the same
+// filter. Only the delay, jitter, loss and rate without correlation are supported on the
+// egress traffic of IPv4, and the IPv6 destinations are rejected.
+func FromTcs(tcs []*pb.Tc, ipsets []*pb.IPSet) ([]Rule, error) {
+	rules := []Rule{}
+	indexes := make(map[string]int)
+
+	for _, tc := range tcs {
+		if tc.Ingress {
+			return nil, errors.New("ingress traffic control is not supported by bpf backend")
+		}
+		if tc.Profile != nil {
+			return nil, errors.New("network profile is not supported by bpf backend")
+		}
+
+		filter, err := fromFilter(tc)
+		if err != nil {
+			return nil, err
+		}
+		if err := mergeTc(&filter, tc); err != nil {
+			return nil, err
+		}
+
+		destinations := []*net.IPNet{{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 32)}}
+		if len(tc.Ipset) > 0 {
+			destinations, err = resolveIPSet(tc.Ipset, ipsets, map[string]bool{})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, destination := range destinations {
+			rule := filter
+			rule.Destination = destination
+
+			key := destination.String()
+			index, ok := indexes[key]
+			if !ok {
+				indexes[key] = len(rules)
+				rules = append(rules, rule)
+				continue
+			}
+
+			existing := &rules[index]
+			if existing.Protocol != rule.Protocol || existing.SourcePorts != rule.SourcePorts || existing.DestinationPorts != rule.DestinationPorts {
+				return nil, errors.Errorf("traffic controls to %s have different filters, which is not supported by bpf backend", key)
+			}
+			if err := mergeTc(existing, tc); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+func fromFilter(tc *pb.Tc) (Rule, error) {
+	rule := Rule{SourcePorts: AllPorts, DestinationPorts: AllPorts}
+
+	switch tc.Protocol {
+	case "":
+	case "tcp":
+		rule.Protocol = 6
+	case "udp":
+		rule.Protocol = 17
+	case "icmp":
+		rule.Protocol = 1
+	default:
+		return rule, errors.Errorf("unknown protocol %s", tc.Protocol)
+	}
+
+	var err error
+	if rule.SourcePorts, err = parsePortRange(tc.SourcePort); err != nil {
+		return rule, err
+	}
+	if rule.DestinationPorts, err = parsePortRange(tc.EgressPort); err != nil {
+		return rule, err
+	}
+	return rule, nil
+}
+
+// parsePortRange parses a single port or a range like "8000:8080", an empty string matches all ports
+func parsePortRange(ports string) (PortRange, error) {
+	if len(ports) == 0 {
+		return AllPorts, nil
+	}
+	if strings.Contains(ports, ",") {
+		return PortRange{}, errors.Errorf("list of ports %s is not supported by bpf backend", ports)
+	}
+
+	bounds := strings.SplitN(ports, ":", 2)
+	from, err := strconv.ParseUint(bounds[0], 10, 16)
+	if err != nil {
+		return PortRange{}, errors.Wrapf(err, "parse ports %s", ports)
+	}
+	to := from
+	if len(bounds) > 1 {
+		to, err = strconv.ParseUint(bounds[1], 10, 16)
+		if err != nil {
+			return PortRange{}, errors.Wrapf(err, "parse ports %s", ports)
+		}
+	}
+	if from > to {
+		return PortRange{}, errors.Errorf("invalid range of ports %s", ports)
+	}
+	return PortRange{From: uint16(from), To: uint16(to)}, nil
+}
+
+// mergeTc adds the tc to the rule like chaining the qdiscs, the delays are summed up, the
+// losses are combined, and the lower rate wins
+func mergeTc(rule *Rule, tc *pb.Tc) error {
+	switch tc.Type {
+	case pb.Tc_NETEM:
+		n := tc.Netem
+		if n == nil {
+			return errors.New("netem is nil while type is NETEM")
+		}
+		if n.Duplicate > 0 || n.Corrupt > 0 || n.Reorder > 0 {
+			return errors.New("duplicate, corrupt and reorder are not supported by bpf backend")
+		}
+		if n.DelayCorr > 0 || n.LossCorr > 0 {
+			return errors.New("correlation of delay and loss is not supported by bpf backend")
+		}
+
+		if len(n.Time) > 0 {
+			delay, err := time.ParseDuration(n.Time)
+			if err != nil {
+				return errors.Wrapf(err, "parse delay %s", n.Time)
+			}
+			rule.Delay += delay
+		}
+		if len(n.Jitter) > 0 {
+			jitter, err := time.ParseDuration(n.Jitter)
+			if err != nil {
+				return errors.Wrapf(err, "parse jitter %s", n.Jitter)
+			}
+			rule.Jitter += jitter
+		}
+		if n.Loss > 0 {
+			rule.Loss = 100 - (100-rule.Loss)*(100-n.Loss)/100
+		}
+		if len(n.Rate) > 0 {
+			if err := rule.limitRate(n.Rate); err != nil {
+				return err
+			}
+		}
+	case pb.Tc_BANDWIDTH:
+		if tc.Tbf == nil {
+			return errors.New("tbf is nil while type is BANDWIDTH")
+		}
+		if err := rule.limitRate(tc.Tbf.Rate); err != nil {
+			return err
+		}
+	default:
+		return errors.New("unknown tc qdisc type")
+	}
+
+	return nil
+}
+
+func (r *Rule) limitRate(rate string) error {
+	bytes := netem.ParseRate(rate)
+	if bytes == 0 {
+		return errors.Errorf("invalid rate %s", rate)
+	}
+	if r.Rate == 0 || bytes < r.Rate {
+		r.Rate = bytes
+	}
+	return nil
+}
+
+// resolveIPSet returns the IPv4 networks in the ipset, it fails on the IPv6 networks
+// which the bpf program doesn't match
+func resolveIPSet(name string, ipsets []*pb.IPSet, visited map[string]bool) ([]*net.IPNet, error) {
+	if visited[name] {
+		return nil, nil
+	}
+	visited[name] = true
+
+	for _, ipset := range ipsets {
+		if ipset.Name != name {
+			continue
+		}
+		if len(ipset.CidrAndPorts) > 0 {
+			return nil, errors.Errorf("ipset %s with ports is not supported by bpf backend", name)
+		}
+
+		networks := []*net.IPNet{}
+		for _, cidr := range ipset.Cidrs {
+			network, err := parseCidr(cidr)
+			if err != nil {
+				return nil, err
+			}
+			if network.IP.To4() == nil {
+				return nil, errors.Errorf("IPv6 network %s in ipset %s is not supported by bpf backend", cidr, name)
+			}
+			networks = append(networks, network)
+		}
+		for _, setName := range ipset.SetNames {
+			children, err := resolveIPSet(setName, ipsets, visited)
+			if err != nil {
+				return nil, err
+			}
+			networks = append(networks, children...)
+		}
+		return networks, nil
+	}
+
+	return nil, errors.Errorf("ipset %s is not found", name)
+}
+
+func parseCidr(cidr string) (*net.IPNet, error) {
+	if _, network, err := net.ParseCIDR(cidr); err == nil {
+		return network, nil
+	}
+
+	ip := net.ParseIP(cidr)
+	if ip == nil {
+		return nil, errors.Errorf("invalid cidr %s", cidr)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
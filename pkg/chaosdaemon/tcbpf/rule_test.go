@@ -0,0 +1,114 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tcbpf
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestFromTcs(t *testing.T) {
+	g := NewWithT(t)
+
+	ipsets := []*pb.IPSet{
+		{Name: "a", Cidrs: []string{"10.0.0.0/24", "10.0.1.1"}},
+		{Name: "b", Cidrs: []string{"192.168.0.0/16"}},
+		{Name: "set", SetNames: []string{"a", "b"}},
+		{Name: "ports", CidrAndPorts: []*pb.CidrAndPort{{Cidr: "10.0.0.1/32", Port: 80}}},
+		{Name: "ipv6", Cidrs: []string{"fd00::/64"}},
+		{Name: "dual", SetNames: []string{"b", "ipv6"}},
+	}
+
+	rules, err := FromTcs([]*pb.Tc{
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "100ms", Jitter: "10ms", Loss: 50}, Ipset: "set", Protocol: "tcp", EgressPort: "8000:8080"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "50ms", Loss: 50}, Ipset: "b", Protocol: "tcp", EgressPort: "8000:8080"},
+		{Type: pb.Tc_BANDWIDTH, Tbf: &pb.Tbf{Rate: "1kbps"}, Ipset: "b", Protocol: "tcp", EgressPort: "8000:8080"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Rate: "2kbps"}},
+	}, ipsets)
+	g.Expect(err).ShouldNot(HaveOccurred())
+	g.Expect(rules).Should(HaveLen(4))
+
+	g.Expect(rules[0].Destination.String()).Should(Equal("10.0.0.0/24"))
+	g.Expect(rules[1].Destination.String()).Should(Equal("10.0.1.1/32"))
+	g.Expect(rules[0].Protocol).Should(Equal(uint8(6)))
+	g.Expect(rules[0].DestinationPorts).Should(Equal(PortRange{From: 8000, To: 8080}))
+	g.Expect(rules[0].SourcePorts).Should(Equal(AllPorts))
+
+	g.Expect(rules[2].Destination.String()).Should(Equal("192.168.0.0/16"))
+	g.Expect(rules[2].Delay).Should(Equal(150 * time.Millisecond))
+	g.Expect(rules[2].Jitter).Should(Equal(10 * time.Millisecond))
+	g.Expect(rules[2].Loss).Should(BeNumerically("~", 75, 0.001))
+	g.Expect(rules[2].Rate).Should(Equal(uint64(1024)))
+
+	g.Expect(rules[3].Destination.String()).Should(Equal("0.0.0.0/0"))
+	g.Expect(rules[3].Rate).Should(Equal(uint64(2048)))
+
+	unsupported := []*pb.Tc{
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ingress: true},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Duplicate: 10}},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Profile: &pb.NetemProfile{}},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Protocol: "tcp", EgressPort: "80,443"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "ports"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "unknown"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "ipv6"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "dual"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s", DelayCorr: 25}},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Loss: 10, LossCorr: 25}},
+	}
+	for _, tc := range unsupported {
+		_, err := FromTcs([]*pb.Tc{tc}, ipsets)
+		g.Expect(err).Should(HaveOccurred(), tc.String())
+	}
+
+	_, err = FromTcs([]*pb.Tc{
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "b", Protocol: "tcp"},
+		{Type: pb.Tc_NETEM, Netem: &pb.Netem{Time: "1s"}, Ipset: "b", Protocol: "udp"},
+	}, ipsets)
+	g.Expect(err).Should(HaveOccurred(), "different filters to the same destination")
+}
+
+func TestMarshalRule(t *testing.T) {
+	g := NewWithT(t)
+
+	rule := Rule{
+		Destination:      mustParseCidr("10.0.0.0/24"),
+		Protocol:         17,
+		SourcePorts:      AllPorts,
+		DestinationPorts: PortRange{From: 53, To: 53},
+		Delay:            time.Second,
+		Loss:             100,
+		Rate:             1024,
+	}
+
+	key := rule.MarshalKey()
+	g.Expect(binary.NativeEndian.Uint32(key)).Should(Equal(uint32(24)))
+	g.Expect(key[4:]).Should(Equal([]byte{10, 0, 0, 0}))
+
+	value := rule.MarshalValue()
+	g.Expect(value).Should(HaveLen(ruleValueSize))
+	g.Expect(binary.NativeEndian.Uint64(value[ruleDelayOffset:])).Should(Equal(uint64(time.Second)))
+	g.Expect(binary.NativeEndian.Uint64(value[ruleRateOffset:])).Should(Equal(uint64(1024)))
+	g.Expect(binary.NativeEndian.Uint32(value[ruleLossOffset:])).Should(Equal(uint32(math.MaxUint32)))
+	g.Expect(value[ruleProtocolOffset]).Should(Equal(uint8(17)))
+	g.Expect(binary.NativeEndian.Uint16(value[ruleSportsOffset+2:])).Should(Equal(uint16(math.MaxUint16)))
+	g.Expect(binary.NativeEndian.Uint16(value[ruleDportsOffset:])).Should(Equal(uint16(53)))
+}
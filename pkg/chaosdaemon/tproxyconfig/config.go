@@ -20,9 +20,17 @@ import (
 )
 
 type Config struct {
-	ProxyPorts []uint32               `json:"proxy_ports,omitempty"`
-	Rules      []PodHttpChaosBaseRule `json:"rules"`
-	TLS        *TLSConfig             `json:"tls,omitempty"`
+	ProxyPorts    []uint32               `json:"proxy_ports,omitempty"`
+	EgressTargets []EgressTarget         `json:"egress_targets,omitempty"`
+	Rules         []PodHttpChaosBaseRule `json:"rules"`
+	TLS           *TLSConfig             `json:"tls,omitempty"`
+}
+
+// EgressTarget represents the outbound connections to the port of cidrs are
+// redirected to the http proxy, all the destinations are redirected if cidrs is empty.
+type EgressTarget struct {
+	Port  uint32   `json:"port"`
+	Cidrs []string `json:"cidrs,omitempty"`
 }
 
 type TLSConfig struct {
@@ -46,6 +54,62 @@ type PodHttpChaosBaseRule struct {
 
 	// Actions contains rules to inject target.
 	Actions PodHttpChaosActions `json:"actions"`
+
+	// Percent represents the percentage of the selected requests to be injected.
+	// +optional
+	Percent *int `json:"percent,omitempty"`
+
+	// RateLimit represents the maximum number of requests to be injected per second.
+	// +optional
+	RateLimit *int32 `json:"rate_limit,omitempty"`
+
+	// Egress represents this rule selects the outbound requests.
+	// +optional
+	Egress *PodHttpChaosEgress `json:"egress,omitempty"`
+}
+
+// PodHttpChaosEgress represents the domain names, IPs or CIDRs of destinations of
+// the outbound requests.
+type PodHttpChaosEgress struct {
+	Targets []string `json:"targets,omitempty"`
+}
+
+// Stats is the response of `GET /stats`, it contains the counters of
+// every rule in the same order as Config.Rules.
+type Stats struct {
+	Rules []RuleStats `json:"rules"`
+}
+
+type RuleStats struct {
+	// Matched is the number of requests selected by the rule.
+	Matched int64 `json:"matched"`
+
+	// Faulted is the number of requests injected by the rule.
+	Faulted int64 `json:"faulted"`
+
+	// Observations is the requests counted by the observe action, grouped by
+	// path, method and status code. The http proxy keeps a limited number of
+	// groups, and the requests beyond are not observed.
+	Observations []Observation `json:"observations,omitempty"`
+}
+
+// ObservationLatencyBuckets is the upper bounds in seconds of the buckets of
+// the latency histogram reported by the http proxy
+var ObservationLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type Observation struct {
+	Path   string `json:"path"`
+	Method string `json:"method"`
+	Code   int32  `json:"code"`
+	Count  int64  `json:"count"`
+
+	// LatencySum is the sum of latency of the requests in seconds, which is the time
+	// from receiving the request to receiving the response headers from upstream.
+	LatencySum float64 `json:"latency_sum"`
+
+	// LatencyBuckets is the cumulative counts of requests whose latency is
+	// less than or equal to the bounds in ObservationLatencyBuckets.
+	LatencyBuckets []uint64 `json:"latency_buckets"`
 }
 
 type PodHttpChaosSelector struct {
@@ -74,6 +138,36 @@ type PodHttpChaosSelector struct {
 	// The key-value pairs represent header name and header value pairs.
 	// +optional
 	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
+
+	// PathPattern is a rule to select target by matching uri path with a pattern.
+	// +optional
+	PathPattern *PodHttpChaosPattern `json:"path_pattern,omitempty"`
+
+	// Queries is a rule to select target by the patterns of uri queries.
+	// +optional
+	Queries map[string]PodHttpChaosPattern `json:"queries,omitempty"`
+
+	// RequestHeaderPatterns is a rule to select target by the patterns of http headers in request.
+	// +optional
+	RequestHeaderPatterns map[string]PodHttpChaosPattern `json:"request_header_patterns,omitempty"`
+
+	// RequestBody is a rule to select target by the contents of http request body.
+	// +optional
+	RequestBody *PodHttpChaosBodySelector `json:"request_body,omitempty"`
+}
+
+// PodHttpChaosPattern is a pattern to match a value, the type is one of `Exact`,
+// `Glob` (path.Match) and `Regex` (RE2), and it's `Exact` if empty.
+type PodHttpChaosPattern struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value"`
+}
+
+// PodHttpChaosBodySelector selects http request by the value located by
+// json path in body, or the whole body if the json path is empty.
+type PodHttpChaosBodySelector struct {
+	JSONPath string              `json:"json_path,omitempty"`
+	Pattern  PodHttpChaosPattern `json:"pattern"`
 }
 
 // PodHttpChaosActions defines possible actions of HttpChaos.
@@ -97,6 +191,51 @@ type PodHttpChaosActions struct {
 	// Patch is a rule to patch some contents in target.
 	// +optional
 	Patch *PodHttpChaosPatchActions `json:"patch,omitempty"`
+
+	// Stream is a rule to slow down or truncate the response body.
+	// +optional
+	Stream *PodHttpChaosStreamActions `json:"stream,omitempty"`
+
+	// Frames is a rule to inject the frames of WebSocket and server-sent events.
+	// +optional
+	Frames *PodHttpChaosFrameActions `json:"frames,omitempty"`
+
+	// Observe represents the selected requests are only counted without being changed.
+	// +optional
+	Observe *bool `json:"observe,omitempty"`
+}
+
+// PodHttpChaosStreamActions defines the actions on streaming the response body.
+type PodHttpChaosStreamActions struct {
+	// Rate represents the response body is sent at this rate in bytes per second.
+	Rate *int64 `json:"rate,omitempty"`
+
+	// Ttfb represents the delay before the first byte of response is sent.
+	Ttfb *string `json:"ttfb,omitempty"`
+
+	// Truncate represents the connection is cut after this number of bytes of body are sent.
+	Truncate *int64 `json:"truncate,omitempty"`
+}
+
+// PodHttpChaosFrameActions defines the actions on the frames of upgraded connections.
+type PodHttpChaosFrameActions struct {
+	// DropAfterFrames represents the connection is closed after this number of frames.
+	DropAfterFrames *int64 `json:"drop_after_frames,omitempty"`
+
+	// DropAfter represents the connection is closed after this duration since it's upgraded.
+	DropAfter *string `json:"drop_after,omitempty"`
+
+	// Delay represents the delay of every frame.
+	Delay *string `json:"delay,omitempty"`
+
+	// Replace represents the payload of every frame is replaced by it.
+	Replace []byte `json:"replace,omitempty"`
+
+	// Corrupt represents the percentage of frames whose payload is corrupted.
+	Corrupt *int `json:"corrupt,omitempty"`
+
+	// CloseCode represents the close code of WebSocket sent when the connection is dropped.
+	CloseCode *int32 `json:"close_code,omitempty"`
 }
 
 // PodHttpChaosPatchBody defines the patch-body action of HttpChaos.
@@ -113,6 +252,16 @@ type PodHttpChaosBodyPatchContent struct {
 }
 
 func (p *PodHttpChaosPatchBody) UnmarshalJSON(data []byte) error {
+	// the config sent to the proxy has the wrapped contents, while the rules of
+	// PodHttpChaos have the bare contents
+	var wrapped struct {
+		Contents *PodHttpChaosBodyPatchContent `json:"contents"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err == nil && wrapped.Contents != nil {
+		p.Contents = *wrapped.Contents
+		return nil
+	}
+
 	var pp PodHttpChaosBodyPatchContent
 	err := json.Unmarshal(data, &pp)
 	if err != nil {
@@ -153,6 +302,16 @@ type PodHttpChaosBodyReplaceContent struct {
 }
 
 func (p *PodHttpChaosReplaceBody) UnmarshalJSON(data []byte) error {
+	// the config sent to the proxy has the wrapped contents, while the rules of
+	// PodHttpChaos have the body in base64
+	var wrapped struct {
+		Contents *PodHttpChaosBodyReplaceContent `json:"contents"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err == nil && wrapped.Contents != nil {
+		p.Contents = *wrapped.Contents
+		return nil
+	}
+
 	var pp PodHttpChaosBodyReplaceContent
 	err := json.Unmarshal(data, &pp)
 	if err == nil {
@@ -203,3 +362,43 @@ type PodHttpChaosReplaceActions struct {
 
 // PodHttpChaosTarget represents the type of an HttpChaos Action
 type PodHttpChaosTarget string
+
+// MergeObservations sums up the observations with the same path, method and
+// status code, in the order of their first appearance. The latency buckets of
+// an observation are dropped if they don't match ObservationLatencyBuckets.
+func MergeObservations(observations []Observation) []Observation {
+	type key struct {
+		path   string
+		method string
+		code   int32
+	}
+
+	var merged []Observation
+	index := make(map[key]int)
+	for _, observation := range observations {
+		k := key{observation.Path, observation.Method, observation.Code}
+		i, ok := index[k]
+		if !ok {
+			index[k] = len(merged)
+			merged = append(merged, Observation{
+				Path:   observation.Path,
+				Method: observation.Method,
+				Code:   observation.Code,
+			})
+			i = len(merged) - 1
+		}
+
+		item := &merged[i]
+		item.Count += observation.Count
+		item.LatencySum += observation.LatencySum
+		if len(observation.LatencyBuckets) == len(ObservationLatencyBuckets) {
+			if item.LatencyBuckets == nil {
+				item.LatencyBuckets = make([]uint64, len(ObservationLatencyBuckets))
+			}
+			for j, count := range observation.LatencyBuckets {
+				item.LatencyBuckets[j] += count
+			}
+		}
+	}
+	return merged
+}
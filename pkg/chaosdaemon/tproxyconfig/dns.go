@@ -0,0 +1,46 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tproxyconfig
+
+// DNSConfig is the config of the DNS responder.
+type DNSConfig = RulesConfig[DNSRule]
+
+// DNSRule defines the injection rule of the DNS responder.
+type DNSRule struct {
+	// Patterns selects the requests by the domain name, all the requests are
+	// selected if it's empty.
+	// +optional
+	Patterns []string `json:"patterns,omitempty"`
+
+	// Action is one of error, random, delay and fixed.
+	Action string `json:"action"`
+
+	// Delay represents the latency added to the answers of delay action.
+	// +optional
+	Delay string `json:"delay,omitempty"`
+
+	// Rcode represents the response code returned by error action.
+	// +optional
+	Rcode string `json:"rcode,omitempty"`
+
+	// Truncate represents the answers over UDP are truncated without records.
+	// +optional
+	Truncate bool `json:"truncate,omitempty"`
+
+	// Records represents the static records returned by fixed action.
+	// +optional
+	Records map[string][]string `json:"records,omitempty"`
+}
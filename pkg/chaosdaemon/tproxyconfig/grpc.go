@@ -0,0 +1,63 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tproxyconfig
+
+// GrpcConfig is the config of the gRPC proxy.
+type GrpcConfig = RulesConfig[GrpcRule]
+
+// GrpcRule defines the injection rule of the gRPC proxy.
+type GrpcRule struct {
+	// Selector contains the rules to select target.
+	Selector GrpcSelector `json:"selector"`
+
+	// Actions contains rules to inject target.
+	Actions GrpcActions `json:"actions"`
+}
+
+type GrpcSelector struct {
+	// Service is a rule to select target by the full name of gRPC service.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// Method is a rule to select target by the name of gRPC method.
+	// +optional
+	Method *string `json:"method,omitempty"`
+
+	// Metadata is a rule to select target by the metadata of gRPC request.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// GrpcActions defines possible actions of GRPCChaos.
+type GrpcActions struct {
+	// Status is a rule to respond with a gRPC status instead of forwarding the call.
+	// +optional
+	Status *GrpcStatus `json:"status,omitempty"`
+
+	// Delay represents the delay of the target call.
+	// +optional
+	Delay *string `json:"delay,omitempty"`
+
+	// AbortAfterMessages is a rule to abort the stream after forwarding
+	// the specific number of response messages.
+	// +optional
+	AbortAfterMessages *int32 `json:"abort_after_messages,omitempty"`
+}
+
+type GrpcStatus struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message,omitempty"`
+}
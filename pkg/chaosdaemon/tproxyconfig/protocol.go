@@ -0,0 +1,66 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tproxyconfig
+
+// ProtocolConfig is the config of the protocol proxy.
+type ProtocolConfig = RulesConfig[ProtocolRule]
+
+// ProtocolRule defines the injection rule of the protocol proxy.
+type ProtocolRule struct {
+	// Selector contains the rules to select target.
+	Selector ProtocolSelector `json:"selector"`
+
+	// Actions contains rules to inject target.
+	Actions ProtocolActions `json:"actions"`
+}
+
+type ProtocolSelector struct {
+	// Command is a rule to select target by the name of command, case-insensitively.
+	// +optional
+	Command *string `json:"command,omitempty"`
+
+	// KeyPattern is a rule to select the redis commands by the glob pattern of key.
+	// +optional
+	KeyPattern *string `json:"key_pattern,omitempty"`
+
+	// QueryPattern is a rule to select the mysql commands by the regex of query.
+	// +optional
+	QueryPattern *string `json:"query_pattern,omitempty"`
+}
+
+// ProtocolActions defines possible actions of ProtocolChaos.
+type ProtocolActions struct {
+	// Error is a rule to respond with an error instead of forwarding the command.
+	// +optional
+	Error *ProtocolError `json:"error,omitempty"`
+
+	// Delay represents the delay of the target command.
+	// +optional
+	Delay *string `json:"delay,omitempty"`
+
+	// Abort is a rule to close the connection when the command is received.
+	// +optional
+	Abort bool `json:"abort,omitempty"`
+}
+
+// ProtocolError represents the error responded by the proxy.
+type ProtocolError struct {
+	// Code is the error code, only used by mysql.
+	// +optional
+	Code *int32 `json:"code,omitempty"`
+
+	Message string `json:"message"`
+}
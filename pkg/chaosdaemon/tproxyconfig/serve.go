@@ -0,0 +1,87 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tproxyconfig
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// RulesConfig is the config of the proxies which only have rules, the rules of
+// proxy are replaced by it.
+type RulesConfig[T any] struct {
+	Rules []T `json:"rules"`
+}
+
+// Handler handles a config request, and returns the status code and the message
+// of response.
+type Handler func(req *http.Request) (int, string)
+
+// Serve reads the http requests from in, handles them with handle, and writes the
+// responses to out, which is the interactive protocol of tproxy shared by all the
+// proxies of chaos daemon. It returns nil when in is closed.
+func Serve(in io.Reader, out io.Writer, handle Handler) error {
+	reader := bufio.NewReader(in)
+	for {
+		req, err := http.ReadRequest(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrap(err, "read config request")
+		}
+
+		code, message := handle(req)
+		req.Body.Close()
+
+		resp := &http.Response{
+			StatusCode:    code,
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			ContentLength: int64(len(message)),
+			Body:          io.NopCloser(bytes.NewBufferString(message)),
+			Request:       req,
+		}
+		if err := resp.Write(out); err != nil {
+			return errors.Wrap(err, "write config response")
+		}
+	}
+}
+
+// PutRules returns a Handler, which replaces the rules with setRules for a `PUT /`
+// request with the json of RulesConfig.
+func PutRules[T any](setRules func(rules []T) error) Handler {
+	return func(req *http.Request) (int, string) {
+		if req.Method != http.MethodPut || req.URL.Path != "/" {
+			return http.StatusNotFound, fmt.Sprintf("%s %s is not supported", req.Method, req.URL.Path)
+		}
+
+		var config RulesConfig[T]
+		if err := json.NewDecoder(req.Body).Decode(&config); err != nil {
+			return http.StatusBadRequest, fmt.Sprintf("decode config: %s", err)
+		}
+		if err := setRules(config.Rules); err != nil {
+			return http.StatusBadRequest, err.Error()
+		}
+		return http.StatusOK, ""
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package tproxyconfig
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestServePutRules(t *testing.T) {
+	g := NewWithT(t)
+
+	var applied []DNSRule
+	handler := PutRules(func(rules []DNSRule) error {
+		if len(rules) > 1 {
+			return errors.New("too many rules")
+		}
+		applied = rules
+		return nil
+	})
+
+	in := &bytes.Buffer{}
+	for _, req := range []struct{ method, path, body string }{
+		{http.MethodPut, "/", `{"rules":[{"action":"error"}]}`},
+		{http.MethodPut, "/", `{"rules":[{"action":"error"},{"action":"random"}]}`},
+		{http.MethodPut, "/", `{"rules":`},
+		{http.MethodGet, "/stats", ""},
+	} {
+		r, err := http.NewRequest(req.method, req.path, bytes.NewBufferString(req.body))
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(r.Write(in)).Should(Succeed())
+	}
+
+	out := &bytes.Buffer{}
+	g.Expect(Serve(in, out, handler)).Should(Succeed())
+
+	reader := bufio.NewReader(out)
+	for _, code := range []int{http.StatusOK, http.StatusBadRequest, http.StatusBadRequest, http.StatusNotFound} {
+		resp, err := http.ReadResponse(reader, nil)
+		g.Expect(err).ShouldNot(HaveOccurred())
+		g.Expect(resp.StatusCode).Should(Equal(code))
+		io.Copy(io.Discard, resp.Body)
+	}
+
+	// the rejected configs don't replace the rules
+	g.Expect(applied).Should(Equal([]DNSRule{{Action: "error"}}))
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/util"
+)
+
+// TrustedCABundles are the CA bundle files of common distributions
+var TrustedCABundles = []string{
+	// Debian, Ubuntu, Alpine
+	"/etc/ssl/certs/ca-certificates.crt",
+	// RHEL, CentOS, Fedora
+	"/etc/pki/tls/certs/ca-bundle.crt",
+	// OpenSUSE
+	"/etc/ssl/ca-bundle.pem",
+}
+
+var ErrInvalidCA = errors.New("invalid PEM encoded certificate of CA")
+
+func (s *DaemonServer) SetTrustedCA(ctx context.Context,
+	req *pb.SetTrustedCARequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+
+	log.Info("SetTrustedCA", "containerID", req.ContainerId, "enable", req.Enable)
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		log.Error(err, "GetPidFromContainerID")
+		return nil, err
+	}
+
+	bundles := strings.Join(TrustedCABundles, " ")
+	var script string
+	if req.Enable {
+		ca, err := encodeCA(req.Ca)
+		if err != nil {
+			return nil, err
+		}
+
+		// backup the bundles and append the ca to them, the symlinks are skipped to
+		// avoid appending the ca twice into the same file
+		script = fmt.Sprintf("for f in %s; do if [ -f $f ] && [ ! -L $f ]; then (ls $f.chaos.bak || cp $f $f.chaos.bak) && cat $f.chaos.bak > $f && printf '%%s' '%s' >> $f || exit 1; fi; done", bundles, ca)
+	} else {
+		// recover the bundles
+		script = fmt.Sprintf("for f in %s; do if [ -f $f.chaos.bak ]; then cat $f.chaos.bak > $f && rm $f.chaos.bak || exit 1; fi; done", bundles)
+	}
+
+	processBuilder := bpm.DefaultProcessBuilder("sh", "-c", script).SetContext(ctx)
+	if req.EnterNS {
+		processBuilder = processBuilder.SetNS(pid, bpm.MountNS)
+	}
+
+	cmd := processBuilder.Build(ctx)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		log.Error(err, "execute command error", "command", cmd.String(), "output", output)
+		return nil, util.EncodeOutputToError(output, err)
+	}
+	if len(output) != 0 {
+		log.Info("command output", "output", string(output))
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// encodeCA checks the certificate of CA and encodes it again, so that the
+// content written into the shell script only consists of safe characters
+func encodeCA(ca string) (string, error) {
+	block, _ := pem.Decode([]byte(ca))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return "", ErrInvalidCA
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil || !cert.IsCA {
+		return "", ErrInvalidCA
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})), nil
+}
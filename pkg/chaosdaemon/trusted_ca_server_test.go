@@ -0,0 +1,139 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/test"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/mock"
+)
+
+func generateTestCA(t *testing.T, isCA bool) string {
+	g := NewWithT(t)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func Test_SetTrustedCA(t *testing.T) {
+	g := NewWithT(t)
+
+	type mockCmd struct {
+		cmd  string
+		args []string
+	}
+	var executedCommands []mockCmd
+
+	mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+		executedCommands = append(executedCommands, mockCmd{cmd, args})
+		return exec.Command("echo", "mock command")
+	})
+
+	mock.With("MockContainerdClient", &test.MockClient{})
+
+	crc, err := crclients.CreateContainerRuntimeInfoClient(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server := chaosdaemon.NewDaemonServerWithCRClient(crc, nil, logr.Discard())
+
+	ca := generateTestCA(t, true)
+	res, err := server.SetTrustedCA(context.TODO(), &pb.SetTrustedCARequest{
+		ContainerId: "containerd://foo",
+		Ca:          ca,
+		Enable:      true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res).NotTo(BeNil())
+
+	g.Expect(executedCommands).To(HaveLen(1))
+	g.Expect(executedCommands[0].cmd).To(Equal("sh"))
+	script := executedCommands[0].args[1]
+	g.Expect(script).To(ContainSubstring("'" + ca + "'"))
+	for _, bundle := range chaosdaemon.TrustedCABundles {
+		g.Expect(script).To(ContainSubstring(bundle))
+	}
+
+	executedCommands = nil
+	_, err = server.SetTrustedCA(context.TODO(), &pb.SetTrustedCARequest{
+		ContainerId: "containerd://foo",
+		Enable:      false,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(executedCommands).To(Equal([]mockCmd{
+		{cmd: "sh", args: []string{"-c", "for f in " + strings.Join(chaosdaemon.TrustedCABundles, " ") + "; do if [ -f $f.chaos.bak ]; then cat $f.chaos.bak > $f && rm $f.chaos.bak || exit 1; fi; done"}},
+	}))
+}
+
+func Test_SetTrustedCA_InvalidCA(t *testing.T) {
+	g := NewWithT(t)
+
+	mock.With("MockProcessBuild", func(ctx context.Context, cmd string, args ...string) *exec.Cmd {
+		g.Fail("no process should be executed")
+		return exec.Command("echo", "mock command")
+	})
+
+	mock.With("MockContainerdClient", &test.MockClient{})
+
+	crc, err := crclients.CreateContainerRuntimeInfoClient(&crclients.CrClientConfig{
+		Runtime: crclients.ContainerRuntimeContainerd,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server := chaosdaemon.NewDaemonServerWithCRClient(crc, nil, logr.Discard())
+
+	for _, ca := range []string{"", "'; rm -rf /; '", generateTestCA(t, false)} {
+		res, err := server.SetTrustedCA(context.TODO(), &pb.SetTrustedCARequest{
+			ContainerId: "containerd://foo",
+			Ca:          ca,
+			Enable:      true,
+		})
+		g.Expect(err).To(Equal(chaosdaemon.ErrInvalidCA))
+		g.Expect(res).To(BeNil())
+	}
+}
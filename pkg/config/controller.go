@@ -82,6 +82,9 @@ type ChaosControllerConfig struct {
 	CertsDir string `envconfig:"CERTS_DIR" default:"/etc/webhook/certs"`
 	// RPCTimeout is timeout of RPC between controllers and chaos-operator
 	RPCTimeout time.Duration `envconfig:"RPC_TIMEOUT" default:"1m"`
+	// NetworkRulesCheckInterval is the interval of reading back the network rules injected by
+	// PodNetworkChaos from chaos daemon, the check is disabled if it's zero
+	NetworkRulesCheckInterval time.Duration `envconfig:"NETWORK_RULES_CHECK_INTERVAL" default:"1m"`
 	// ClusterScoped means control Chaos Object in cluster level(all namespace),
 	ClusterScoped bool `envconfig:"CLUSTER_SCOPED" default:"true"`
 	// TargetNamespace is the target namespace to injecting chaos.
@@ -188,6 +188,10 @@ func convertInnerObjectToExperiment(obj v1alpha1.InnerObject) (*core.Experiment,
 		archive.Action = string(chaos.Spec.Action)
 	case *v1alpha1.BlockChaos:
 		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.GRPCChaos:
+		archive.Action = string(chaos.Spec.Action)
+	case *v1alpha1.ProtocolChaos:
+		archive.Action = string(chaos.Spec.Action)
 	default:
 		return nil, errors.New("unsupported chaos type " + archive.Kind)
 	}
@@ -0,0 +1,62 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package flap
+
+import (
+	"time"
+)
+
+// Phase calculates the phase of flapping at now. Every cycle starts with a down phase, in which
+// the chaos is injected, and then follows an up phase. A random duration up to jitter is added
+// to the down phase and the rest of jitter to the up phase, so every cycle lasts for
+// up+down+jitter and the phase is calculated without replaying the previous cycles. The random
+// duration is generated from the start time and the index of cycle, so the phases are the same
+// wherever they are calculated.
+// It returns the number of cycles which have started, whether it's in the down phase, and the
+// time of the next switch between phases. The next switch is zero if it never switches.
+func Phase(start time.Time, up, down, jitter time.Duration, now time.Time) (int, bool, time.Time) {
+	if jitter < 0 {
+		jitter = 0
+	}
+	period := up + down + jitter
+	if period <= 0 {
+		return 1, true, time.Time{}
+	}
+	if now.Before(start) {
+		now = start
+	}
+
+	elapsed := now.Sub(start)
+	cycle := elapsed / period
+	cycleStart := start.Add(cycle * period)
+
+	downEnd := cycleStart.Add(down)
+	if jitter > 0 {
+		downEnd = downEnd.Add(time.Duration(splitmix64(uint64(start.UnixNano())+uint64(cycle)) % uint64(jitter+1)))
+	}
+	if now.Before(downEnd) {
+		return int(cycle) + 1, true, downEnd
+	}
+	return int(cycle) + 1, false, cycleStart.Add(period)
+}
+
+// splitmix64 is a cheap deterministic hash of the index of cycle
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
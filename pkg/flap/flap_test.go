@@ -0,0 +1,93 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package flap
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestPhase(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	cases := []struct {
+		elapsed time.Duration
+		cycle   int
+		down    bool
+		next    time.Duration
+	}{
+		{-time.Second, 1, true, 5 * time.Second},
+		{0, 1, true, 5 * time.Second},
+		{4 * time.Second, 1, true, 5 * time.Second},
+		{5 * time.Second, 1, false, 30 * time.Second},
+		{31 * time.Second, 2, true, 35 * time.Second},
+		{59 * time.Second, 2, false, 60 * time.Second},
+	}
+
+	for _, c := range cases {
+		cycle, down, next := Phase(start, 25*time.Second, 5*time.Second, 0, start.Add(c.elapsed))
+		g.Expect(cycle).Should(Equal(c.cycle))
+		g.Expect(down).Should(Equal(c.down))
+		g.Expect(next).Should(Equal(start.Add(c.next)))
+	}
+}
+
+func TestPhaseWithJitter(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	for i := 0; i < 10; i++ {
+		cycle, down, next := Phase(start, 25*time.Second, 5*time.Second, 2*time.Second, now)
+		g.Expect(cycle).Should(Equal(i/2 + 1))
+		g.Expect(down).Should(Equal(i%2 == 0))
+		if down {
+			g.Expect(next.Sub(now)).Should(BeNumerically("~", 6*time.Second, time.Second))
+		} else {
+			g.Expect(next.Sub(now)).Should(BeNumerically("~", 26*time.Second, time.Second))
+		}
+
+		// the phases are stable
+		_, _, again := Phase(start, 25*time.Second, 5*time.Second, 2*time.Second, now)
+		g.Expect(again).Should(Equal(next))
+
+		now = next
+	}
+}
+
+func TestPhaseOfLaterCycle(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	up, down, jitter := 25*time.Second, 5*time.Second, 2*time.Second
+
+	// every cycle lasts for up+down+jitter, so the cycle is located without replaying
+	// the previous cycles
+	cycleStart := start.Add(1000000 * (up + down + jitter))
+	cycle, isDown, next := Phase(start, up, down, jitter, cycleStart)
+	g.Expect(cycle).Should(Equal(1000001))
+	g.Expect(isDown).Should(BeTrue())
+	g.Expect(next.Sub(cycleStart)).Should(BeNumerically(">=", down))
+	g.Expect(next.Sub(cycleStart)).Should(BeNumerically("<=", down+jitter))
+
+	cycle, isDown, upEnd := Phase(start, up, down, jitter, next)
+	g.Expect(cycle).Should(Equal(1000001))
+	g.Expect(isDown).Should(BeFalse())
+	g.Expect(upEnd).Should(Equal(cycleStart.Add(up + down + jitter)))
+}
@@ -17,12 +17,15 @@ package metrics
 
 import (
 	"context"
+	"strconv"
+	"sync"
 
 	"github.com/go-logr/logr"
 	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
 	"github.com/chaos-mesh/chaos-mesh/pkg/log"
 	"github.com/chaos-mesh/chaos-mesh/pkg/metrics/utils"
 )
@@ -59,6 +62,17 @@ type ChaosDaemonMetricsCollector struct {
 	iptablesPacketBytes *prometheus.GaugeVec
 	ipsetMembers        *prometheus.GaugeVec
 	tcRules             *prometheus.GaugeVec
+	networkRuleDrifts   *prometheus.CounterVec
+
+	httpObservedLatency *prometheus.Desc
+	// httpObservations is a map from container id to the requests observed
+	// by HTTPChaos in the container
+	httpObservations sync.Map
+}
+
+type httpObservations struct {
+	labels       []string
+	observations []tproxyconfig.Observation
 }
 
 // NewChaosDaemonMetricsCollector initializes metrics for each chaos daemon
@@ -85,6 +99,15 @@ func NewChaosDaemonMetricsCollector(logger logr.Logger) *ChaosDaemonMetricsColle
 			Name:      "tcs_rules",
 			Help:      "Total number of tc rules",
 		}, []string{"namespace", "pod", "container"}),
+		networkRuleDrifts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Subsystem: chaosDaemonMetricsSubsystem,
+			Name:      "network_rule_drifts_total",
+			Help:      "Total number of drifts of the injected network rules",
+		}, []string{"kind"}),
+		httpObservedLatency: prometheus.NewDesc(
+			prometheus.BuildFQName("", chaosDaemonMetricsSubsystem, "httpchaos_observed_request_duration_seconds"),
+			"Histogram of latency of the requests observed by HTTPChaos",
+			[]string{"namespace", "pod", "container", "path", "method", "code"}, nil),
 	}
 }
 
@@ -93,6 +116,8 @@ func (collector *ChaosDaemonMetricsCollector) Describe(ch chan<- *prometheus.Des
 	collector.iptablesPacketBytes.Describe(ch)
 	collector.ipsetMembers.Describe(ch)
 	collector.tcRules.Describe(ch)
+	collector.networkRuleDrifts.Describe(ch)
+	ch <- collector.httpObservedLatency
 }
 
 func (collector *ChaosDaemonMetricsCollector) Collect(ch chan<- prometheus.Metric) {
@@ -101,6 +126,8 @@ func (collector *ChaosDaemonMetricsCollector) Collect(ch chan<- prometheus.Metri
 	collector.iptablesPacketBytes.Collect(ch)
 	collector.ipsetMembers.Collect(ch)
 	collector.tcRules.Collect(ch)
+	collector.networkRuleDrifts.Collect(ch)
+	collector.collectHttpObservations(ch)
 }
 
 func (collector *ChaosDaemonMetricsCollector) InjectCrClient(client crclients.ContainerRuntimeInfoClient) *ChaosDaemonMetricsCollector {
@@ -108,6 +135,61 @@ func (collector *ChaosDaemonMetricsCollector) InjectCrClient(client crclients.Co
 	return collector
 }
 
+// ObserveNetworkRuleDrift records a drift of the network rules of kind, such as ipset, iptables or tc
+func (collector *ChaosDaemonMetricsCollector) ObserveNetworkRuleDrift(kind string) {
+	collector.networkRuleDrifts.WithLabelValues(kind).Inc()
+}
+
+// ObserveHttpChaosTraffic replaces the requests observed by HTTPChaos in the container,
+// the observations are removed if they are empty
+func (collector *ChaosDaemonMetricsCollector) ObserveHttpChaosTraffic(containerID string, observations []tproxyconfig.Observation) {
+	if len(observations) == 0 {
+		collector.httpObservations.Delete(containerID)
+		return
+	}
+
+	labels := []string{"", "", ""}
+	if collector.crClient != nil {
+		containerLabels, err := collector.crClient.GetLabelsFromContainerID(context.Background(), containerID)
+		if err != nil {
+			collector.logger.Error(err, "fail to get container labels", "containerID", containerID)
+		}
+		labels = []string{containerLabels[kubernetesPodNamespaceLabel],
+			containerLabels[kubernetesPodNameLabel], containerLabels[kubernetesContainerNameLabel]}
+	}
+
+	collector.httpObservations.Store(containerID, httpObservations{
+		labels:       labels,
+		observations: observations,
+	})
+}
+
+func (collector *ChaosDaemonMetricsCollector) collectHttpObservations(ch chan<- prometheus.Metric) {
+	collector.httpObservations.Range(func(_, value interface{}) bool {
+		item := value.(httpObservations)
+		for _, observation := range item.observations {
+			if len(observation.LatencyBuckets) != len(tproxyconfig.ObservationLatencyBuckets) {
+				continue
+			}
+			buckets := make(map[float64]uint64, len(observation.LatencyBuckets))
+			for i, bound := range tproxyconfig.ObservationLatencyBuckets {
+				buckets[bound] = observation.LatencyBuckets[i]
+			}
+
+			labelValues := append(append([]string{}, item.labels...),
+				observation.Path, observation.Method, strconv.Itoa(int(observation.Code)))
+			metric, err := prometheus.NewConstHistogram(collector.httpObservedLatency,
+				uint64(observation.Count), observation.LatencySum, buckets, labelValues...)
+			if err != nil {
+				collector.logger.Error(err, "fail to collect http observations")
+				continue
+			}
+			ch <- metric
+		}
+		return true
+	})
+}
+
 func (collector *ChaosDaemonMetricsCollector) collectNetworkMetrics() {
 	collector.iptablesPackets.Reset()
 	collector.iptablesPacketBytes.Reset()
@@ -0,0 +1,51 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netem
+
+import (
+	"time"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// FromFlap converts network flap spec to flap, the cycles of flapping start from startTime.
+func FromFlap(in *v1alpha1.NetworkFlapSpec, startTime time.Time) (*chaosdaemonpb.Flap, error) {
+	up, err := time.ParseDuration(in.UpDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	down, err := time.ParseDuration(in.DownDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	var jitter time.Duration
+	if len(in.Jitter) > 0 {
+		jitter, err = time.ParseDuration(in.Jitter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &chaosdaemonpb.Flap{
+		UpDuration:   int64(up),
+		DownDuration: int64(down),
+		Jitter:       int64(jitter),
+		StartTime:    startTime.UnixNano(),
+	}, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netem
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	chaosdaemonnetem "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/netem"
+	chaosdaemonpb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// FromProfile converts network profile to netem profile, the steps of trace
+// profile should have been resolved, and they are replayed as step profile.
+func FromProfile(in *v1alpha1.NetworkProfileSpec, startTime time.Time) (*chaosdaemonpb.NetemProfile, error) {
+	profile := &chaosdaemonpb.NetemProfile{
+		StartTime: startTime.UnixNano(),
+	}
+
+	switch in.Type {
+	case v1alpha1.RampProfile:
+		profile.Type = chaosdaemonpb.NetemProfile_RAMP
+	case v1alpha1.StepProfile, v1alpha1.TraceProfile:
+		profile.Type = chaosdaemonpb.NetemProfile_STEP
+	case v1alpha1.SineProfile:
+		profile.Type = chaosdaemonpb.NetemProfile_SINE
+	default:
+		return nil, errors.Errorf("unknown profile type %s", in.Type)
+	}
+
+	if len(in.Period) > 0 {
+		period, err := time.ParseDuration(in.Period)
+		if err != nil {
+			return nil, err
+		}
+		profile.Period = int64(period)
+	}
+
+	if len(in.Interval) > 0 {
+		interval, err := time.ParseDuration(in.Interval)
+		if err != nil {
+			return nil, err
+		}
+		profile.Interval = int64(interval)
+	}
+
+	for _, step := range in.Steps {
+		s, err := fromProfileStep(step)
+		if err != nil {
+			return nil, err
+		}
+		profile.Steps = append(profile.Steps, s)
+	}
+
+	return profile, nil
+}
+
+func fromProfileStep(in v1alpha1.NetworkProfileStep) (*chaosdaemonpb.NetemProfileStep, error) {
+	step := &chaosdaemonpb.NetemProfileStep{}
+
+	offset, err := time.ParseDuration(in.Offset)
+	if err != nil {
+		return nil, err
+	}
+	step.Offset = int64(offset)
+
+	if len(in.Latency) > 0 {
+		latency, err := time.ParseDuration(in.Latency)
+		if err != nil {
+			return nil, err
+		}
+		step.Latency = int64(latency)
+	}
+
+	if len(in.Loss) > 0 {
+		loss, err := strconv.ParseFloat(in.Loss, 32)
+		if err != nil {
+			return nil, err
+		}
+		step.Loss = float32(loss)
+	}
+
+	if len(in.Rate) > 0 {
+		step.Rate = chaosdaemonnetem.ParseRate(in.Rate)
+		if step.Rate == 0 {
+			return nil, errors.Errorf("invalid rate %s", in.Rate)
+		}
+	}
+
+	return step, nil
+}
+
+// ParseTrace parses the recorded trace into the steps of profile.
+// Every line of the trace is `<offset>,<latency>,<loss>,<rate>`, the empty fields
+// are ignored, and the empty lines or lines starting with `#` are skipped.
+func ParseTrace(content string) ([]v1alpha1.NetworkProfileStep, error) {
+	var steps []v1alpha1.NetworkProfileStep
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, ",")
+		if len(fields) > 4 {
+			return nil, errors.Errorf("line %d of trace has more than 4 fields", i+1)
+		}
+		for len(fields) < 4 {
+			fields = append(fields, "")
+		}
+
+		step := v1alpha1.NetworkProfileStep{
+			Offset:  strings.TrimSpace(fields[0]),
+			Latency: strings.TrimSpace(fields[1]),
+			Loss:    strings.TrimSpace(fields[2]),
+			Rate:    strings.TrimSpace(fields[3]),
+		}
+		if _, err := fromProfileStep(step); err != nil {
+			return nil, errors.Wrapf(err, "parse line %d of trace", i+1)
+		}
+		if len(steps) > 0 {
+			last, _ := time.ParseDuration(steps[len(steps)-1].Offset)
+			offset, _ := time.ParseDuration(step.Offset)
+			if offset < last {
+				return nil, errors.Errorf("the offset of line %d of trace is less than the previous one", i+1)
+			}
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
@@ -0,0 +1,67 @@
+// Copyright 2021 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package netem
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestParseTrace(t *testing.T) {
+	g := NewWithT(t)
+
+	t.Run("parse steps", func(t *testing.T) {
+		steps, err := ParseTrace(`
+# offset,latency,loss,rate
+0s,10ms,0,1mbps
+30s,100ms
+ 1m , , 5 ,
+`)
+		g.Expect(err).To(BeNil())
+		g.Expect(steps).To(Equal([]v1alpha1.NetworkProfileStep{
+			{Offset: "0s", Latency: "10ms", Loss: "0", Rate: "1mbps"},
+			{Offset: "30s", Latency: "100ms"},
+			{Offset: "1m", Loss: "5"},
+		}))
+	})
+
+	t.Run("empty trace", func(t *testing.T) {
+		steps, err := ParseTrace("\n# nothing\n")
+		g.Expect(err).To(BeNil())
+		g.Expect(steps).To(BeEmpty())
+	})
+
+	t.Run("too many fields", func(t *testing.T) {
+		_, err := ParseTrace("0s,10ms,0,1mbps,1")
+		g.Expect(err).To(MatchError(ContainSubstring("line 1")))
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		_, err := ParseTrace("0s,10ms\n10s,fast")
+		g.Expect(err).To(MatchError(ContainSubstring("line 2")))
+
+		_, err = ParseTrace("0s,10ms,0,fast")
+		g.Expect(err).To(HaveOccurred())
+	})
+
+	t.Run("decreasing offset", func(t *testing.T) {
+		_, err := ParseTrace("10s,10ms\n5s,20ms")
+		g.Expect(err).To(MatchError(ContainSubstring("less than the previous one")))
+	})
+}
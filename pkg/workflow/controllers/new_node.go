@@ -137,6 +137,8 @@ func conversionSchedule(origin *v1alpha1.ChaosOnlyScheduleSpec) *v1alpha1.Schedu
 				DNSChaos:             origin.EmbedChaos.DNSChaos,
 				GCPChaos:             origin.EmbedChaos.GCPChaos,
 				HTTPChaos:            origin.EmbedChaos.HTTPChaos,
+				GRPCChaos:            origin.EmbedChaos.GRPCChaos,
+				ProtocolChaos:        origin.EmbedChaos.ProtocolChaos,
 				IOChaos:              origin.EmbedChaos.IOChaos,
 				JVMChaos:             origin.EmbedChaos.JVMChaos,
 				KernelChaos:          origin.EmbedChaos.KernelChaos,
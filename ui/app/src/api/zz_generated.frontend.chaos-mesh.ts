@@ -6,6 +6,7 @@ const mapping = new Map<ExperimentKind, string>([
   ['BlockChaos', 'blockChaos'],
   ['DNSChaos', 'dnsChaos'],
   ['GCPChaos', 'gcpChaos'],
+  ['GRPCChaos', 'grpcChaos'],
   ['HTTPChaos', 'httpChaos'],
   ['IOChaos', 'ioChaos'],
   ['JVMChaos', 'jvmChaos'],
@@ -13,6 +14,7 @@ const mapping = new Map<ExperimentKind, string>([
   ['NetworkChaos', 'networkChaos'],
   ['PhysicalMachineChaos', 'physicalmachineChaos'],
   ['PodChaos', 'podChaos'],
+  ['ProtocolChaos', 'protocolChaos'],
   ['RuntimeMutatorChaos', 'runtimemutatorChaos'],
   ['StressChaos', 'stressChaos'],
   ['TimeChaos', 'timeChaos'],